@@ -0,0 +1,92 @@
+// Package cep consulta o logradouro, o bairro, a cidade e a UF de um CEP. O serviço
+// de pedidos depende apenas da interface Consulta, então a origem dos dados (uma base
+// de CEPs em arquivo, um serviço externo) é escolhida na inicialização da API.
+package cep
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"lanchonete/models"
+)
+
+var (
+	// ErrNaoEncontrado indica que o CEP não existe na base consultada
+	ErrNaoEncontrado = errors.New("CEP não encontrado")
+	// ErrIndisponivel indica que a consulta não pôde ser feita; o endereço informado
+	// pelo cliente é usado sem ser completado
+	ErrIndisponivel = errors.New("consulta de CEP indisponível")
+)
+
+// Consulta retorna o endereço do CEP, só com dígitos, sem número nem complemento.
+// CEPs de cidades com CEP único não têm logradouro nem bairro.
+type Consulta interface {
+	Buscar(cep string) (models.EnderecoEntrega, error)
+}
+
+// SemConsulta é usada quando nenhuma base de CEPs foi configurada: todo endereço
+// precisa ser informado por completo
+type SemConsulta struct{}
+
+func (SemConsulta) Buscar(string) (models.EnderecoEntrega, error) {
+	return models.EnderecoEntrega{}, ErrIndisponivel
+}
+
+// Arquivo consulta uma base de CEPs carregada de um arquivo CSV, sem acesso à rede
+type Arquivo struct {
+	enderecos map[string]models.EnderecoEntrega
+}
+
+// NovoArquivo carrega o CSV com o cabeçalho cep,logradouro,bairro,cidade,uf; o CEP
+// pode ter hífen
+func NovoArquivo(caminho string) (*Arquivo, error) {
+	f, err := os.Open(caminho)
+	if err != nil {
+		return nil, fmt.Errorf("abrir base de CEPs: %w", err)
+	}
+	defer f.Close()
+
+	return LerArquivo(f)
+}
+
+// LerArquivo carrega a base de CEPs no formato de NovoArquivo
+func LerArquivo(r io.Reader) (*Arquivo, error) {
+	leitor := csv.NewReader(r)
+	leitor.FieldsPerRecord = 5
+
+	linhas, err := leitor.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("ler base de CEPs: %w", err)
+	}
+
+	arquivo := &Arquivo{enderecos: map[string]models.EnderecoEntrega{}}
+	for i, linha := range linhas {
+		if i == 0 && strings.EqualFold(linha[0], "cep") {
+			continue
+		}
+		cep, ok := models.NormalizarCEP(linha[0])
+		if !ok {
+			return nil, fmt.Errorf("ler base de CEPs: linha %d: CEP inválido %q", i+1, linha[0])
+		}
+		arquivo.enderecos[cep] = models.EnderecoEntrega{
+			CEP:        cep,
+			Logradouro: linha[1],
+			Bairro:     linha[2],
+			Cidade:     linha[3],
+			UF:         strings.ToUpper(linha[4]),
+		}
+	}
+	return arquivo, nil
+}
+
+func (a *Arquivo) Buscar(cep string) (models.EnderecoEntrega, error) {
+	endereco, ok := a.enderecos[cep]
+	if !ok {
+		return models.EnderecoEntrega{}, ErrNaoEncontrado
+	}
+	return endereco, nil
+}
@@ -0,0 +1,58 @@
+package cep
+
+import (
+	"encoding/csv"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"lanchonete/models"
+)
+
+func lerFixture(t *testing.T, nome string) (*Arquivo, error) {
+	t.Helper()
+
+	f, err := os.Open("testdata/" + nome)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	return LerArquivo(f)
+}
+
+func TestLerArquivoBuscaCEP(t *testing.T) {
+	arquivo, err := lerFixture(t, "ceps.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	esperados := map[string]models.EnderecoEntrega{
+		"01001000": {CEP: "01001000", Logradouro: "Praça da Sé", Bairro: "Sé", Cidade: "São Paulo", UF: "SP"},
+		// Cidade com CEP único, sem logradouro nem bairro
+		"38400000": {CEP: "38400000", Cidade: "Uberlândia", UF: "MG"},
+	}
+	for cep, esperado := range esperados {
+		endereco, err := arquivo.Buscar(cep)
+		if err != nil {
+			t.Fatalf("Buscar(%s): %v", cep, err)
+		}
+		if endereco != esperado {
+			t.Errorf("Buscar(%s) = %+v, esperado %+v", cep, endereco, esperado)
+		}
+	}
+
+	if _, err := arquivo.Buscar("99999999"); !errors.Is(err, ErrNaoEncontrado) {
+		t.Errorf("CEP fora da base: erro %v, esperado ErrNaoEncontrado", err)
+	}
+}
+
+func TestLerArquivoRecusaLinhaMalformada(t *testing.T) {
+	if _, err := lerFixture(t, "cep_invalido.csv"); err == nil || !strings.Contains(err.Error(), "linha 3") {
+		t.Errorf("CEP inválido: erro %v, esperado apontar a linha 3", err)
+	}
+	if _, err := lerFixture(t, "colunas_faltando.csv"); !errors.Is(err, csv.ErrFieldCount) {
+		t.Errorf("linha sem a UF: erro %v, esperado csv.ErrFieldCount", err)
+	}
+}
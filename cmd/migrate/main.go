@@ -0,0 +1,80 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+
+	"lanchonete/database"
+)
+
+const uso = `Uso: go run ./cmd/migrate <comando>
+
+Comandos:
+  up        aplica todas as migrações pendentes
+  down [n]  reverte as últimas n migrações aplicadas (padrão 1)
+  status    lista as migrações e quando cada uma foi aplicada`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Println(uso)
+		os.Exit(2)
+	}
+
+	db, err := database.OpenDB()
+	if err != nil {
+		log.Fatal("Erro ao conectar ao banco de dados:", err)
+	}
+
+	switch os.Args[1] {
+	case "up":
+		aplicadas, err := database.MigrateUp(db)
+		for _, migracao := range aplicadas {
+			fmt.Printf("Aplicada %04d_%s\n", migracao.Versao, migracao.Nome)
+		}
+		if err != nil {
+			log.Fatal("Erro ao aplicar migrações:", err)
+		}
+		if len(aplicadas) == 0 {
+			fmt.Println("Nenhuma migração pendente")
+		}
+
+	case "down":
+		passos := 1
+		if len(os.Args) > 2 {
+			passos, err = strconv.Atoi(os.Args[2])
+			if err != nil || passos < 1 {
+				log.Fatal("Número de migrações inválido: ", os.Args[2])
+			}
+		}
+
+		revertidas, err := database.MigrateDown(db, passos)
+		for _, migracao := range revertidas {
+			fmt.Printf("Revertida %04d_%s\n", migracao.Versao, migracao.Nome)
+		}
+		if err != nil {
+			log.Fatal("Erro ao reverter migrações:", err)
+		}
+		if len(revertidas) == 0 {
+			fmt.Println("Nenhuma migração aplicada")
+		}
+
+	case "status":
+		status, err := database.MigrationStatus(db)
+		if err != nil {
+			log.Fatal("Erro ao consultar migrações:", err)
+		}
+		for _, item := range status {
+			situacao := "pendente"
+			if item.AplicadaEm != nil {
+				situacao = "aplicada em " + item.AplicadaEm.Format("2006-01-02 15:04:05")
+			}
+			fmt.Printf("%04d_%s\t%s\n", item.Versao, item.Nome, situacao)
+		}
+
+	default:
+		fmt.Println(uso)
+		os.Exit(2)
+	}
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	"lanchonete/database"
+	"lanchonete/repository"
+)
+
+const uso = `Uso: go run ./cmd/purge [dias]
+
+Apaga definitivamente os pedidos, hambúrgueres e itens removidos há mais de
+dias dias (padrão 30). Hambúrgueres e itens que ainda aparecem em pedidos ou
+receitas continuam removidos logicamente, para preservar o histórico.`
+
+const retencaoPadrao = 30
+
+func main() {
+	dias := retencaoPadrao
+	if len(os.Args) > 1 {
+		var err error
+		dias, err = strconv.Atoi(os.Args[1])
+		if err != nil || dias < 0 {
+			fmt.Println(uso)
+			os.Exit(2)
+		}
+	}
+
+	database.ConnectDB()
+	store := repository.NewGormStore(database.DB)
+	antes := time.Now().AddDate(0, 0, -dias)
+
+	// Os pedidos vão primeiro para liberar os produtos que só eles referenciavam,
+	// e os hambúrgueres antes dos itens para liberar os ingredientes das receitas
+	var pedidos, hamburguers, itens int64
+	err := store.Transacao(func(tx repository.Store) error {
+		var err error
+		if pedidos, err = tx.Pedidos().Expurgar(antes); err != nil {
+			return fmt.Errorf("pedidos: %w", err)
+		}
+		if hamburguers, err = tx.Hamburguers().Expurgar(antes); err != nil {
+			return fmt.Errorf("hambúrgueres: %w", err)
+		}
+		if itens, err = tx.Itens().Expurgar(antes); err != nil {
+			return fmt.Errorf("itens: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		log.Fatal("Erro ao expurgar registros removidos: ", err)
+	}
+
+	fmt.Printf("Removidos antes de %s expurgados: %d pedidos, %d hambúrgueres, %d itens\n",
+		antes.Format("2006-01-02 15:04:05"), pedidos, hamburguers, itens)
+}
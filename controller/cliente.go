@@ -0,0 +1,209 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"lanchonete/mensagens"
+	"lanchonete/models"
+	"lanchonete/repository"
+	"lanchonete/service"
+)
+
+// ClienteController atende as rotas do cadastro de clientes e seus endereços. O
+// cliente também é cadastrado automaticamente no primeiro pedido do telefone.
+type ClienteController struct {
+	clientes repository.ClienteRepository
+	servico  *service.ClienteService
+}
+
+func NewClienteController(clientes repository.ClienteRepository, servico *service.ClienteService) *ClienteController {
+	return &ClienteController{clientes: clientes, servico: servico}
+}
+
+// @Summary Busca um cliente
+// @Description Retorna o cliente do telefone com os endereços salvos
+// @Tags clientes
+// @Accept json
+// @Produce json
+// @Param telefone path string true "Telefone do cliente, só com números e DDD"
+// @Success 200 {object} models.Cliente
+// @Failure 400 {object} models.ErroResponse "Telefone inválido"
+// @Failure 404 {object} models.ErroResponse "Cliente não encontrado"
+// @Router /clientes/{telefone} [get]
+func (ctrl *ClienteController) GetCliente(c *gin.Context) {
+	cliente, ok := ctrl.buscarCliente(c)
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusOK, cliente)
+}
+
+// @Summary Cadastra um cliente
+// @Description Cadastra um cliente com, opcionalmente, seus endereços. O primeiro endereço é o principal se nenhum for marcado.
+// @Description Com um CEP conhecido, logradouro, bairro, cidade e UF são completados pela consulta de CEP.
+// @Tags clientes
+// @Accept json
+// @Produce json
+// @Param cliente body models.ClienteRequest true "Dados do cliente"
+// @Success 201 {object} models.Cliente
+// @Failure 400 {object} models.ErroResponse "Erro na validação dos dados, CEP não encontrado ou endereço incompleto"
+// @Failure 409 {object} models.ErroResponse "Telefone já cadastrado"
+// @Router /clientes [post]
+func (ctrl *ClienteController) CreateCliente(c *gin.Context) {
+	var request models.ClienteRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		responderDadosInvalidos(c, err)
+		return
+	}
+
+	cliente, err := ctrl.servico.RegisterCustomer(request)
+	if errors.Is(err, service.ErrConflito) {
+		responderErro(c, http.StatusConflict, models.ErroJaExiste, traduzir(c, mensagens.ClienteJaExiste))
+		return
+	}
+	if err != nil {
+		responderErroServico(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, cliente)
+}
+
+// @Summary Atualiza um cliente
+// @Description Altera o nome do cliente. Pedidos já feitos mantêm o nome usado na compra.
+// @Tags clientes
+// @Accept json
+// @Produce json
+// @Param telefone path string true "Telefone do cliente, só com números e DDD"
+// @Param cliente body models.ClienteUpdateRequest true "Novo nome"
+// @Success 200 {object} models.Cliente
+// @Failure 400 {object} models.ErroResponse "Telefone ou dados inválidos"
+// @Failure 404 {object} models.ErroResponse "Cliente não encontrado"
+// @Router /clientes/{telefone} [put]
+func (ctrl *ClienteController) UpdateCliente(c *gin.Context) {
+	cliente, ok := ctrl.buscarCliente(c)
+	if !ok {
+		return
+	}
+
+	var request models.ClienteUpdateRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		responderDadosInvalidos(c, err)
+		return
+	}
+
+	cliente.Nome = request.Nome
+	if err := ctrl.clientes.Salvar(&cliente); err != nil {
+		responderErro(c, http.StatusInternalServerError, models.ErroInterno, traduzir(c, mensagens.ClienteErroAtualizar))
+		return
+	}
+
+	c.JSON(http.StatusOK, cliente)
+}
+
+// @Summary Adiciona um endereço ao cliente
+// @Description Salva um endereço de entrega no cadastro. Marcado como principal, substitui o principal anterior.
+// @Description Com um CEP conhecido, logradouro, bairro, cidade e UF são completados pela consulta de CEP.
+// @Tags clientes
+// @Accept json
+// @Produce json
+// @Param telefone path string true "Telefone do cliente, só com números e DDD"
+// @Param endereco body models.ClienteEnderecoRequest true "Endereço de entrega"
+// @Success 201 {object} models.Cliente
+// @Failure 400 {object} models.ErroResponse "Telefone ou dados inválidos, CEP não encontrado ou endereço incompleto"
+// @Failure 404 {object} models.ErroResponse "Cliente não encontrado"
+// @Router /clientes/{telefone}/enderecos [post]
+func (ctrl *ClienteController) CreateEndereco(c *gin.Context) {
+	cliente, ok := ctrl.buscarCliente(c)
+	if !ok {
+		return
+	}
+
+	var request models.ClienteEnderecoRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		responderDadosInvalidos(c, err)
+		return
+	}
+
+	cliente, err := ctrl.servico.AddAddress(cliente.Telefone, request)
+	if err != nil {
+		responderErroServico(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, cliente)
+}
+
+// @Summary Remove um endereço do cliente
+// @Description Apaga o endereço do cadastro; se era o principal, o mais antigo dos restantes assume. Pedidos já feitos mantêm o endereço de entrega.
+// @Tags clientes
+// @Accept json
+// @Produce json
+// @Param telefone path string true "Telefone do cliente, só com números e DDD"
+// @Param id path int true "ID do endereço"
+// @Success 200 {object} string "Endereço removido com sucesso"
+// @Failure 400 {object} models.ErroResponse "Telefone ou ID inválido"
+// @Failure 404 {object} models.ErroResponse "Cliente ou endereço não encontrado"
+// @Router /clientes/{telefone}/enderecos/{id} [delete]
+func (ctrl *ClienteController) DeleteEndereco(c *gin.Context) {
+	telefone, ok := lerTelefone(c)
+	if !ok {
+		return
+	}
+
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		responderErro(c, http.StatusBadRequest, models.ErroDadosInvalidos, traduzir(c, mensagens.ClienteEnderecoIDInvalido),
+			models.ErroDetalhe{Campo: "id", Mensagem: traduzir(c, mensagens.NumeroInteiro), Valor: c.Param("id")})
+		return
+	}
+
+	err = ctrl.clientes.RemoverEndereco(telefone, uint(id))
+	if errors.Is(err, repository.ErrNaoEncontrado) {
+		responderErro(c, http.StatusNotFound, models.ErroNaoEncontrado, traduzir(c, mensagens.EnderecoNaoEncontrado, id))
+		return
+	}
+	if err != nil {
+		responderErro(c, http.StatusInternalServerError, models.ErroInterno, traduzir(c, mensagens.ClienteErroRemoverEndereco))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": traduzir(c, mensagens.ClienteEnderecoRemovido)})
+}
+
+// buscarCliente carrega o cliente do telefone da rota, respondendo com o erro se não encontrar
+func (ctrl *ClienteController) buscarCliente(c *gin.Context) (models.Cliente, bool) {
+	telefone, ok := lerTelefone(c)
+	if !ok {
+		return models.Cliente{}, false
+	}
+
+	cliente, err := ctrl.clientes.Buscar(telefone)
+	if errors.Is(err, repository.ErrNaoEncontrado) {
+		responderErro(c, http.StatusNotFound, models.ErroNaoEncontrado, traduzir(c, mensagens.ClienteNaoEncontrado))
+		return models.Cliente{}, false
+	}
+	if err != nil {
+		responderErro(c, http.StatusInternalServerError, models.ErroInterno, traduzir(c, mensagens.ClienteErroBuscar))
+		return models.Cliente{}, false
+	}
+	if cliente.Enderecos == nil {
+		cliente.Enderecos = []models.ClienteEndereco{}
+	}
+	return cliente, true
+}
+
+// lerTelefone interpreta o parâmetro telefone da rota, com DDD e sem pontuação
+func lerTelefone(c *gin.Context) (string, bool) {
+	telefone := c.Param("telefone")
+	if !models.TelefoneValido(telefone) {
+		responderErro(c, http.StatusBadRequest, models.ErroDadosInvalidos, traduzir(c, mensagens.ClienteTelefoneInvalido),
+			models.ErroDetalhe{Campo: "telefone", Mensagem: traduzir(c, mensagens.TelefoneInvalidoCampo), Valor: telefone})
+		return "", false
+	}
+	return telefone, true
+}
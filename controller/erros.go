@@ -0,0 +1,151 @@
+package controller
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+	"lanchonete/mensagens"
+	"lanchonete/middleware"
+	"lanchonete/models"
+)
+
+func init() {
+	// Os detalhes de validação usam o nome do campo no JSON ou na query, e não o da struct
+	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
+		v.RegisterTagNameFunc(func(campo reflect.StructField) string {
+			for _, tag := range []string{"json", "form"} {
+				nome, _, _ := strings.Cut(campo.Tag.Get(tag), ",")
+				if nome != "" && nome != "-" {
+					return nome
+				}
+			}
+			return ""
+		})
+
+		// Formatos brasileiros usados no cadastro de clientes e nos pedidos
+		v.RegisterValidation("telefone", func(campo validator.FieldLevel) bool {
+			return models.TelefoneValido(campo.Field().String())
+		})
+		v.RegisterValidation("cep", func(campo validator.FieldLevel) bool {
+			_, ok := models.NormalizarCEP(campo.Field().String())
+			return ok
+		})
+		v.RegisterValidation("uf", func(campo validator.FieldLevel) bool {
+			return models.UFValida(campo.Field().String())
+		})
+	}
+}
+
+// idioma retorna o idioma negociado pelo middleware ou, sem ele, pelo próprio Accept-Language
+func idioma(c *gin.Context) mensagens.Idioma {
+	if valor, ok := c.Get(middleware.ChaveIdioma); ok {
+		if idioma, ok := valor.(mensagens.Idioma); ok {
+			return idioma
+		}
+	}
+	return mensagens.Negociar(c.GetHeader("Accept-Language"))
+}
+
+// traduzir monta a mensagem do catálogo no idioma da requisição
+func traduzir(c *gin.Context, chave mensagens.Chave, args ...any) string {
+	return mensagens.Traduzir(idioma(c), chave, args...)
+}
+
+// responderErro envia o envelope padrão de erro, com o ID da requisição
+func responderErro(c *gin.Context, status int, codigo models.CodigoErro, mensagem string, detalhes ...models.ErroDetalhe) {
+	c.JSON(status, models.ErroResponse{
+		Codigo:    codigo,
+		Mensagem:  mensagem,
+		Detalhes:  detalhes,
+		RequestID: c.GetString(middleware.ChaveRequestID),
+	})
+}
+
+// erroCampo é um parâmetro da requisição recusado pelo próprio controller
+type erroCampo struct {
+	campo    string
+	mensagem mensagens.Chave
+}
+
+func (e erroCampo) Error() string {
+	return e.campo + ": " + mensagens.Traduzir(mensagens.Padrao, e.mensagem)
+}
+
+// responderDadosInvalidos responde 400 detalhando por campo as falhas do binding do gin
+// ou o parâmetro recusado
+func responderDadosInvalidos(c *gin.Context, err error) {
+	responderErro(c, http.StatusBadRequest, models.ErroDadosInvalidos, traduzir(c, mensagens.DadosInvalidos), detalharErro(c, err)...)
+}
+
+func detalharErro(c *gin.Context, err error) []models.ErroDetalhe {
+	var validacao validator.ValidationErrors
+	var campo erroCampo
+	var tipo *json.UnmarshalTypeError
+	var sintaxe *json.SyntaxError
+
+	switch {
+	case errors.As(err, &validacao):
+		detalhes := make([]models.ErroDetalhe, 0, len(validacao))
+		for _, falha := range validacao {
+			detalhes = append(detalhes, models.ErroDetalhe{
+				Campo:    caminhoCampo(falha),
+				Mensagem: mensagemValidacao(c, falha),
+			})
+		}
+		return detalhes
+	case errors.As(err, &campo):
+		return []models.ErroDetalhe{{Campo: campo.campo, Mensagem: traduzir(c, campo.mensagem)}}
+	case errors.As(err, &tipo):
+		return []models.ErroDetalhe{{Campo: tipo.Field, Mensagem: traduzir(c, mensagens.TipoValorInvalido)}}
+	case errors.As(err, &sintaxe), errors.Is(err, io.ErrUnexpectedEOF):
+		return []models.ErroDetalhe{{Campo: "body", Mensagem: traduzir(c, mensagens.JSONMalformado)}}
+	case errors.Is(err, io.EOF):
+		return []models.ErroDetalhe{{Campo: "body", Mensagem: traduzir(c, mensagens.CorpoVazio)}}
+	case errors.Is(err, models.ErrDinheiroInvalido):
+		return []models.ErroDetalhe{{Campo: "body", Mensagem: traduzir(c, mensagens.ValorMonetarioInvalido)}}
+	}
+	return nil
+}
+
+// caminhoCampo remove o nome da struct raiz do caminho, como em hamburgueres[0].quantidade
+func caminhoCampo(falha validator.FieldError) string {
+	_, caminho, ok := strings.Cut(falha.Namespace(), ".")
+	if !ok {
+		return falha.Field()
+	}
+	return caminho
+}
+
+// mensagemValidacao traduz a regra de binding que falhou no idioma da requisição
+func mensagemValidacao(c *gin.Context, falha validator.FieldError) string {
+	switch falha.Tag() {
+	case "required":
+		return traduzir(c, mensagens.CampoObrigatorio)
+	case "min":
+		switch falha.Kind() {
+		case reflect.Slice, reflect.Array:
+			return traduzir(c, mensagens.MinimoItens, falha.Param())
+		case reflect.String:
+			return traduzir(c, mensagens.MinimoCaracteres, falha.Param())
+		}
+		return traduzir(c, mensagens.ValorMinimo, falha.Param())
+	case "len":
+		return traduzir(c, mensagens.TamanhoExato, falha.Param())
+	case "gt":
+		return traduzir(c, mensagens.MaiorQue, falha.Param())
+	case "telefone":
+		return traduzir(c, mensagens.TelefoneInvalidoCampo)
+	case "cep":
+		return traduzir(c, mensagens.CEPInvalidoCampo)
+	case "uf":
+		return traduzir(c, mensagens.UFInvalidaCampo)
+	}
+	return traduzir(c, mensagens.ValorInvalido)
+}
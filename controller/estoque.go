@@ -0,0 +1,394 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"lanchonete/mensagens"
+	"lanchonete/models"
+	"lanchonete/repository"
+	"lanchonete/service"
+)
+
+// EstoqueController atende as rotas de estoque dos itens. A baixa e a devolução
+// acontecem nos pedidos; aqui o saldo e os movimentos são consultados e as entradas,
+// perdas e ajustes são lançados.
+type EstoqueController struct {
+	store   repository.Store
+	estoque *service.EstoqueService
+}
+
+func NewEstoqueController(store repository.Store, estoque *service.EstoqueService) *EstoqueController {
+	return &EstoqueController{store: store, estoque: estoque}
+}
+
+// @Summary Lista o estoque
+// @Description Retorna o saldo de todos os itens com estoque controlado
+// @Tags estoque
+// @Accept json
+// @Produce json
+// @Param limit query int false "Quantidade máxima de itens por página (padrão 20, máximo 100)"
+// @Param cursor query string false "Cursor da próxima página, retornado em next_cursor"
+// @Success 200 {object} models.Pagina[models.EstoqueResponse]
+// @Failure 400 {object} models.ErroResponse "Paginação inválida"
+// @Router /estoque [get]
+func (ctrl *EstoqueController) GetAllEstoque(c *gin.Context) {
+	pagina, ok := ctrl.buscarPaginaEstoque(c, repository.FiltroEstoque{})
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusOK, pagina)
+}
+
+// @Summary Lista os alertas de estoque baixo
+// @Description Retorna os itens cujo saldo chegou ao ponto de reposição ou ficou abaixo dele
+// @Tags estoque
+// @Accept json
+// @Produce json
+// @Param limit query int false "Quantidade máxima de itens por página (padrão 20, máximo 100)"
+// @Param cursor query string false "Cursor da próxima página, retornado em next_cursor"
+// @Success 200 {object} models.Pagina[models.EstoqueResponse]
+// @Failure 400 {object} models.ErroResponse "Paginação inválida"
+// @Router /estoque/alertas [get]
+func (ctrl *EstoqueController) GetAlertasEstoque(c *gin.Context) {
+	pagina, ok := ctrl.buscarPaginaEstoque(c, repository.FiltroEstoque{AbaixoDoMinimo: true})
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusOK, pagina)
+}
+
+// @Summary Busca o estoque de um item
+// @Description Retorna o saldo, a unidade e o ponto de reposição de um item com estoque controlado
+// @Tags estoque
+// @Accept json
+// @Produce json
+// @Param codigo path string true "Código do item"
+// @Success 200 {object} models.EstoqueResponse
+// @Failure 400 {object} models.ErroResponse "Código inválido"
+// @Failure 404 {object} models.ErroResponse "Item sem estoque controlado"
+// @Router /estoque/{codigo} [get]
+func (ctrl *EstoqueController) GetEstoqueItem(c *gin.Context) {
+	id, ok := lerCodigoItem(c)
+	if !ok {
+		return
+	}
+
+	estoque, err := ctrl.store.Estoque().Buscar(id)
+	if errors.Is(err, repository.ErrNaoEncontrado) {
+		responderErro(c, http.StatusNotFound, models.ErroNaoEncontrado, traduzir(c, mensagens.EstoqueNaoControlado))
+		return
+	}
+	if err != nil {
+		responderErro(c, http.StatusInternalServerError, models.ErroInterno, traduzir(c, mensagens.EstoqueErroBuscar))
+		return
+	}
+
+	c.JSON(http.StatusOK, respostaEstoque(estoque))
+}
+
+// @Summary Define o estoque de um item
+// @Description Passa a controlar o estoque do item ou altera a unidade e o ponto de reposição. As quantidades
+// @Description das receitas são lidas na mesma unidade. Se a quantidade contada for informada, a diferença
+// @Description para o saldo atual é registrada como um movimento AJUSTE.
+// @Tags estoque
+// @Accept json
+// @Produce json
+// @Param codigo path string true "Código do item"
+// @Param estoque body models.EstoqueRequest true "Saldo e parâmetros do estoque"
+// @Success 200 {object} models.EstoqueResponse
+// @Failure 400 {object} models.ErroResponse "Código, unidade ou dados inválidos"
+// @Failure 404 {object} models.ErroResponse "Item não encontrado"
+// @Failure 409 {object} models.ErroResponse "Saldo alterado por um pedido durante a contagem"
+// @Router /estoque/{codigo} [put]
+func (ctrl *EstoqueController) UpdateEstoqueItem(c *gin.Context) {
+	id, ok := lerCodigoItem(c)
+	if !ok {
+		return
+	}
+
+	var request models.EstoqueRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		responderDadosInvalidos(c, err)
+		return
+	}
+
+	if !request.Unidade.Valida() {
+		responderErro(c, http.StatusBadRequest, models.ErroDadosInvalidos, traduzir(c, mensagens.EstoqueUnidadeInvalida), models.ErroDetalhe{
+			Campo:      "unidade",
+			Mensagem:   traduzir(c, mensagens.EstoqueUnidadeInvalidaCampo),
+			Valor:      string(request.Unidade),
+			Permitidos: textos(models.UnidadesEstoque),
+		})
+		return
+	}
+
+	estoque, err := ctrl.estoque.SetStock(id, request)
+	if err != nil {
+		responderErroServico(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, respostaEstoque(estoque))
+}
+
+// @Summary Registra um recebimento
+// @Description Lança no estoque a mercadoria entregue por um fornecedor, com o documento de entrada
+// @Tags estoque
+// @Accept json
+// @Produce json
+// @Param codigo path string true "Código do item"
+// @Param recebimento body models.RecebimentoRequest true "Dados do recebimento"
+// @Success 201 {object} models.MovimentoEstoque
+// @Failure 400 {object} models.ErroResponse "Código ou dados inválidos"
+// @Failure 404 {object} models.ErroResponse "Item sem estoque controlado"
+// @Router /estoque/{codigo}/recebimentos [post]
+func (ctrl *EstoqueController) CreateRecebimento(c *gin.Context) {
+	id, ok := lerCodigoItem(c)
+	if !ok {
+		return
+	}
+
+	var request models.RecebimentoRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		responderDadosInvalidos(c, err)
+		return
+	}
+
+	movimento, err := ctrl.estoque.ReceiveStock(id, request)
+	if err != nil {
+		responderErroServico(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, movimento)
+}
+
+// @Summary Registra uma perda ou um ajuste
+// @Description Lança uma PERDA (desperdício, vencimento ou quebra), sempre com quantidade positiva, ou um
+// @Description AJUSTE manual, com quantidade negativa para retirar do estoque. A observação explica o motivo.
+// @Tags estoque
+// @Accept json
+// @Produce json
+// @Param codigo path string true "Código do item"
+// @Param ajuste body models.AjusteEstoqueRequest true "Dados do ajuste"
+// @Success 201 {object} models.MovimentoEstoque
+// @Failure 400 {object} models.ErroResponse "Código, tipo ou dados inválidos"
+// @Failure 404 {object} models.ErroResponse "Item sem estoque controlado"
+// @Failure 409 {object} models.ErroResponse "Estoque insuficiente"
+// @Router /estoque/{codigo}/ajustes [post]
+func (ctrl *EstoqueController) CreateAjuste(c *gin.Context) {
+	id, ok := lerCodigoItem(c)
+	if !ok {
+		return
+	}
+
+	var request models.AjusteEstoqueRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		responderDadosInvalidos(c, err)
+		return
+	}
+
+	if !slices.Contains(models.TiposAjuste, request.Tipo) {
+		responderErro(c, http.StatusBadRequest, models.ErroDadosInvalidos, traduzir(c, mensagens.EstoqueTipoAjusteInvalido, request.Tipo), models.ErroDetalhe{
+			Campo:      "tipo",
+			Mensagem:   traduzir(c, mensagens.EstoqueTipoInvalidoCampo),
+			Valor:      string(request.Tipo),
+			Permitidos: textos(models.TiposAjuste),
+		})
+		return
+	}
+
+	movimento, err := ctrl.estoque.AdjustStock(id, request)
+	if err != nil {
+		responderErroServico(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, movimento)
+}
+
+// @Summary Lista os movimentos de um item
+// @Description Retorna o registro de movimentos do item em ordem de lançamento, com o saldo após cada um
+// @Tags estoque
+// @Accept json
+// @Produce json
+// @Param codigo path string true "Código do item"
+// @Param tipo query string false "Tipo do movimento" Enums(RECEBIMENTO, CONSUMO, ESTORNO, PERDA, AJUSTE)
+// @Param data_inicio query string false "Data inicial (AAAA-MM-DD)"
+// @Param data_fim query string false "Data final, inclusiva (AAAA-MM-DD)"
+// @Param limit query int false "Quantidade máxima de movimentos por página (padrão 20, máximo 100)"
+// @Param cursor query string false "Cursor da próxima página, retornado em next_cursor"
+// @Success 200 {object} models.Pagina[models.MovimentoEstoque]
+// @Failure 400 {object} models.ErroResponse "Código, filtro ou paginação inválidos"
+// @Router /estoque/{codigo}/movimentos [get]
+func (ctrl *EstoqueController) GetMovimentos(c *gin.Context) {
+	id, ok := lerCodigoItem(c)
+	if !ok {
+		return
+	}
+
+	var filtro models.MovimentoFiltro
+	if err := c.ShouldBindQuery(&filtro); err != nil {
+		responderDadosInvalidos(c, err)
+		return
+	}
+
+	busca := repository.FiltroMovimentos{ItemID: id, DataInicio: filtro.DataInicio}
+	if filtro.Tipo != "" {
+		tipo := models.TipoMovimento(strings.ToUpper(filtro.Tipo))
+		if !slices.Contains(models.TiposMovimento, tipo) {
+			responderErro(c, http.StatusBadRequest, models.ErroDadosInvalidos, traduzir(c, mensagens.EstoqueTipoMovimentoInvalido, filtro.Tipo),
+				models.ErroDetalhe{Campo: "tipo", Mensagem: traduzir(c, mensagens.EstoqueTipoInvalidoCampo), Valor: filtro.Tipo, Permitidos: textos(models.TiposMovimento)})
+			return
+		}
+		busca.Tipo = tipo
+	}
+	if filtro.DataFim != nil {
+		// A data final é inclusiva, então busca até o início do dia seguinte
+		dataFim := filtro.DataFim.AddDate(0, 0, 1)
+		busca.DataFim = &dataFim
+	}
+
+	limite, atual, err := lerPaginacao(c)
+	if err != nil {
+		responderDadosInvalidos(c, err)
+		return
+	}
+	busca.Limite = limite + 1
+	if atual != nil {
+		aposID, err := atual.idNumerico()
+		if err != nil {
+			responderDadosInvalidos(c, err)
+			return
+		}
+		busca.AposID = uint(aposID)
+	}
+
+	movimentos, err := ctrl.store.Estoque().Movimentos(busca)
+	if err != nil {
+		responderErro(c, http.StatusInternalServerError, models.ErroInterno, traduzir(c, mensagens.EstoqueErroBuscar))
+		return
+	}
+
+	c.JSON(http.StatusOK, paginar(movimentos, limite, func(movimento models.MovimentoEstoque) cursor {
+		return cursor{ID: strconv.FormatUint(uint64(movimento.ID), 10)}
+	}))
+}
+
+// @Summary Relatório de estoque em uma data
+// @Description Retorna o saldo de cada item ao fim do dia informado, somado a partir do registro de movimentos
+// @Tags estoque
+// @Accept json
+// @Produce json
+// @Param data query string false "Data do relatório (AAAA-MM-DD); padrão hoje"
+// @Success 200 {object} models.RelatorioEstoqueResponse
+// @Failure 400 {object} models.ErroResponse "Data inválida"
+// @Router /estoque/relatorio [get]
+func (ctrl *EstoqueController) GetRelatorioEstoque(c *gin.Context) {
+	var filtro models.RelatorioEstoqueFiltro
+	if err := c.ShouldBindQuery(&filtro); err != nil {
+		responderDadosInvalidos(c, err)
+		return
+	}
+
+	agora := time.Now()
+	dia := time.Date(agora.Year(), agora.Month(), agora.Day(), 0, 0, 0, 0, agora.Location())
+	if filtro.Data != nil {
+		dia = *filtro.Data
+	}
+
+	saldos, err := ctrl.estoque.StockAt(dia)
+	if err != nil {
+		responderErroServico(c, err)
+		return
+	}
+	if saldos == nil {
+		saldos = []models.SaldoEstoque{}
+	}
+
+	c.JSON(http.StatusOK, models.RelatorioEstoqueResponse{Data: dia.Format(time.DateOnly), Saldos: saldos})
+}
+
+// @Summary Deixa de controlar o estoque de um item
+// @Description Zera o saldo do item com um movimento AJUSTE e deixa de controlá-lo; a partir daí os pedidos
+// @Description não baixam mais o seu estoque. Os movimentos anteriores são mantidos.
+// @Tags estoque
+// @Accept json
+// @Produce json
+// @Param codigo path string true "Código do item"
+// @Success 200 {object} string "O estoque do item deixou de ser controlado"
+// @Failure 400 {object} models.ErroResponse "Código inválido"
+// @Failure 404 {object} models.ErroResponse "Item sem estoque controlado"
+// @Router /estoque/{codigo} [delete]
+func (ctrl *EstoqueController) DeleteEstoqueItem(c *gin.Context) {
+	id, ok := lerCodigoItem(c)
+	if !ok {
+		return
+	}
+
+	if err := ctrl.estoque.StopTracking(id); err != nil {
+		responderErroServico(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": traduzir(c, mensagens.EstoqueRemovido)})
+}
+
+// respostaEstoque converte o saldo no formato devolvido ao cliente
+func respostaEstoque(estoque models.Estoque) models.EstoqueResponse {
+	return models.EstoqueResponse{
+		ItemID:         estoque.ItemID,
+		Descricao:      estoque.Item.Descricao,
+		Quantidade:     estoque.Quantidade,
+		Unidade:        estoque.Unidade,
+		PontoReposicao: estoque.PontoReposicao,
+		AbaixoDoMinimo: estoque.AbaixoDoMinimo(),
+		AtualizadoEm:   estoque.AtualizadoEm,
+	}
+}
+
+// buscarPaginaEstoque aplica a paginação pelo ID do item sobre o estoque e já responde em caso de erro
+func (ctrl *EstoqueController) buscarPaginaEstoque(c *gin.Context, filtro repository.FiltroEstoque) (models.Pagina[models.EstoqueResponse], bool) {
+	limite, atual, err := lerPaginacao(c)
+	if err != nil {
+		responderDadosInvalidos(c, err)
+		return models.Pagina[models.EstoqueResponse]{}, false
+	}
+
+	filtro.Limite = limite + 1
+	if atual != nil {
+		id, err := atual.idNumerico()
+		if err != nil {
+			responderDadosInvalidos(c, err)
+			return models.Pagina[models.EstoqueResponse]{}, false
+		}
+		filtro.AposID = uint(id)
+	}
+
+	estoques, err := ctrl.store.Estoque().Listar(filtro)
+	if err != nil {
+		responderErro(c, http.StatusInternalServerError, models.ErroInterno, traduzir(c, mensagens.EstoqueErroBuscar))
+		return models.Pagina[models.EstoqueResponse]{}, false
+	}
+
+	pagina := paginar(estoques, limite, func(estoque models.Estoque) cursor {
+		return cursor{ID: strconv.FormatUint(uint64(estoque.ItemID), 10)}
+	})
+
+	response := models.Pagina[models.EstoqueResponse]{
+		Dados:      make([]models.EstoqueResponse, 0, len(pagina.Dados)),
+		NextCursor: pagina.NextCursor,
+	}
+	for _, estoque := range pagina.Dados {
+		response.Dados = append(response.Dados, respostaEstoque(estoque))
+	}
+	return response, true
+}
@@ -1,24 +1,42 @@
 package controller
 
 import (
+	"errors"
 	"net/http"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
-	"lanchonete/database"
+	"lanchonete/mensagens"
 	"lanchonete/models"
+	"lanchonete/repository"
 )
 
+// HamburguerController atende as rotas de hambúrgueres e suas receitas
+type HamburguerController struct {
+	hamburguers repository.HamburguerRepository
+	itens       repository.ItemRepository
+}
+
+func NewHamburguerController(hamburguers repository.HamburguerRepository, itens repository.ItemRepository) *HamburguerController {
+	return &HamburguerController{hamburguers: hamburguers, itens: itens}
+}
+
 // @Summary Lista todos os hamburgueres
 // @Description Retorna uma lista de todos os hamburgueres disponíveis
 // @Tags hamburgueres
 // @Accept json
 // @Produce json
-// @Success 200 {array} models.Hamburguer
+// @Param limit query int false "Quantidade máxima de hamburgueres por página (padrão 20, máximo 100)"
+// @Param cursor query string false "Cursor da próxima página, retornado em next_cursor"
+// @Success 200 {object} models.Pagina[models.Hamburguer]
+// @Failure 400 {object} models.ErroResponse "Paginação inválida"
 // @Router /hamburguers [get]
-func GetAllHamburguers(c *gin.Context) {
-	var hamburguers []models.Hamburguer
-	database.DB.Preload("HamburguerIngredientes.Item").Find(&hamburguers)
-	c.JSON(http.StatusOK, hamburguers)
+func (ctrl *HamburguerController) GetAllHamburguers(c *gin.Context) {
+	pagina, ok := ctrl.buscarPaginaHamburguers(c, repository.FiltroHamburguers{})
+	if !ok {
+		return
+	}
+	c.JSON(http.StatusOK, pagina)
 }
 
 // @Summary Busca um hamburguer por ID
@@ -28,27 +46,22 @@ func GetAllHamburguers(c *gin.Context) {
 // @Produce json
 // @Param id path int true "ID do Hamburguer"
 // @Success 200 {object} models.Hamburguer
-// @Failure 404 {object} string "Hamburguer não encontrado"
+// @Failure 404 {object} models.ErroResponse "Hamburguer não encontrado"
 // @Router /hamburguers/{id} [get]
-func GetHamburguerByID(c *gin.Context) {
-	id := c.Param("id")
-	var hamburguer models.Hamburguer
-	
-	// Verifica se existe um hambúrguer com este ID
-	var count int64
-	if err := database.DB.Model(&models.Hamburguer{}).Where("id = ?", id).Count(&count).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Erro ao buscar hambúrguer"})
-		return
-	}
-	
-	if count == 0 {
-		c.JSON(http.StatusNotFound, gin.H{"error": "Hambúrguer não encontrado"})
+func (ctrl *HamburguerController) GetHamburguerByID(c *gin.Context) {
+	id, ok := lerIDHamburguer(c)
+	if !ok {
 		return
 	}
 
 	// Busca o hambúrguer com seus ingredientes
-	if err := database.DB.Preload("HamburguerIngredientes.Item").First(&hamburguer, id).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Erro ao buscar detalhes do hambúrguer"})
+	hamburguer, err := ctrl.hamburguers.Buscar(id)
+	if errors.Is(err, repository.ErrNaoEncontrado) {
+		responderErro(c, http.StatusNotFound, models.ErroNaoEncontrado, traduzir(c, mensagens.HamburguerNaoEncontrado))
+		return
+	}
+	if err != nil {
+		responderErro(c, http.StatusInternalServerError, models.ErroInterno, traduzir(c, mensagens.HamburguerErroBuscar))
 		return
 	}
 
@@ -61,14 +74,18 @@ func GetHamburguerByID(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param nome path string true "Nome do Hamburguer"
-// @Success 200 {object} models.Hamburguer
-// @Failure 404 {object} string
+// @Param limit query int false "Quantidade máxima de hamburgueres por página (padrão 20, máximo 100)"
+// @Param cursor query string false "Cursor da próxima página, retornado em next_cursor"
+// @Success 200 {object} models.Pagina[models.Hamburguer]
+// @Failure 400 {object} models.ErroResponse "Paginação inválida"
 // @Router /hamburguers/nome/{nome} [get]
-func GetHamburguerByName(c *gin.Context) {
-	name := c.Param("Descricao")
-	var hamburguers []models.Hamburguer
-	database.DB.Preload("HamburguerIngredientes.Item").Where("descricao ILIKE ?", "%"+name+"%").Find(&hamburguers)
-	c.JSON(http.StatusOK, hamburguers)
+func (ctrl *HamburguerController) GetHamburguerByName(c *gin.Context) {
+	name := c.Param("nome")
+	pagina, ok := ctrl.buscarPaginaHamburguers(c, repository.FiltroHamburguers{Nome: name})
+	if !ok {
+		return
+	}
+	c.JSON(http.StatusOK, pagina)
 }
 
 // @Summary Cria um novo hamburguer
@@ -78,77 +95,46 @@ func GetHamburguerByName(c *gin.Context) {
 // @Produce json
 // @Param hamburguer body models.HamburguerRequest true "Dados do Hamburguer"
 // @Success 201 {object} models.Hamburguer
-// @Failure 400 {object} string "Erro na validação dos dados"
-// @Failure 404 {object} string "Ingrediente não encontrado"
+// @Failure 400 {object} models.ErroResponse "Erro na validação dos dados ou ingrediente inválido"
+// @Failure 409 {object} models.ErroResponse "Já existe um hambúrguer com este ID"
 // @Router /hamburguers [post]
-func CreateHamburguer(c *gin.Context) {
+func (ctrl *HamburguerController) CreateHamburguer(c *gin.Context) {
 	var request models.HamburguerRequest
 	if err := c.ShouldBindJSON(&request); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos: " + err.Error()})
+		responderDadosInvalidos(c, err)
 		return
 	}
 
 	// Verifica se o hambúrguer já existe
-	var count int64
-	if err := database.DB.Model(&models.Hamburguer{}).Where("id = ?", request.ID).Count(&count).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Erro ao verificar hambúrguer existente"})
+	if _, err := ctrl.hamburguers.Buscar(request.ID); err == nil {
+		responderErro(c, http.StatusConflict, models.ErroJaExiste, traduzir(c, mensagens.HamburguerJaExiste))
 		return
-	}
-	if count > 0 {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Já existe um hambúrguer com este ID"})
+	} else if !errors.Is(err, repository.ErrNaoEncontrado) {
+		responderErro(c, http.StatusInternalServerError, models.ErroInterno, traduzir(c, mensagens.HamburguerErroVerificarExistente))
 		return
 	}
 
-	// Inicia uma transação
-	tx := database.DB.Begin()
+	receita, ok := ctrl.montarReceita(c, request.Ingredientes)
+	if !ok {
+		return
+	}
 
-	// Cria o hambúrguer
 	hamburguer := models.Hamburguer{
-		ID:        request.ID,
-		Descricao: request.Descricao,
-		Preco:     request.Preco,
+		ID:                     request.ID,
+		Descricao:              request.Descricao,
+		Preco:                  request.Preco,
+		Disponivel:             true,
+		HamburguerIngredientes: receita,
 	}
 
-	if err := tx.Create(&hamburguer).Error; err != nil {
-		tx.Rollback()
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Erro ao criar hambúrguer"})
+	// O hambúrguer e a receita são gravados na mesma transação
+	if err := ctrl.hamburguers.Criar(&hamburguer); err != nil {
+		responderErroBanco(c, err, models.ErroJaExiste, mensagens.HamburguerJaExisteOuIngredienteRemovido, mensagens.HamburguerErroCriar)
 		return
 	}
 
-	// Adiciona os ingredientes com suas quantidades
-	for _, ingrediente := range request.Ingredientes {
-		var item models.Item
-		if err := tx.First(&item, ingrediente.ID).Error; err != nil {
-			tx.Rollback()
-			c.JSON(http.StatusNotFound, gin.H{"error": "Ingrediente não encontrado: " + string(ingrediente.ID)})
-			return
-		}
-
-		// Verifica se é um ingrediente
-		if item.Tipo != models.TipoIngrediente {
-			tx.Rollback()
-			c.JSON(http.StatusBadRequest, gin.H{"error": "O item " + string(ingrediente.ID) + " não é um ingrediente"})
-			return
-		}
-
-		hamburguerIngrediente := models.HamburguerIngrediente{
-			HamburguerID: hamburguer.ID,
-			ItemID:       ingrediente.ID,
-			Quantidade:   ingrediente.Quantidade,
-		}
-
-		if err := tx.Create(&hamburguerIngrediente).Error; err != nil {
-			tx.Rollback()
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Erro ao adicionar ingrediente ao hambúrguer"})
-			return
-		}
-	}
-
-	// Commit da transação
-	tx.Commit()
-
 	// Carrega os relacionamentos para retornar
-	database.DB.Preload("HamburguerIngredientes.Item").First(&hamburguer, hamburguer.ID)
+	hamburguer, _ = ctrl.hamburguers.Buscar(hamburguer.ID)
 	c.JSON(http.StatusCreated, hamburguer)
 }
 
@@ -160,104 +146,105 @@ func CreateHamburguer(c *gin.Context) {
 // @Param id path int true "ID do Hamburguer"
 // @Param hamburguer body models.HamburguerUpdateRequest true "Dados do Hamburguer"
 // @Success 200 {object} models.Hamburguer
-// @Failure 400 {object} string "Erro na validação dos dados"
-// @Failure 404 {object} string "Hamburguer não encontrado"
+// @Failure 400 {object} models.ErroResponse "Erro na validação dos dados"
+// @Failure 404 {object} models.ErroResponse "Hamburguer não encontrado"
+// @Failure 409 {object} models.ErroResponse "Hamburguer em pedidos em aberto"
 // @Router /hamburguers/{id} [put]
-func UpdateHamburguer(c *gin.Context) {
-	id := c.Param("id")
+func (ctrl *HamburguerController) UpdateHamburguer(c *gin.Context) {
+	id, ok := lerIDHamburguer(c)
+	if !ok {
+		return
+	}
 
 	// Verifica se o hambúrguer existe
-	var count int64
-	if err := database.DB.Model(&models.Hamburguer{}).Where("id = ?", id).Count(&count).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Erro ao verificar hambúrguer"})
+	hamburguer, err := ctrl.hamburguers.Buscar(id)
+	if errors.Is(err, repository.ErrNaoEncontrado) {
+		responderErro(c, http.StatusNotFound, models.ErroNaoEncontrado, traduzir(c, mensagens.HamburguerNaoEncontrado))
 		return
 	}
-	if count == 0 {
-		c.JSON(http.StatusNotFound, gin.H{"error": "Hambúrguer não encontrado"})
+	if err != nil {
+		responderErro(c, http.StatusInternalServerError, models.ErroInterno, traduzir(c, mensagens.HamburguerErroVerificar))
 		return
 	}
 
 	// Verifica se o hambúrguer está em algum pedido não finalizado
-	if err := database.DB.Table("pedido_hamburgueres").
-		Joins("JOIN pedidos ON pedidos.id = pedido_hamburgueres.pedido_id").
-		Where("pedido_hamburgueres.hamburguer_id = ? AND pedidos.status != ?", id, models.StatusFinalized).
-		Count(&count).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Erro ao verificar pedidos"})
+	emAberto, err := ctrl.hamburguers.EmPedidosAbertos(id)
+	if err != nil {
+		responderErro(c, http.StatusInternalServerError, models.ErroInterno, traduzir(c, mensagens.ErroVerificarPedidos))
 		return
 	}
 
-	if count > 0 {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Não é possível atualizar um hambúrguer que está em pedidos não finalizados"})
+	if emAberto {
+		responderErro(c, http.StatusConflict, models.ErroEmUso, traduzir(c, mensagens.HamburguerEmPedidosAbertos))
 		return
 	}
 
 	var request models.HamburguerUpdateRequest
 	if err := c.ShouldBindJSON(&request); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos: " + err.Error()})
+		responderDadosInvalidos(c, err)
 		return
 	}
 
-	// Inicia uma transação
-	tx := database.DB.Begin()
-
-	// Atualiza o hambúrguer
-	var hamburguer models.Hamburguer
-	if err := tx.First(&hamburguer, id).Error; err != nil {
-		tx.Rollback()
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Erro ao buscar hambúrguer"})
+	receita, ok := ctrl.montarReceita(c, request.Ingredientes)
+	if !ok {
 		return
 	}
 
+	// Atualiza o hambúrguer e substitui os ingredientes antigos
 	hamburguer.Descricao = request.Descricao
 	hamburguer.Preco = request.Preco
+	hamburguer.HamburguerIngredientes = receita
 
-	if err := tx.Save(&hamburguer).Error; err != nil {
-		tx.Rollback()
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Erro ao salvar hambúrguer"})
+	if err := ctrl.hamburguers.Salvar(&hamburguer); err != nil {
+		responderErroBanco(c, err, models.ErroConflito, mensagens.IngredienteRemovidoOperacao, mensagens.HamburguerErroSalvar)
 		return
 	}
 
-	// Remove os ingredientes antigos
-	if err := tx.Where("hamburguer_id = ?", hamburguer.ID).Delete(&models.HamburguerIngrediente{}).Error; err != nil {
-		tx.Rollback()
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Erro ao remover ingredientes antigos"})
+	// Carrega os relacionamentos para retornar
+	hamburguer, _ = ctrl.hamburguers.Buscar(hamburguer.ID)
+	c.JSON(http.StatusOK, hamburguer)
+}
+
+// @Summary Altera a disponibilidade de um hamburguer
+// @Description Marca um hamburguer como disponível ou em falta. Mesmo marcado como disponível, ele só
+// @Description pode ser vendido se todos os ingredientes da receita estiverem disponíveis (disponivel_para_venda).
+// @Tags hamburgueres
+// @Accept json
+// @Produce json
+// @Param id path int true "ID do Hamburguer"
+// @Param disponibilidade body models.DisponibilidadeRequest true "Disponibilidade do hamburguer"
+// @Success 200 {object} models.Hamburguer
+// @Failure 400 {object} models.ErroResponse "ID ou dados inválidos"
+// @Failure 404 {object} models.ErroResponse "Hamburguer não encontrado"
+// @Router /hamburguers/{id}/disponibilidade [put]
+func (ctrl *HamburguerController) UpdateHamburguerDisponibilidade(c *gin.Context) {
+	id, ok := lerIDHamburguer(c)
+	if !ok {
 		return
 	}
 
-	// Adiciona os novos ingredientes
-	for _, ingrediente := range request.Ingredientes {
-		var item models.Item
-		if err := tx.First(&item, ingrediente.ID).Error; err != nil {
-			tx.Rollback()
-			c.JSON(http.StatusNotFound, gin.H{"error": "Ingrediente não encontrado: " + string(ingrediente.ID)})
-			return
-		}
-
-		// Verifica se é um ingrediente
-		if item.Tipo != models.TipoIngrediente {
-			tx.Rollback()
-			c.JSON(http.StatusBadRequest, gin.H{"error": "O item " + string(ingrediente.ID) + " não é um ingrediente"})
-			return
-		}
-
-		hamburguerIngrediente := models.HamburguerIngrediente{
-			HamburguerID: hamburguer.ID,
-			ItemID:       ingrediente.ID,
-			Quantidade:   ingrediente.Quantidade,
-		}
+	var request models.DisponibilidadeRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		responderDadosInvalidos(c, err)
+		return
+	}
 
-		if err := tx.Create(&hamburguerIngrediente).Error; err != nil {
-			tx.Rollback()
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Erro ao adicionar ingrediente ao hambúrguer"})
-			return
-		}
+	err := ctrl.hamburguers.DefinirDisponibilidade(id, *request.Disponivel)
+	if errors.Is(err, repository.ErrNaoEncontrado) {
+		responderErro(c, http.StatusNotFound, models.ErroNaoEncontrado, traduzir(c, mensagens.HamburguerNaoEncontrado))
+		return
+	}
+	if err != nil {
+		responderErro(c, http.StatusInternalServerError, models.ErroInterno, traduzir(c, mensagens.HamburguerErroSalvar))
+		return
 	}
 
-	// Commit da transação
-	tx.Commit()
+	hamburguer, err := ctrl.hamburguers.Buscar(id)
+	if err != nil {
+		responderErro(c, http.StatusInternalServerError, models.ErroInterno, traduzir(c, mensagens.HamburguerErroBuscar))
+		return
+	}
 
-	// Carrega os relacionamentos para retornar
-	database.DB.Preload("HamburguerIngredientes.Item").First(&hamburguer, hamburguer.ID)
 	c.JSON(http.StatusOK, hamburguer)
 }
 
@@ -268,57 +255,159 @@ func UpdateHamburguer(c *gin.Context) {
 // @Produce json
 // @Param id path int true "ID do Hamburguer"
 // @Success 204 "No Content"
-// @Failure 400 {object} string "Erro ao deletar hamburguer"
-// @Failure 404 {object} string "Hamburguer não encontrado"
+// @Failure 400 {object} models.ErroResponse "Erro ao deletar hamburguer"
+// @Failure 404 {object} models.ErroResponse "Hamburguer não encontrado"
+// @Failure 409 {object} models.ErroResponse "Hamburguer está em pedidos em aberto"
 // @Router /hamburguers/{id} [delete]
-func DeleteHamburguer(c *gin.Context) {
-	id := c.Param("id")
+func (ctrl *HamburguerController) DeleteHamburguer(c *gin.Context) {
+	id, ok := lerIDHamburguer(c)
+	if !ok {
+		return
+	}
 
-	// Verifica se o hambúrguer existe
-	var count int64
-	if err := database.DB.Model(&models.Hamburguer{}).Where("id = ?", id).Count(&count).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Erro ao verificar hambúrguer"})
+	// Pedidos encerrados continuam apontando para o hambúrguer removido; os em aberto impedem a remoção
+	emAberto, err := ctrl.hamburguers.EmPedidosAbertos(id)
+	if err != nil {
+		responderErro(c, http.StatusInternalServerError, models.ErroInterno, traduzir(c, mensagens.ErroVerificarPedidos))
 		return
 	}
-	if count == 0 {
-		c.JSON(http.StatusNotFound, gin.H{"error": "Hambúrguer não encontrado"})
+	if emAberto {
+		responderErro(c, http.StatusConflict, models.ErroEmUso, traduzir(c, mensagens.HamburguerEmUso))
 		return
 	}
 
-	// Verifica se o hambúrguer está em algum pedido não finalizado
-	if err := database.DB.Table("pedido_hamburgueres").
-		Joins("JOIN pedidos ON pedidos.id = pedido_hamburgueres.pedido_id").
-		Where("pedido_hamburgueres.hamburguer_id = ? AND pedidos.status != ?", id, models.StatusFinalized).
-		Count(&count).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Erro ao verificar pedidos"})
+	// A receita é mantida, para que o hambúrguer possa ser restaurado
+	err = ctrl.hamburguers.Remover(id)
+	if errors.Is(err, repository.ErrNaoEncontrado) {
+		responderErro(c, http.StatusNotFound, models.ErroNaoEncontrado, traduzir(c, mensagens.HamburguerNaoEncontrado))
 		return
 	}
+	if err != nil {
+		responderErroBanco(c, err, models.ErroEmUso, mensagens.HamburguerEmUso, mensagens.HamburguerErroDeletar)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
 
-	if count > 0 {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Não é possível deletar um hambúrguer que está em pedidos não finalizados"})
+// @Summary Lista os hamburgueres removidos
+// @Description Retorna os hamburgueres removidos logicamente e ainda não expurgados, com suas receitas
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param limit query int false "Quantidade máxima de hamburgueres por página (padrão 20, máximo 100)"
+// @Param cursor query string false "Cursor da próxima página, retornado em next_cursor"
+// @Success 200 {object} models.Pagina[models.Hamburguer]
+// @Failure 400 {object} models.ErroResponse "Paginação inválida"
+// @Router /admin/hamburguers/removidos [get]
+func (ctrl *HamburguerController) GetDeletedHamburguers(c *gin.Context) {
+	pagina, ok := ctrl.buscarPaginaHamburguers(c, repository.FiltroHamburguers{Removidos: true})
+	if !ok {
 		return
 	}
+	c.JSON(http.StatusOK, pagina)
+}
 
-	// Inicia uma transação
-	tx := database.DB.Begin()
+// @Summary Restaura um hamburguer removido
+// @Description Desfaz a remoção lógica de um hamburguer; todos os ingredientes da receita precisam estar ativos
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param id path int true "ID do Hamburguer"
+// @Success 200 {object} models.Hamburguer
+// @Failure 400 {object} models.ErroResponse "ID inválido"
+// @Failure 404 {object} models.ErroResponse "Nenhum hamburguer removido com este ID"
+// @Failure 409 {object} models.ErroResponse "A receita usa ingredientes removidos"
+// @Router /admin/hamburguers/{id}/restaurar [post]
+func (ctrl *HamburguerController) RestoreHamburguer(c *gin.Context) {
+	id, ok := lerIDHamburguer(c)
+	if !ok {
+		return
+	}
 
-	// Remove os ingredientes
-	if err := tx.Where("hamburguer_id = ?", id).Delete(&models.HamburguerIngrediente{}).Error; err != nil {
-		tx.Rollback()
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Erro ao remover ingredientes"})
+	err := ctrl.hamburguers.Restaurar(id)
+	if errors.Is(err, repository.ErrNaoEncontrado) {
+		responderErro(c, http.StatusNotFound, models.ErroNaoEncontrado, traduzir(c, mensagens.HamburguerRemovidoNaoEncontrado))
+		return
+	}
+	if err != nil {
+		responderErroBanco(c, err, models.ErroConflito, mensagens.HamburguerReceitaComItemRemovido, mensagens.HamburguerErroRestaurar)
 		return
 	}
 
-	// Deleta o hambúrguer
-	if err := tx.Delete(&models.Hamburguer{}, id).Error; err != nil {
-		tx.Rollback()
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Erro ao deletar hambúrguer"})
+	hamburguer, err := ctrl.hamburguers.Buscar(id)
+	if err != nil {
+		responderErro(c, http.StatusInternalServerError, models.ErroInterno, traduzir(c, mensagens.HamburguerErroRestaurar))
 		return
 	}
 
-	// Commit da transação
-	tx.Commit()
+	c.JSON(http.StatusOK, hamburguer)
+}
 
-	c.Status(http.StatusNoContent)
+// lerIDHamburguer interpreta o parâmetro id da rota e já responde se ele for inválido
+func lerIDHamburguer(c *gin.Context) (uint, bool) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		responderErro(c, http.StatusBadRequest, models.ErroDadosInvalidos, traduzir(c, mensagens.IDInvalido), models.ErroDetalhe{Campo: "id", Mensagem: traduzir(c, mensagens.NumeroInteiro), Valor: c.Param("id")})
+		return 0, false
+	}
+	return uint(id), true
+}
+
+// montarReceita valida os ingredientes informados e monta a receita do hambúrguer
+func (ctrl *HamburguerController) montarReceita(c *gin.Context, ingredientes []models.IngredienteRequest) ([]models.HamburguerIngrediente, bool) {
+	receita := make([]models.HamburguerIngrediente, 0, len(ingredientes))
+	for i, ingrediente := range ingredientes {
+		campo := "ingredientes[" + strconv.Itoa(i) + "]"
+		codigo := strconv.FormatUint(uint64(ingrediente.ID), 10)
+
+		item, err := ctrl.itens.Buscar(ingrediente.ID)
+		if err != nil {
+			responderErro(c, http.StatusBadRequest, models.ErroDadosInvalidos, traduzir(c, mensagens.IngredienteNaoEncontradoCodigo, codigo),
+				models.ErroDetalhe{Campo: campo, Mensagem: traduzir(c, mensagens.IngredienteNaoEncontrado), Valor: codigo})
+			return nil, false
+		}
+
+		// Verifica se é um ingrediente
+		if item.Tipo != models.TipoIngrediente {
+			responderErro(c, http.StatusBadRequest, models.ErroDadosInvalidos, traduzir(c, mensagens.ItemNaoEIngredienteCodigo, codigo),
+				models.ErroDetalhe{Campo: campo, Mensagem: traduzir(c, mensagens.ItemNaoEIngrediente), Valor: codigo})
+			return nil, false
+		}
+
+		receita = append(receita, models.HamburguerIngrediente{
+			ItemID:     ingrediente.ID,
+			Quantidade: ingrediente.Quantidade,
+		})
+	}
+	return receita, true
 }
 
+// buscarPaginaHamburguers aplica a paginação por ID sobre a listagem de hambúrgueres e já responde em caso de erro
+func (ctrl *HamburguerController) buscarPaginaHamburguers(c *gin.Context, filtro repository.FiltroHamburguers) (models.Pagina[models.Hamburguer], bool) {
+	limite, atual, err := lerPaginacao(c)
+	if err != nil {
+		responderDadosInvalidos(c, err)
+		return models.Pagina[models.Hamburguer]{}, false
+	}
+
+	filtro.Limite = limite + 1
+	if atual != nil {
+		id, err := atual.idNumerico()
+		if err != nil {
+			responderDadosInvalidos(c, err)
+			return models.Pagina[models.Hamburguer]{}, false
+		}
+		filtro.AposID = uint(id)
+	}
+
+	hamburguers, err := ctrl.hamburguers.Listar(filtro)
+	if err != nil {
+		responderErro(c, http.StatusInternalServerError, models.ErroInterno, traduzir(c, mensagens.HamburguersErroBuscar))
+		return models.Pagina[models.Hamburguer]{}, false
+	}
+
+	return paginar(hamburguers, limite, func(hamburguer models.Hamburguer) cursor {
+		return cursor{ID: strconv.FormatUint(uint64(hamburguer.ID), 10)}
+	}), true
+}
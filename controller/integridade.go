@@ -0,0 +1,26 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"lanchonete/mensagens"
+	"lanchonete/models"
+	"lanchonete/repository"
+)
+
+// violaIntegridade indica se o banco recusou a operação por uma chave estrangeira ou chave duplicada
+func violaIntegridade(err error) bool {
+	return errors.Is(err, repository.ErrConflito)
+}
+
+// responderErroBanco responde 409 com o código e a mensagem de conflito quando o banco
+// recusa a operação por integridade e 500 com a mensagem padrão nos demais erros
+func responderErroBanco(c *gin.Context, err error, codigo models.CodigoErro, conflito, mensagem mensagens.Chave) {
+	if violaIntegridade(err) {
+		responderErro(c, http.StatusConflict, codigo, traduzir(c, conflito))
+		return
+	}
+	responderErro(c, http.StatusInternalServerError, models.ErroInterno, traduzir(c, mensagem))
+}
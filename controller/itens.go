@@ -1,46 +1,42 @@
 package controller
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
 
 	"github.com/gin-gonic/gin"
-	"lanchonete/database"
+	"lanchonete/mensagens"
 	"lanchonete/models"
+	"lanchonete/repository"
 )
 
+// ItemController atende as rotas de itens (bebidas e ingredientes)
+type ItemController struct {
+	itens repository.ItemRepository
+}
+
+func NewItemController(itens repository.ItemRepository) *ItemController {
+	return &ItemController{itens: itens}
+}
+
 // @Summary Lista todos os itens
 // @Description Retorna uma lista de todos os itens (bebidas e ingredientes)
 // @Tags itens
 // @Accept json
 // @Produce json
-// @Success 200 {array} models.ItemResponse
-// @Failure 404 {object} string "Nenhum item encontrado"
+// @Param limit query int false "Quantidade máxima de itens por página (padrão 20, máximo 100)"
+// @Param cursor query string false "Cursor da próxima página, retornado em next_cursor"
+// @Success 200 {object} models.Pagina[models.ItemResponse]
+// @Failure 400 {object} models.ErroResponse "Paginação inválida"
 // @Router /itens/todos [get]
-func GetAllItens(c *gin.Context) {
-	var itens []models.Item
-	if err := database.DB.Find(&itens).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Erro ao buscar itens"})
+func (ctrl *ItemController) GetAllItens(c *gin.Context) {
+	pagina, ok := ctrl.buscarPaginaItens(c, repository.FiltroItens{}, mensagens.ItensErroBuscar)
+	if !ok {
 		return
 	}
 
-	if len(itens) == 0 {
-		c.JSON(http.StatusNotFound, gin.H{"error": "Nenhum item encontrado"})
-		return
-	}
-
-	var response []models.ItemResponse
-	for _, item := range itens {
-		response = append(response, models.ItemResponse{
-			ID:        item.ID,
-			Tipo:      string(item.Tipo),
-			Descricao: item.Descricao,
-			Preco:     item.Preco,
-			Extra:     item.Extra,
-		})
-	}
-
-	c.JSON(http.StatusOK, response)
+	c.JSON(http.StatusOK, pagina)
 }
 
 // @Summary Busca um item por código
@@ -50,35 +46,22 @@ func GetAllItens(c *gin.Context) {
 // @Produce json
 // @Param codigo path string true "Código do item"
 // @Success 200 {object} models.ItemResponse
-// @Failure 400 {object} string "Código inválido"
-// @Failure 404 {object} string "Item não encontrado"
+// @Failure 400 {object} models.ErroResponse "Código inválido"
+// @Failure 404 {object} models.ErroResponse "Item não encontrado"
 // @Router /itens/{codigo} [get]
-func GetItem(c *gin.Context) {
-	codigo := c.Param("codigo")
-	if codigo == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Código do item é obrigatório"})
+func (ctrl *ItemController) GetItem(c *gin.Context) {
+	id, ok := lerCodigoItem(c)
+	if !ok {
 		return
 	}
 
-	id, err := strconv.Atoi(codigo)
+	item, err := ctrl.itens.Buscar(id)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Código inválido"})
+		responderErro(c, http.StatusNotFound, models.ErroNaoEncontrado, traduzir(c, mensagens.ItemNaoEncontrado))
 		return
 	}
 
-	var item models.Item
-	if err := database.DB.First(&item, id).Error; err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "Item não encontrado"})
-		return
-	}
-
-	c.JSON(http.StatusOK, models.ItemResponse{
-		ID:        item.ID,
-		Tipo:      string(item.Tipo),
-		Descricao: item.Descricao,
-		Preco:     item.Preco,
-		Extra:     item.Extra,
-	})
+	c.JSON(http.StatusOK, respostaItem(item))
 }
 
 // @Summary Lista todas as bebidas
@@ -86,33 +69,18 @@ func GetItem(c *gin.Context) {
 // @Tags itens
 // @Accept json
 // @Produce json
-// @Success 200 {array} models.ItemResponse
-// @Failure 404 {object} string "Nenhuma bebida encontrada"
+// @Param limit query int false "Quantidade máxima de bebidas por página (padrão 20, máximo 100)"
+// @Param cursor query string false "Cursor da próxima página, retornado em next_cursor"
+// @Success 200 {object} models.Pagina[models.ItemResponse]
+// @Failure 400 {object} models.ErroResponse "Paginação inválida"
 // @Router /itens/bebidas [get]
-func GetBebidas(c *gin.Context) {
-	var bebidas []models.Item
-	if err := database.DB.Where("tipo = ?", models.TipoBebida).Find(&bebidas).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Erro ao buscar bebidas"})
+func (ctrl *ItemController) GetBebidas(c *gin.Context) {
+	pagina, ok := ctrl.buscarPaginaItens(c, repository.FiltroItens{Tipo: models.TipoBebida}, mensagens.BebidasErroBuscar)
+	if !ok {
 		return
 	}
 
-	if len(bebidas) == 0 {
-		c.JSON(http.StatusNotFound, gin.H{"error": "Nenhuma bebida encontrada"})
-		return
-	}
-
-	var response []models.ItemResponse
-	for _, bebida := range bebidas {
-		response = append(response, models.ItemResponse{
-			ID:        bebida.ID,
-			Tipo:      string(bebida.Tipo),
-			Descricao: bebida.Descricao,
-			Preco:     bebida.Preco,
-			Extra:     bebida.Extra,
-		})
-	}
-
-	c.JSON(http.StatusOK, response)
+	c.JSON(http.StatusOK, pagina)
 }
 
 // @Summary Lista todos os ingredientes
@@ -120,33 +88,18 @@ func GetBebidas(c *gin.Context) {
 // @Tags itens
 // @Accept json
 // @Produce json
-// @Success 200 {array} models.ItemResponse
-// @Failure 404 {object} string "Nenhum ingrediente encontrado"
+// @Param limit query int false "Quantidade máxima de ingredientes por página (padrão 20, máximo 100)"
+// @Param cursor query string false "Cursor da próxima página, retornado em next_cursor"
+// @Success 200 {object} models.Pagina[models.ItemResponse]
+// @Failure 400 {object} models.ErroResponse "Paginação inválida"
 // @Router /itens/ingredientes [get]
-func GetIngredientes(c *gin.Context) {
-	var ingredientes []models.Item
-	if err := database.DB.Where("tipo = ?", models.TipoIngrediente).Find(&ingredientes).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Erro ao buscar ingredientes"})
-		return
-	}
-
-	if len(ingredientes) == 0 {
-		c.JSON(http.StatusNotFound, gin.H{"error": "Nenhum ingrediente encontrado"})
+func (ctrl *ItemController) GetIngredientes(c *gin.Context) {
+	pagina, ok := ctrl.buscarPaginaItens(c, repository.FiltroItens{Tipo: models.TipoIngrediente}, mensagens.IngredientesErroBuscar)
+	if !ok {
 		return
 	}
 
-	var response []models.ItemResponse
-	for _, ingrediente := range ingredientes {
-		response = append(response, models.ItemResponse{
-			ID:        ingrediente.ID,
-			Tipo:      string(ingrediente.Tipo),
-			Descricao: ingrediente.Descricao,
-			Preco:     ingrediente.Preco,
-			Extra:     ingrediente.Extra,
-		})
-	}
-
-	c.JSON(http.StatusOK, response)
+	c.JSON(http.StatusOK, pagina)
 }
 
 // @Summary Cria um novo item
@@ -156,54 +109,52 @@ func GetIngredientes(c *gin.Context) {
 // @Produce json
 // @Param item body models.ItemRequest true "Dados do Item"
 // @Success 201 {object} models.ItemResponse
-// @Failure 400 {object} string "Erro na validação dos dados"
+// @Failure 400 {object} models.ErroResponse "Erro na validação dos dados"
+// @Failure 409 {object} models.ErroResponse "Item já existe"
 // @Router /itens [post]
-func CreateItem(c *gin.Context) {
+func (ctrl *ItemController) CreateItem(c *gin.Context) {
 	var request models.ItemRequest
 
 	if err := c.ShouldBindJSON(&request); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		responderDadosInvalidos(c, err)
 		return
 	}
 
 	// Validação do tipo
 	if request.Tipo != string(models.TipoBebida) && request.Tipo != string(models.TipoIngrediente) {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Tipo de item inválido. Use 'BEBIDA' ou 'INGREDIENTE'"})
+		responderErro(c, http.StatusBadRequest, models.ErroDadosInvalidos, traduzir(c, mensagens.ItemTipoInvalido), models.ErroDetalhe{
+			Campo:      "tipo",
+			Mensagem:   traduzir(c, mensagens.ItemTipoInvalidoCampo),
+			Valor:      request.Tipo,
+			Permitidos: []string{string(models.TipoBebida), string(models.TipoIngrediente)},
+		})
 		return
 	}
 
 	// Verifica se o item já existe
-	var count int64
-	if err := database.DB.Model(&models.Item{}).Where("id = ?", request.ID).Count(&count).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Erro ao verificar existência do item"})
+	if _, err := ctrl.itens.Buscar(request.ID); err == nil {
+		responderErro(c, http.StatusConflict, models.ErroJaExiste, traduzir(c, mensagens.ItemJaExiste))
 		return
-	}
-
-	if count > 0 {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Item já existe"})
+	} else if !errors.Is(err, repository.ErrNaoEncontrado) {
+		responderErro(c, http.StatusInternalServerError, models.ErroInterno, traduzir(c, mensagens.ItemErroVerificarExistencia))
 		return
 	}
 
 	item := models.Item{
-		ID:        request.ID,
-		Tipo:      models.TipoItem(request.Tipo),
-		Descricao: request.Descricao,
-		Preco:     request.Preco,
-		Extra:     request.Extra,
+		ID:         request.ID,
+		Tipo:       models.TipoItem(request.Tipo),
+		Descricao:  request.Descricao,
+		Preco:      request.Preco,
+		Extra:      request.Extra,
+		Disponivel: true,
 	}
 
-	if err := database.DB.Create(&item).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Erro ao criar item"})
+	if err := ctrl.itens.Criar(&item); err != nil {
+		responderErroBanco(c, err, models.ErroJaExiste, mensagens.ItemJaExiste, mensagens.ItemErroCriar)
 		return
 	}
 
-	c.JSON(http.StatusCreated, models.ItemResponse{
-		ID:        item.ID,
-		Tipo:      string(item.Tipo),
-		Descricao: item.Descricao,
-		Preco:     item.Preco,
-		Extra:     item.Extra,
-	})
+	c.JSON(http.StatusCreated, respostaItem(item))
 }
 
 // @Summary Atualiza um item existente
@@ -214,59 +165,38 @@ func CreateItem(c *gin.Context) {
 // @Param codigo path string true "Código do item"
 // @Param item body models.ItemUpdateRequest true "Dados do Item"
 // @Success 200 {object} models.ItemResponse
-// @Failure 400 {object} string "Código inválido"
-// @Failure 404 {object} string "Item não encontrado"
+// @Failure 400 {object} models.ErroResponse "Código inválido"
+// @Failure 404 {object} models.ErroResponse "Item não encontrado"
+// @Failure 409 {object} models.ErroResponse "Item em pedidos em aberto"
 // @Router /itens/{codigo} [put]
-func UpdateItem(c *gin.Context) {
-	codigo := c.Param("codigo")
-	if codigo == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Código do item é obrigatório"})
-		return
-	}
-
-	id, err := strconv.Atoi(codigo)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Código inválido"})
+func (ctrl *ItemController) UpdateItem(c *gin.Context) {
+	id, ok := lerCodigoItem(c)
+	if !ok {
 		return
 	}
 
 	var updateRequest models.ItemUpdateRequest
 	if err := c.ShouldBindJSON(&updateRequest); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		responderDadosInvalidos(c, err)
 		return
 	}
 
 	// Verifica se o item existe
-	var item models.Item
-	if err := database.DB.First(&item, id).Error; err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "Item não encontrado"})
+	item, err := ctrl.itens.Buscar(id)
+	if err != nil {
+		responderErro(c, http.StatusNotFound, models.ErroNaoEncontrado, traduzir(c, mensagens.ItemNaoEncontrado))
 		return
 	}
 
 	// Verifica se o item está em algum pedido não finalizado
-	var count int64
-	if item.Tipo == models.TipoBebida {
-		if err := database.DB.Table("pedido_bebidas").
-			Joins("JOIN pedidos ON pedidos.id = pedido_bebidas.pedido_id").
-			Where("pedido_bebidas.item_id = ? AND pedidos.status != ?", id, models.StatusFinalized).
-			Count(&count).Error; err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Erro ao verificar pedidos"})
-			return
-		}
-	} else {
-		if err := database.DB.Table("hamburguer_ingredientes").
-			Joins("JOIN hamburguers ON hamburguers.id = hamburguer_ingredientes.hamburguer_id").
-			Joins("JOIN pedido_hamburgueres ON pedido_hamburgueres.hamburguer_id = hamburguers.id").
-			Joins("JOIN pedidos ON pedidos.id = pedido_hamburgueres.pedido_id").
-			Where("hamburguer_ingredientes.item_id = ? AND pedidos.status != ?", id, models.StatusFinalized).
-			Count(&count).Error; err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Erro ao verificar pedidos"})
-			return
-		}
+	emAberto, err := ctrl.itens.EmPedidosAbertos(item.ID)
+	if err != nil {
+		responderErro(c, http.StatusInternalServerError, models.ErroInterno, traduzir(c, mensagens.ErroVerificarPedidos))
+		return
 	}
 
-	if count > 0 {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Não é possível atualizar um item que está em pedidos não finalizados"})
+	if emAberto {
+		responderErro(c, http.StatusConflict, models.ErroEmUso, traduzir(c, mensagens.ItemEmPedidosAbertos))
 		return
 	}
 
@@ -275,82 +205,222 @@ func UpdateItem(c *gin.Context) {
 	item.Preco = updateRequest.Preco
 	item.Extra = *updateRequest.Extra
 
-	if err := database.DB.Save(&item).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Erro ao atualizar item"})
+	if err := ctrl.itens.Salvar(&item); err != nil {
+		responderErro(c, http.StatusInternalServerError, models.ErroInterno, traduzir(c, mensagens.ItemErroAtualizar))
 		return
 	}
 
-	c.JSON(http.StatusOK, models.ItemResponse{
-		ID:        item.ID,
-		Tipo:      string(item.Tipo),
-		Descricao: item.Descricao,
-		Preco:     item.Preco,
-		Extra:     item.Extra,
-	})
+	c.JSON(http.StatusOK, respostaItem(item))
+}
+
+// @Summary Altera a disponibilidade de um item
+// @Description Marca um item como disponível ou em falta. Hambúrgueres com um ingrediente em falta
+// @Description ficam indisponíveis para venda, e pedidos com itens em falta são recusados.
+// @Tags itens
+// @Accept json
+// @Produce json
+// @Param codigo path string true "Código do item"
+// @Param disponibilidade body models.DisponibilidadeRequest true "Disponibilidade do item"
+// @Success 200 {object} models.ItemResponse
+// @Failure 400 {object} models.ErroResponse "Código ou dados inválidos"
+// @Failure 404 {object} models.ErroResponse "Item não encontrado"
+// @Router /itens/{codigo}/disponibilidade [put]
+func (ctrl *ItemController) UpdateItemDisponibilidade(c *gin.Context) {
+	id, ok := lerCodigoItem(c)
+	if !ok {
+		return
+	}
+
+	var request models.DisponibilidadeRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		responderDadosInvalidos(c, err)
+		return
+	}
+
+	// Ao contrário da atualização, pode ser feita com o item em pedidos em aberto
+	err := ctrl.itens.DefinirDisponibilidade(id, *request.Disponivel)
+	if errors.Is(err, repository.ErrNaoEncontrado) {
+		responderErro(c, http.StatusNotFound, models.ErroNaoEncontrado, traduzir(c, mensagens.ItemNaoEncontrado))
+		return
+	}
+	if err != nil {
+		responderErro(c, http.StatusInternalServerError, models.ErroInterno, traduzir(c, mensagens.ItemErroAtualizar))
+		return
+	}
+
+	item, err := ctrl.itens.Buscar(id)
+	if err != nil {
+		responderErro(c, http.StatusInternalServerError, models.ErroInterno, traduzir(c, mensagens.ItemErroAtualizar))
+		return
+	}
+
+	c.JSON(http.StatusOK, respostaItem(item))
 }
 
 // @Summary Deleta um item existente
-// @Description Remove um item (bebida ou ingrediente) existente pelo código
+// @Description Remove logicamente um item (bebida ou ingrediente) pelo código; ele pode ser restaurado em /admin/itens/{codigo}/restaurar
 // @Tags itens
 // @Accept json
 // @Produce json
 // @Param codigo path string true "Código do item"
 // @Success 200 {object} string "Item removido com sucesso"
-// @Failure 400 {object} string "Código inválido"
-// @Failure 404 {object} string "Item não encontrado"
+// @Failure 400 {object} models.ErroResponse "Código inválido"
+// @Failure 404 {object} models.ErroResponse "Item não encontrado"
+// @Failure 409 {object} models.ErroResponse "Item na receita de hambúrgueres ativos ou em pedidos em aberto"
 // @Router /itens/{codigo} [delete]
-func DeleteItem(c *gin.Context) {
-	codigo := c.Param("codigo")
-	if codigo == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Código do item é obrigatório"})
+func (ctrl *ItemController) DeleteItem(c *gin.Context) {
+	id, ok := lerCodigoItem(c)
+	if !ok {
 		return
 	}
 
-	id, err := strconv.Atoi(codigo)
+	// Verifica se o item existe
+	item, err := ctrl.itens.Buscar(id)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Código inválido"})
+		responderErro(c, http.StatusNotFound, models.ErroNaoEncontrado, traduzir(c, mensagens.ItemNaoEncontrado))
 		return
 	}
 
-	// Verifica se o item existe
-	var item models.Item
-	if err := database.DB.First(&item, id).Error; err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "Item não encontrado"})
+	emAberto, err := ctrl.itens.EmPedidosAbertos(item.ID)
+	if err != nil {
+		responderErro(c, http.StatusInternalServerError, models.ErroInterno, traduzir(c, mensagens.ErroVerificarPedidos))
+		return
+	}
+	if emAberto {
+		responderErro(c, http.StatusConflict, models.ErroEmUso, traduzir(c, mensagens.ItemEmUso))
 		return
 	}
 
-	// Verifica se o item está em algum pedido não finalizado
-	var count int64
-	if item.Tipo == models.TipoBebida {
-		if err := database.DB.Table("pedido_bebidas").
-			Joins("JOIN pedidos ON pedidos.id = pedido_bebidas.pedido_id").
-			Where("pedido_bebidas.item_id = ? AND pedidos.status != ?", id, models.StatusFinalized).
-			Count(&count).Error; err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Erro ao verificar pedidos"})
-			return
-		}
-	} else {
-		if err := database.DB.Table("hamburguer_ingredientes").
-			Joins("JOIN hamburguers ON hamburguers.id = hamburguer_ingredientes.hamburguer_id").
-			Joins("JOIN pedido_hamburgueres ON pedido_hamburgueres.hamburguer_id = hamburguers.id").
-			Joins("JOIN pedidos ON pedidos.id = pedido_hamburgueres.pedido_id").
-			Where("hamburguer_ingredientes.item_id = ? AND pedidos.status != ?", id, models.StatusFinalized).
-			Count(&count).Error; err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Erro ao verificar pedidos"})
-			return
-		}
+	// O repositório recusa remover ingredientes da receita de hambúrgueres ativos
+	if err := ctrl.itens.Remover(item.ID); err != nil {
+		responderErroBanco(c, err, models.ErroEmUso, mensagens.ItemEmUso, mensagens.ItemErroDeletar)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": traduzir(c, mensagens.ItemRemovido)})
+}
+
+// @Summary Lista os itens removidos
+// @Description Retorna os itens (bebidas e ingredientes) removidos logicamente e ainda não expurgados
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param limit query int false "Quantidade máxima de itens por página (padrão 20, máximo 100)"
+// @Param cursor query string false "Cursor da próxima página, retornado em next_cursor"
+// @Success 200 {object} models.Pagina[models.ItemResponse]
+// @Failure 400 {object} models.ErroResponse "Paginação inválida"
+// @Router /admin/itens/removidos [get]
+func (ctrl *ItemController) GetDeletedItens(c *gin.Context) {
+	pagina, ok := ctrl.buscarPaginaItens(c, repository.FiltroItens{Removidos: true}, mensagens.ItensErroBuscar)
+	if !ok {
+		return
 	}
 
-	if count > 0 {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Não é possível deletar um item que está em pedidos não finalizados"})
+	c.JSON(http.StatusOK, pagina)
+}
+
+// @Summary Restaura um item removido
+// @Description Desfaz a remoção lógica de um item (bebida ou ingrediente)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param codigo path string true "Código do item"
+// @Success 200 {object} models.ItemResponse
+// @Failure 400 {object} models.ErroResponse "Código inválido"
+// @Failure 404 {object} models.ErroResponse "Nenhum item removido com este código"
+// @Router /admin/itens/{codigo}/restaurar [post]
+func (ctrl *ItemController) RestoreItem(c *gin.Context) {
+	id, ok := lerCodigoItem(c)
+	if !ok {
 		return
 	}
 
-	// Deleta o item
-	if err := database.DB.Delete(&item).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Erro ao deletar item"})
+	err := ctrl.itens.Restaurar(id)
+	if errors.Is(err, repository.ErrNaoEncontrado) {
+		responderErro(c, http.StatusNotFound, models.ErroNaoEncontrado, traduzir(c, mensagens.ItemRemovidoNaoEncontrado))
 		return
 	}
+	if err != nil {
+		responderErro(c, http.StatusInternalServerError, models.ErroInterno, traduzir(c, mensagens.ItemErroRestaurar))
+		return
+	}
+
+	item, err := ctrl.itens.Buscar(id)
+	if err != nil {
+		responderErro(c, http.StatusInternalServerError, models.ErroInterno, traduzir(c, mensagens.ItemErroRestaurar))
+		return
+	}
+
+	c.JSON(http.StatusOK, respostaItem(item))
+}
+
+// lerCodigoItem interpreta o parâmetro codigo da rota e já responde se ele for inválido
+func lerCodigoItem(c *gin.Context) (uint, bool) {
+	codigo := c.Param("codigo")
+	if codigo == "" {
+		responderErro(c, http.StatusBadRequest, models.ErroDadosInvalidos, traduzir(c, mensagens.ItemCodigoObrigatorio), models.ErroDetalhe{Campo: "codigo", Mensagem: traduzir(c, mensagens.CampoObrigatorio)})
+		return 0, false
+	}
+
+	id, err := strconv.ParseUint(codigo, 10, 64)
+	if err != nil {
+		responderErro(c, http.StatusBadRequest, models.ErroDadosInvalidos, traduzir(c, mensagens.ItemCodigoInvalido), models.ErroDetalhe{Campo: "codigo", Mensagem: traduzir(c, mensagens.NumeroInteiro), Valor: codigo})
+		return 0, false
+	}
+	return uint(id), true
+}
+
+// respostaItem converte o item no formato devolvido ao cliente
+func respostaItem(item models.Item) models.ItemResponse {
+	response := models.ItemResponse{
+		ID:         item.ID,
+		Tipo:       string(item.Tipo),
+		Descricao:  item.Descricao,
+		Preco:      item.Preco,
+		Extra:      item.Extra,
+		Disponivel: item.Disponivel,
+	}
+	if item.DeletedAt.Valid {
+		response.RemovidoEm = &item.DeletedAt.Time
+	}
+	return response
+}
+
+// buscarPaginaItens aplica a paginação por ID sobre a listagem de itens e já responde em caso de erro
+func (ctrl *ItemController) buscarPaginaItens(c *gin.Context, filtro repository.FiltroItens, mensagemErro mensagens.Chave) (models.Pagina[models.ItemResponse], bool) {
+	limite, atual, err := lerPaginacao(c)
+	if err != nil {
+		responderDadosInvalidos(c, err)
+		return models.Pagina[models.ItemResponse]{}, false
+	}
+
+	filtro.Limite = limite + 1
+	if atual != nil {
+		id, err := atual.idNumerico()
+		if err != nil {
+			responderDadosInvalidos(c, err)
+			return models.Pagina[models.ItemResponse]{}, false
+		}
+		filtro.AposID = uint(id)
+	}
+
+	itens, err := ctrl.itens.Listar(filtro)
+	if err != nil {
+		responderErro(c, http.StatusInternalServerError, models.ErroInterno, traduzir(c, mensagemErro))
+		return models.Pagina[models.ItemResponse]{}, false
+	}
+
+	pagina := paginar(itens, limite, func(item models.Item) cursor {
+		return cursor{ID: strconv.FormatUint(uint64(item.ID), 10)}
+	})
+
+	response := models.Pagina[models.ItemResponse]{
+		Dados:      make([]models.ItemResponse, 0, len(pagina.Dados)),
+		NextCursor: pagina.NextCursor,
+	}
+	for _, item := range pagina.Dados {
+		response.Dados = append(response.Dados, respostaItem(item))
+	}
 
-	c.JSON(http.StatusOK, gin.H{"message": "Item removido com sucesso"})
+	return response, true
 }
@@ -0,0 +1,80 @@
+package controller
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"lanchonete/mensagens"
+	"lanchonete/models"
+)
+
+const (
+	limitePadrao = 20
+	limiteMaximo = 100
+)
+
+// cursor identifica o último registro entregue: a ordenação usada, o valor da coluna ordenada e o ID
+type cursor struct {
+	Ordem string `json:"o,omitempty"`
+	Valor any    `json:"v,omitempty"`
+	ID    string `json:"id"`
+}
+
+// lerPaginacao interpreta os parâmetros limit e cursor da query string
+func lerPaginacao(c *gin.Context) (int, *cursor, error) {
+	limite := limitePadrao
+	if valor := c.Query("limit"); valor != "" {
+		n, err := strconv.Atoi(valor)
+		if err != nil || n < 1 {
+			return 0, nil, erroCampo{"limit", mensagens.InteiroPositivo}
+		}
+		limite = min(n, limiteMaximo)
+	}
+
+	valor := c.Query("cursor")
+	if valor == "" {
+		return limite, nil, nil
+	}
+
+	dados, err := base64.RawURLEncoding.DecodeString(valor)
+	if err != nil {
+		return 0, nil, erroCampo{"cursor", mensagens.CursorInvalido}
+	}
+
+	var atual cursor
+	if err := json.Unmarshal(dados, &atual); err != nil || atual.ID == "" {
+		return 0, nil, erroCampo{"cursor", mensagens.CursorInvalido}
+	}
+
+	return limite, &atual, nil
+}
+
+func (c cursor) codificar() string {
+	dados, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(dados)
+}
+
+// paginar recebe até limite+1 registros e monta a página, gerando o cursor
+// da próxima apenas quando sobrou registro além do limite
+func paginar[T any](registros []T, limite int, proximo func(T) cursor) models.Pagina[T] {
+	pagina := models.Pagina[T]{Dados: registros}
+	if len(registros) > limite {
+		pagina.Dados = registros[:limite]
+		pagina.NextCursor = proximo(registros[limite-1]).codificar()
+	}
+	if pagina.Dados == nil {
+		pagina.Dados = []T{}
+	}
+	return pagina
+}
+
+// idNumerico converte o ID do cursor para as tabelas com chave inteira
+func (c cursor) idNumerico() (uint64, error) {
+	id, err := strconv.ParseUint(c.ID, 10, 64)
+	if err != nil {
+		return 0, erroCampo{"cursor", mensagens.CursorInvalido}
+	}
+	return id, nil
+}
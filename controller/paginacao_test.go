@@ -0,0 +1,176 @@
+package controller
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"lanchonete/models"
+	"lanchonete/repository"
+	"lanchonete/service"
+)
+
+// pedidosEmPaginas grava a quantidade de pedidos com poucos valores distintos em cada
+// coluna de ordenação, para que muitos empatem, e retorna a rota de listagem
+func pedidosEmPaginas(t *testing.T, quantidade int) *gin.Engine {
+	t.Helper()
+
+	store := repository.NewMemoriaStore()
+	cliente := models.Cliente{Telefone: "11987654321", Nome: "Cliente", Enderecos: []models.ClienteEndereco{}}
+	if err := store.Clientes().Criar(&cliente); err != nil {
+		t.Fatal(err)
+	}
+
+	inicio := time.Date(2026, time.March, 10, 12, 0, 0, 0, time.UTC)
+	for i := range quantidade {
+		pedido := models.Pedido{
+			Data:       inicio.Add(time.Duration(i%3) * time.Minute),
+			Descricao:  "Pedido de teste",
+			Tipo:       models.TipoPickup,
+			Status:     []models.StatusPedido{models.StatusStarted, models.StatusFinalized}[i%2],
+			Nome:       fmt.Sprintf("Cliente %d", i%4),
+			Telefone:   "11987654321",
+			ValorTotal: models.Centavos(int64(i%2) * 1000),
+		}
+		if err := store.Pedidos().Criar(&pedido); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/pedidos", NewPedidoController(store, service.NewPedidoService(store, nil)).GetAllPedidos)
+	return r
+}
+
+func listar(t *testing.T, r *gin.Engine, query url.Values) (int, models.Pagina[models.PedidoResponse]) {
+	t.Helper()
+
+	resposta := httptest.NewRecorder()
+	r.ServeHTTP(resposta, httptest.NewRequest(http.MethodGet, "/pedidos?"+query.Encode(), nil))
+
+	var pagina models.Pagina[models.PedidoResponse]
+	if resposta.Code == http.StatusOK {
+		if err := json.Unmarshal(resposta.Body.Bytes(), &pagina); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return resposta.Code, pagina
+}
+
+func TestPaginacaoNaoPulaNemRepeteEmpates(t *testing.T) {
+	const total = 37
+	r := pedidosEmPaginas(t, total)
+
+	for _, ordem := range repository.OrdensPedido {
+		for _, direcao := range []string{"asc", "desc"} {
+			t.Run(ordem+" "+direcao, func(t *testing.T) {
+				query := url.Values{"ordem": {ordem}, "direcao": {direcao}, "limit": {"4"}}
+				_, completa := listar(t, r, url.Values{"ordem": {ordem}, "direcao": {direcao}, "limit": {"100"}})
+
+				var paginado []string
+				vistos := map[string]bool{}
+				for {
+					status, pagina := listar(t, r, query)
+					if status != http.StatusOK {
+						t.Fatalf("página %d: status %d", len(paginado)/4+1, status)
+					}
+					for _, pedido := range pagina.Dados {
+						if vistos[pedido.ID.String()] {
+							t.Fatalf("pedido %s repetido", pedido.ID)
+						}
+						vistos[pedido.ID.String()] = true
+						paginado = append(paginado, pedido.ID.String())
+					}
+					if pagina.NextCursor == "" {
+						break
+					}
+					query.Set("cursor", pagina.NextCursor)
+				}
+
+				if len(paginado) != total || len(completa.Dados) != total {
+					t.Fatalf("%d pedidos nas páginas e %d na listagem completa, esperado %d", len(paginado), len(completa.Dados), total)
+				}
+				for i, pedido := range completa.Dados {
+					if paginado[i] != pedido.ID.String() {
+						t.Fatalf("posição %d: %s nas páginas, %s na listagem completa", i, paginado[i], pedido.ID)
+					}
+				}
+			})
+		}
+	}
+}
+
+func TestPaginacaoLimitaOTamanhoDaPagina(t *testing.T) {
+	r := pedidosEmPaginas(t, limiteMaximo+5)
+
+	tamanhos := map[string]int{
+		"":     limitePadrao,
+		"1":    1,
+		"100":  limiteMaximo,
+		"101":  limiteMaximo,
+		"5000": limiteMaximo,
+	}
+	for limite, esperado := range tamanhos {
+		query := url.Values{}
+		if limite != "" {
+			query.Set("limit", limite)
+		}
+		status, pagina := listar(t, r, query)
+		if status != http.StatusOK || len(pagina.Dados) != esperado || pagina.NextCursor == "" {
+			t.Errorf("limit=%q: status %d, %d pedidos, cursor %q; esperado %d pedidos e próxima página", limite, status, len(pagina.Dados), pagina.NextCursor, esperado)
+		}
+	}
+
+	for _, limite := range []string{"0", "-1", "abc", "1.5"} {
+		if status, _ := listar(t, r, url.Values{"limit": {limite}}); status != http.StatusBadRequest {
+			t.Errorf("limit=%q: status %d, esperado 400", limite, status)
+		}
+	}
+}
+
+func TestCursorAdulteradoE400(t *testing.T) {
+	r := pedidosEmPaginas(t, 10)
+
+	_, primeira := listar(t, r, url.Values{"ordem": {"nome"}, "limit": {"2"}})
+	if primeira.NextCursor == "" {
+		t.Fatal("primeira página sem cursor")
+	}
+	id := primeira.Dados[1].ID.String()
+	texto := func(dados string) string { return base64.RawURLEncoding.EncodeToString([]byte(dados)) }
+
+	cursores := map[string]struct {
+		ordem  string
+		cursor string
+	}{
+		"fora do base64":               {"nome", "!!!"},
+		"base64 com padding":           {"nome", base64.URLEncoding.EncodeToString([]byte(`{"id":"x"}`))},
+		"sem JSON":                     {"nome", texto("não é json")},
+		"JSON truncado":                {"nome", primeira.NextCursor[:len(primeira.NextCursor)-4]},
+		"sem ID":                       {"nome", cursor{Ordem: "nome:asc", Valor: "Cliente 0"}.codificar()},
+		"ID que não é UUID":            {"nome", cursor{Ordem: "nome:asc", Valor: "Cliente 0", ID: "42"}.codificar()},
+		"de outra ordenação":           {"data", primeira.NextCursor},
+		"de outra direção":             {"nome", cursor{Ordem: "nome:desc", Valor: "Cliente 0", ID: id}.codificar()},
+		"valor de outro tipo":          {"nome", cursor{Ordem: "nome:asc", Valor: 7, ID: id}.codificar()},
+		"data inválida":                {"data", cursor{Ordem: "data:asc", Valor: "ontem", ID: id}.codificar()},
+		"data como número":             {"data", cursor{Ordem: "data:asc", Valor: 1700000000, ID: id}.codificar()},
+		"valor total com três casas":   {"valor_total", cursor{Ordem: "valor_total:asc", Valor: "10.001", ID: id}.codificar()},
+		"valor total como número":      {"valor_total", cursor{Ordem: "valor_total:asc", Valor: 10, ID: id}.codificar()},
+		"status como objeto":           {"status", cursor{Ordem: "status:asc", Valor: map[string]any{"a": 1}, ID: id}.codificar()},
+		"campos de outro tipo no JSON": {"nome", texto(`{"o":1,"v":"x","id":2}`)},
+	}
+	for nome, caso := range cursores {
+		t.Run(nome, func(t *testing.T) {
+			status, _ := listar(t, r, url.Values{"ordem": {caso.ordem}, "limit": {"2"}, "cursor": {caso.cursor}})
+			if status != http.StatusBadRequest {
+				t.Errorf("status %d, esperado 400", status)
+			}
+		})
+	}
+}
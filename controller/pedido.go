@@ -1,26 +1,268 @@
 package controller
 
 import (
+	"errors"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
-	"lanchonete/database"
+	"github.com/google/uuid"
+	"lanchonete/mensagens"
 	"lanchonete/models"
+	"lanchonete/repository"
+	"lanchonete/service"
 )
 
+// PedidoController atende as rotas de pedidos; as consultas vão direto ao Store e as
+// alterações passam pelo PedidoService, que concentra as regras de negócio
+type PedidoController struct {
+	store   repository.Store
+	pedidos *service.PedidoService
+}
+
+func NewPedidoController(store repository.Store, pedidos *service.PedidoService) *PedidoController {
+	return &PedidoController{store: store, pedidos: pedidos}
+}
+
+// ordenacaoPedido descreve uma ordenação aceita na listagem de pedidos
+type ordenacaoPedido struct {
+	coluna string
+	valor  func(models.Pedido) any // valor da coluna gravado no cursor
+	ler    func(any) (any, bool)   // converte o valor do cursor de volta para a consulta
+}
+
+// ordenacoesPedido mapeia os valores aceitos em "ordem" para as colunas da tabela de pedidos
+var ordenacoesPedido = map[string]ordenacaoPedido{
+	"data": {
+		coluna: "data",
+		valor:  func(p models.Pedido) any { return p.Data.Format(time.RFC3339Nano) },
+		ler: func(v any) (any, bool) {
+			texto, ok := v.(string)
+			if !ok {
+				return nil, false
+			}
+			data, err := time.Parse(time.RFC3339Nano, texto)
+			return data, err == nil
+		},
+	},
+	"valor_total": {
+		coluna: "valor_total",
+		valor:  func(p models.Pedido) any { return p.ValorTotal.String() },
+		ler: func(v any) (any, bool) {
+			texto, ok := v.(string)
+			if !ok {
+				return nil, false
+			}
+			valor, err := models.ParseDinheiro(texto)
+			return valor, err == nil
+		},
+	},
+	"nome": {
+		coluna: "nome",
+		valor:  func(p models.Pedido) any { return p.Nome },
+		ler:    lerTextoCursor,
+	},
+	"status": {
+		coluna: "status",
+		valor:  func(p models.Pedido) any { return string(p.Status) },
+		ler:    lerTextoCursor,
+	},
+}
+
+func lerTextoCursor(v any) (any, bool) {
+	texto, ok := v.(string)
+	return texto, ok
+}
+
 // @Summary Lista todos os pedidos
-// @Description Retorna uma lista de todos os pedidos cadastrados, com opção de filtrar por status não finalizado
+// @Description Retorna os pedidos cadastrados, com filtros por status, tipo, período, telefone e nome do cliente
 // @Tags pedidos
 // @Accept json
 // @Produce json
-// @Param status query string false "Filtrar por status não finalizado (true/false)"
-// @Success 200 {array} models.PedidoResponse
+// @Param status query []string false "Filtra por um ou mais status (ex.: status=STARTED,DELIVERY)" collectionFormat(multi)
+// @Param tipo query string false "Filtra pelo tipo do pedido" Enums(DELIVERY, PICKUP, DINE_IN)
+// @Param abertos query bool false "Somente pedidos não finalizados nem cancelados"
+// @Param data_inicio query string false "Data inicial (AAAA-MM-DD)"
+// @Param data_fim query string false "Data final, inclusiva (AAAA-MM-DD)"
+// @Param telefone query string false "Telefone do cliente"
+// @Param nome query string false "Parte do nome do cliente"
+// @Param ordem query string false "Campo de ordenação" Enums(data, valor_total, nome, status)
+// @Param direcao query string false "Direção da ordenação" Enums(asc, desc)
+// @Param limit query int false "Quantidade máxima de pedidos por página (padrão 20, máximo 100)"
+// @Param cursor query string false "Cursor da próxima página, retornado em next_cursor"
+// @Success 200 {object} models.Pagina[models.PedidoResponse]
+// @Failure 400 {object} models.ErroResponse "Filtro ou paginação inválidos"
 // @Router /pedidos [get]
-func GetAllPedidos(c *gin.Context) {
-	var pedidos []models.Pedido
-	database.DB.Preload("PedidoHamburgueres.Hamburguer").Preload("PedidoBebidas.Bebida").Find(&pedidos)
+func (ctrl *PedidoController) GetAllPedidos(c *gin.Context) {
+	ctrl.listarPedidos(c, repository.FiltroPedidos{})
+}
 
-	c.JSON(http.StatusOK, pedidos)
+// @Summary Lista os pedidos removidos
+// @Description Retorna os pedidos removidos logicamente e ainda não expurgados, com os mesmos filtros da listagem de pedidos
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param status query []string false "Filtra por um ou mais status (ex.: status=STARTED,DELIVERY)" collectionFormat(multi)
+// @Param tipo query string false "Filtra pelo tipo do pedido" Enums(DELIVERY, PICKUP, DINE_IN)
+// @Param abertos query bool false "Somente pedidos não finalizados nem cancelados"
+// @Param data_inicio query string false "Data inicial (AAAA-MM-DD)"
+// @Param data_fim query string false "Data final, inclusiva (AAAA-MM-DD)"
+// @Param telefone query string false "Telefone do cliente"
+// @Param nome query string false "Parte do nome do cliente"
+// @Param ordem query string false "Campo de ordenação" Enums(data, valor_total, nome, status)
+// @Param direcao query string false "Direção da ordenação" Enums(asc, desc)
+// @Param limit query int false "Quantidade máxima de pedidos por página (padrão 20, máximo 100)"
+// @Param cursor query string false "Cursor da próxima página, retornado em next_cursor"
+// @Success 200 {object} models.Pagina[models.PedidoResponse]
+// @Failure 400 {object} models.ErroResponse "Filtro ou paginação inválidos"
+// @Router /admin/pedidos/removidos [get]
+func (ctrl *PedidoController) GetDeletedPedidos(c *gin.Context) {
+	ctrl.listarPedidos(c, repository.FiltroPedidos{Removidos: true})
+}
+
+// @Summary Lista os pedidos de um cliente
+// @Description Retorna o histórico de pedidos do cliente, com os mesmos filtros da listagem de pedidos
+// @Tags clientes
+// @Accept json
+// @Produce json
+// @Param telefone path string true "Telefone do cliente, só com números e DDD"
+// @Param status query []string false "Filtra por um ou mais status (ex.: status=STARTED,DELIVERY)" collectionFormat(multi)
+// @Param tipo query string false "Filtra pelo tipo do pedido" Enums(DELIVERY, PICKUP, DINE_IN)
+// @Param abertos query bool false "Somente pedidos não finalizados nem cancelados"
+// @Param data_inicio query string false "Data inicial (AAAA-MM-DD)"
+// @Param data_fim query string false "Data final, inclusiva (AAAA-MM-DD)"
+// @Param ordem query string false "Campo de ordenação" Enums(data, valor_total, nome, status)
+// @Param direcao query string false "Direção da ordenação" Enums(asc, desc)
+// @Param limit query int false "Quantidade máxima de pedidos por página (padrão 20, máximo 100)"
+// @Param cursor query string false "Cursor da próxima página, retornado em next_cursor"
+// @Success 200 {object} models.Pagina[models.PedidoResponse]
+// @Failure 400 {object} models.ErroResponse "Telefone, filtro ou paginação inválidos"
+// @Failure 404 {object} models.ErroResponse "Cliente não encontrado"
+// @Router /clientes/{telefone}/pedidos [get]
+func (ctrl *PedidoController) GetPedidosCliente(c *gin.Context) {
+	telefone, ok := lerTelefone(c)
+	if !ok {
+		return
+	}
+
+	_, err := ctrl.store.Clientes().Buscar(telefone)
+	if errors.Is(err, repository.ErrNaoEncontrado) {
+		responderErro(c, http.StatusNotFound, models.ErroNaoEncontrado, traduzir(c, mensagens.ClienteNaoEncontrado))
+		return
+	}
+	if err != nil {
+		responderErro(c, http.StatusInternalServerError, models.ErroInterno, traduzir(c, mensagens.ClienteErroBuscar))
+		return
+	}
+
+	ctrl.listarPedidos(c, repository.FiltroPedidos{Telefone: telefone})
+}
+
+// listarPedidos interpreta os filtros, a ordenação e a paginação da query string e
+// responde com a página de pedidos; base traz as restrições da rota, como apenas os
+// removidos logicamente ou apenas os de um cliente, que a query string não substitui
+func (ctrl *PedidoController) listarPedidos(c *gin.Context, base repository.FiltroPedidos) {
+	var filtro models.PedidoFiltro
+	if err := c.ShouldBindQuery(&filtro); err != nil {
+		responderDadosInvalidos(c, err)
+		return
+	}
+
+	busca := base
+	busca.Abertos = filtro.Abertos
+	busca.DataInicio = filtro.DataInicio
+	busca.Nome = filtro.Nome
+	if busca.Telefone == "" {
+		busca.Telefone = filtro.Telefone
+	}
+
+	// Aceita tanto status=A&status=B quanto status=A,B
+	for _, valor := range filtro.Status {
+		for _, s := range strings.Split(valor, ",") {
+			s = strings.ToUpper(strings.TrimSpace(s))
+			if s == "" {
+				continue
+			}
+			if !models.StatusPedido(s).Valido() {
+				responderErro(c, http.StatusBadRequest, models.ErroDadosInvalidos, traduzir(c, mensagens.StatusInvalido, s),
+					models.ErroDetalhe{Campo: "status", Mensagem: traduzir(c, mensagens.StatusInvalidoCampo), Valor: s, Permitidos: textos(models.StatusValidos)})
+				return
+			}
+			busca.Status = append(busca.Status, models.StatusPedido(s))
+		}
+	}
+
+	if filtro.Tipo != "" {
+		tipo := models.TipoPedido(strings.ToUpper(strings.TrimSpace(filtro.Tipo)))
+		if !tipo.Valido() {
+			responderErro(c, http.StatusBadRequest, models.ErroDadosInvalidos, traduzir(c, mensagens.TipoPedidoInvalido, filtro.Tipo),
+				models.ErroDetalhe{Campo: "tipo", Mensagem: traduzir(c, mensagens.TipoPedidoInvalidoCampo), Valor: filtro.Tipo, Permitidos: textos(models.TiposPedido)})
+			return
+		}
+		busca.Tipo = tipo
+	}
+
+	if filtro.DataFim != nil {
+		// A data final é inclusiva, então busca até o início do dia seguinte
+		dataFim := filtro.DataFim.AddDate(0, 0, 1)
+		busca.DataFim = &dataFim
+	}
+
+	chave := "data"
+	if filtro.Ordem != "" {
+		chave = filtro.Ordem
+	}
+	ordenacao, ok := ordenacoesPedido[chave]
+	if !ok {
+		responderErro(c, http.StatusBadRequest, models.ErroDadosInvalidos, traduzir(c, mensagens.OrdenacaoInvalida, filtro.Ordem),
+			models.ErroDetalhe{Campo: "ordem", Mensagem: traduzir(c, mensagens.OrdenacaoInvalidaCampo), Valor: filtro.Ordem, Permitidos: repository.OrdensPedido})
+		return
+	}
+	busca.Ordem = ordenacao.coluna
+
+	direcao := "asc"
+	switch strings.ToLower(filtro.Direcao) {
+	case "", "asc":
+	case "desc":
+		direcao = "desc"
+	default:
+		responderErro(c, http.StatusBadRequest, models.ErroDadosInvalidos, traduzir(c, mensagens.DirecaoInvalida, filtro.Direcao),
+			models.ErroDetalhe{Campo: "direcao", Mensagem: traduzir(c, mensagens.DirecaoInvalidaCampo), Valor: filtro.Direcao, Permitidos: []string{"asc", "desc"}})
+		return
+	}
+	busca.Desc = direcao == "desc"
+
+	limite, atual, err := lerPaginacao(c)
+	if err != nil {
+		responderDadosInvalidos(c, err)
+		return
+	}
+	busca.Limite = limite + 1
+
+	// O cursor só vale para a mesma ordenação em que foi gerado
+	ordemCursor := chave + ":" + direcao
+	if atual != nil {
+		valor, ok := ordenacao.ler(atual.Valor)
+		id, err := uuid.Parse(atual.ID)
+		if atual.Ordem != ordemCursor || !ok || err != nil {
+			responderErro(c, http.StatusBadRequest, models.ErroDadosInvalidos, traduzir(c, mensagens.CursorInvalidoOrdenacao),
+				models.ErroDetalhe{Campo: "cursor", Mensagem: traduzir(c, mensagens.CursorOutraOrdenacao)})
+			return
+		}
+		busca.Apos = &repository.PosicaoPedido{Valor: valor, ID: id}
+	}
+
+	pedidos, err := ctrl.store.Pedidos().Listar(busca)
+	if err != nil {
+		responderErro(c, http.StatusInternalServerError, models.ErroInterno, traduzir(c, mensagens.PedidosErroBuscar))
+		return
+	}
+
+	c.JSON(http.StatusOK, paginar(pedidos, limite, func(pedido models.Pedido) cursor {
+		return cursor{Ordem: ordemCursor, Valor: ordenacao.valor(pedido), ID: pedido.ID.String()}
+	}))
 }
 
 // @Summary Busca um pedido por ID
@@ -29,317 +271,491 @@ func GetAllPedidos(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param id path string true "ID do Pedido"
+// @Param historico query bool false "Inclui o histórico de status do pedido"
 // @Success 200 {object} models.PedidoResponse
-// @Failure 404 {object} string "Pedido não encontrado"
+// @Failure 404 {object} models.ErroResponse "Pedido não encontrado"
 // @Router /pedidos/{id} [get]
-func GetPedidoByID(c *gin.Context) {
-	id := c.Param("id")
-	var pedido models.Pedido
+func (ctrl *PedidoController) GetPedidoByID(c *gin.Context) {
+	id, ok := lerIDPedido(c)
+	if !ok {
+		return
+	}
 
-	if err := database.DB.Preload("PedidoHamburgueres.Hamburguer").Preload("PedidoBebidas.Bebida").First(&pedido, "id = ?", id).Error; err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "Pedido não encontrado"})
+	pedido, err := ctrl.store.Pedidos().Buscar(id)
+	if errors.Is(err, repository.ErrNaoEncontrado) {
+		responderErro(c, http.StatusNotFound, models.ErroNaoEncontrado, traduzir(c, mensagens.PedidoNaoEncontrado))
+		return
+	}
+	if err != nil {
+		responderErro(c, http.StatusInternalServerError, models.ErroInterno, traduzir(c, mensagens.PedidoErroCarregar))
 		return
 	}
 
+	if c.Query("historico") == "true" {
+		if pedido.Historico, err = ctrl.store.Pedidos().Historico(id); err != nil {
+			responderErro(c, http.StatusInternalServerError, models.ErroInterno, traduzir(c, mensagens.PedidoErroBuscarHistorico))
+			return
+		}
+	}
+
 	c.JSON(http.StatusOK, pedido)
 }
 
-// @Summary Cria um novo pedido
-// @Description Cria um novo pedido com os dados fornecidos
+// @Summary Histórico de status de um pedido
+// @Description Retorna a linha do tempo de status do pedido, com o tempo que ele permaneceu em cada etapa
 // @Tags pedidos
 // @Accept json
 // @Produce json
-// @Param pedido body models.PedidoRequest true "Dados do Pedido"
-// @Success 201 {object} models.PedidoResponse
-// @Failure 400 {object} string "Erro na validação dos dados"
-// @Router /pedidos [post]
-func CreatePedido(c *gin.Context) {
-	var request models.PedidoRequest
-	if err := c.ShouldBindJSON(&request); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+// @Param id path string true "ID do Pedido"
+// @Success 200 {array} models.PedidoHistoricoResponse
+// @Failure 404 {object} models.ErroResponse "Pedido não encontrado"
+// @Router /pedidos/{id}/historico [get]
+func (ctrl *PedidoController) GetPedidoHistorico(c *gin.Context) {
+	id, ok := lerIDPedido(c)
+	if !ok {
 		return
 	}
 
-	// Criar o pedido base
-	pedido := models.Pedido{
-		Descricao:   request.Descricao,
-		Nome:        request.Nome,
-		Endereco:    request.Endereco,
-		Telefone:    request.Telefone,
-		Observacoes: request.Observacoes,
-		Status:      models.StatusStarted,
+	_, err := ctrl.store.Pedidos().Buscar(id)
+	if errors.Is(err, repository.ErrNaoEncontrado) {
+		responderErro(c, http.StatusNotFound, models.ErroNaoEncontrado, traduzir(c, mensagens.PedidoNaoEncontrado))
+		return
 	}
-
-	// Iniciar uma transação
-	tx := database.DB.Begin()
-
-	// Criar o pedido
-	if err := tx.Create(&pedido).Error; err != nil {
-		tx.Rollback()
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Erro ao criar pedido"})
+	if err != nil {
+		responderErro(c, http.StatusInternalServerError, models.ErroInterno, traduzir(c, mensagens.PedidoErroCarregar))
 		return
 	}
 
-	valorTotal := 0.0
-
-	// Adicionar hambúrgueres
-	for _, hamburguerReq := range request.Hamburgueres {
-		var hamburguer models.Hamburguer
-		if err := tx.First(&hamburguer, hamburguerReq.ID).Error; err != nil {
-			tx.Rollback()
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Hambúrguer não encontrado"})
-			return
-		}
+	historico, err := ctrl.store.Pedidos().Historico(id)
+	if err != nil {
+		responderErro(c, http.StatusInternalServerError, models.ErroInterno, traduzir(c, mensagens.PedidoErroBuscarHistorico))
+		return
+	}
 
-		pedidoHamburguer := models.PedidoHamburguer{
-			PedidoID:     pedido.ID,
-			HamburguerID: hamburguer.ID,
-			Quantidade:   hamburguerReq.Quantidade,
+	response := make([]models.PedidoHistoricoResponse, 0, len(historico))
+	for i, etapa := range historico {
+		item := models.PedidoHistoricoResponse{
+			StatusAnterior: etapa.StatusAnterior,
+			StatusNovo:     etapa.StatusNovo,
+			Ator:           etapa.Ator,
+			Data:           etapa.Data,
 		}
 
-		if err := tx.Create(&pedidoHamburguer).Error; err != nil {
-			tx.Rollback()
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Erro ao adicionar hambúrguer ao pedido"})
-			return
+		// A duração de uma etapa vai até a próxima transição; a etapa atual ainda não terminou
+		if i+1 < len(historico) {
+			duracao := int64(historico[i+1].Data.Sub(etapa.Data).Seconds())
+			item.DuracaoSegundos = &duracao
 		}
 
-		valorTotal += hamburguer.Preco * float64(hamburguerReq.Quantidade)
+		response = append(response, item)
 	}
 
-	// Adicionar bebidas
-	for _, bebidaReq := range request.Bebidas {
-		var bebida models.Item
-		if err := tx.First(&bebida, bebidaReq.ID).Error; err != nil {
-			tx.Rollback()
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Bebida não encontrada"})
-			return
-		}
-
-		pedidoBebida := models.PedidoBebida{
-			PedidoID:   pedido.ID,
-			ItemID:     bebida.ID,
-			Quantidade: bebidaReq.Quantidade,
-		}
-
-		if err := tx.Create(&pedidoBebida).Error; err != nil {
-			tx.Rollback()
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Erro ao adicionar bebida ao pedido"})
-			return
-		}
+	c.JSON(http.StatusOK, response)
+}
 
-		valorTotal += bebida.Preco * float64(bebidaReq.Quantidade)
+// @Summary Cria um novo pedido
+// @Description Cria um novo pedido com os dados fornecidos e baixa do estoque os itens consumidos. O cliente é
+// @Description identificado pelo telefone: no primeiro pedido, nome e endereço são obrigatórios e o cadastro é
+// @Description criado; depois, o endereço pode ser um dos salvos (endereco_id), um novo, que é adicionado ao
+// @Description cadastro, ou omitido para usar o principal. A zona de entrega que atende o endereço define a taxa,
+// @Description somada ao valor total, o pedido mínimo e o prazo; endereços fora de todas as zonas são recusados com FORA_DA_AREA.
+// @Description O tipo pode ser DELIVERY (padrão), PICKUP, retirado no balcão, ou DINE_IN, consumido na mesa informada em
+// @Description mesa; esses dois não têm endereço nem taxa de entrega, e no primeiro pedido basta o nome do cliente.
+// @Description As promoções automáticas vigentes são aplicadas e gravadas em descontos, junto com a do cupom informado;
+// @Description um cupom inexistente, fora da validade, esgotado ou que não se aplica às linhas é recusado com CUPOM_INVALIDO.
+// @Tags pedidos
+// @Accept json
+// @Produce json
+// @Param pedido body models.PedidoRequest true "Dados do Pedido"
+// @Success 201 {object} models.PedidoResponse
+// @Failure 400 {object} models.ErroResponse "Erro na validação dos dados, linhas inválidas, endereço fora da área de entrega ou cupom inválido"
+// @Failure 409 {object} models.ErroResponse "Produto removido do cardápio durante o pedido ou estoque insuficiente"
+// @Router /pedidos [post]
+func (ctrl *PedidoController) CreatePedido(c *gin.Context) {
+	var request models.PedidoRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		responderDadosInvalidos(c, err)
+		return
 	}
 
-	// Atualizar o valor total do pedido
-	pedido.ValorTotal = valorTotal
-	if err := tx.Save(&pedido).Error; err != nil {
-		tx.Rollback()
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Erro ao atualizar valor total do pedido"})
+	pedido, err := ctrl.pedidos.PlaceOrder(request)
+	if err != nil {
+		responderErroServico(c, err)
 		return
 	}
 
-	// Commit da transação
-	tx.Commit()
+	c.JSON(http.StatusCreated, pedido)
+}
 
-	// Carregar os relacionamentos para retornar
-	database.DB.Preload("PedidoHamburgueres.Hamburguer").Preload("PedidoBebidas.Bebida").First(&pedido, pedido.ID)
+// @Summary Cota um pedido
+// @Description Valida os hambúrgueres e bebidas e retorna o pedido precificado linha a linha, sem gravar nada. Com o
+// @Description endereço informado ou salvo no cadastro do cliente, inclui a taxa de entrega da zona que atende o endereço.
+// @Description O desconto das promoções vigentes e do cupom aparece somado em desconto e detalhado em descontos.
+// @Tags pedidos
+// @Accept json
+// @Produce json
+// @Param pedido body models.PedidoRequest true "Dados do Pedido"
+// @Success 200 {object} models.PedidoCotacaoResponse
+// @Failure 400 {object} models.ErroResponse "Erro na validação dos dados, linhas inválidas, endereço fora da área de entrega ou cupom inválido"
+// @Router /pedidos/cotacao [post]
+func (ctrl *PedidoController) QuotePedido(c *gin.Context) {
+	var request models.PedidoRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		responderDadosInvalidos(c, err)
+		return
+	}
 
-	c.JSON(http.StatusCreated, pedido)
+	cotacao, err := ctrl.pedidos.QuoteOrder(request)
+	if err != nil {
+		responderErroServico(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, respostaCotacao(cotacao))
 }
 
 // @Summary Atualiza um pedido existente
-// @Description Atualiza um pedido existente com os dados fornecidos
+// @Description Atualiza os dados de um pedido existente. O status é alterado apenas por POST /pedidos/{id}/status.
+// @Description Telefone e endereço seguem as regras do cadastro de clientes da criação do pedido. O tipo só pode ser
+// @Description trocado enquanto o pedido está em STARTED; ao deixar de ser entrega, o pedido perde o endereço e a taxa.
+// @Description Os descontos são recalculados quando as linhas ou o cupom mudam, com as promoções vigentes no horário do
+// @Description pedido; sem novo cupom, o já aplicado é mantido enquanto se aplicar às linhas.
 // @Tags pedidos
 // @Accept json
 // @Produce json
 // @Param id path string true "ID do Pedido"
 // @Param pedido body models.PedidoUpdateRequest true "Dados do Pedido"
 // @Success 200 {object} models.PedidoResponse
-// @Failure 400 {object} string "Erro na validação dos dados"
-// @Failure 404 {object} string "Pedido não encontrado"
+// @Failure 400 {object} models.ErroResponse "Erro na validação dos dados, linhas inválidas, endereço fora da área de entrega ou cupom inválido"
+// @Failure 404 {object} models.ErroResponse "Pedido não encontrado"
+// @Failure 409 {object} models.ErroResponse "Pedido finalizado ou cancelado, produto removido do cardápio durante o pedido, estoque insuficiente ou troca de tipo depois do preparo"
 // @Router /pedidos/{id} [put]
-func UpdatePedido(c *gin.Context) {
-	id := c.Param("id")
-	var pedido models.Pedido
-	var request models.PedidoUpdateRequest
-
-	if err := database.DB.First(&pedido, "id = ?", id).Error; err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "Pedido não encontrado"})
+func (ctrl *PedidoController) UpdatePedido(c *gin.Context) {
+	id, ok := lerIDPedido(c)
+	if !ok {
 		return
 	}
 
+	var request models.PedidoUpdateRequest
 	if err := c.ShouldBindJSON(&request); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		responderDadosInvalidos(c, err)
 		return
 	}
 
-	tx := database.DB.Begin()
-
-	// Atualizar campos básicos se fornecidos
-	if request.Descricao != "" {
-		pedido.Descricao = request.Descricao
-	}
-	if request.Status != "" {
-		pedido.Status = request.Status
-	}
-	if request.Nome != "" {
-		pedido.Nome = request.Nome
-	}
-	if request.Endereco != "" {
-		pedido.Endereco = request.Endereco
-	}
-	if request.Telefone != "" {
-		pedido.Telefone = request.Telefone
-	}
-	if request.Observacoes != "" {
-		pedido.Observacoes = request.Observacoes
+	pedido, err := ctrl.pedidos.AmendOrder(id, request)
+	if err != nil {
+		responderErroServico(c, err)
+		return
 	}
 
-	valorTotal := 0.0
-
-	// Atualizar hambúrgueres se fornecidos
-	if len(request.Hamburgueres) > 0 {
-		// Remover relacionamentos existentes
-		if err := tx.Where("pedido_id = ?", pedido.ID).Delete(&models.PedidoHamburguer{}).Error; err != nil {
-			tx.Rollback()
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Erro ao atualizar hambúrgueres"})
-			return
-		}
-
-		// Adicionar novos relacionamentos
-		for _, hamburguerReq := range request.Hamburgueres {
-			var hamburguer models.Hamburguer
-			if err := tx.First(&hamburguer, hamburguerReq.ID).Error; err != nil {
-				tx.Rollback()
-				c.JSON(http.StatusBadRequest, gin.H{"error": "Hambúrguer não encontrado"})
-				return
-			}
-
-			pedidoHamburguer := models.PedidoHamburguer{
-				PedidoID:     pedido.ID,
-				HamburguerID: hamburguer.ID,
-				Quantidade:   hamburguerReq.Quantidade,
-			}
+	c.JSON(http.StatusOK, pedido)
+}
 
-			if err := tx.Create(&pedidoHamburguer).Error; err != nil {
-				tx.Rollback()
-				c.JSON(http.StatusInternalServerError, gin.H{"error": "Erro ao adicionar hambúrguer ao pedido"})
-				return
-			}
+// @Summary Altera o status de um pedido
+// @Description Move o pedido para o próximo status respeitando o fluxo do seu tipo: STARTED → DELIVERY → FINALIZED nas entregas,
+// @Description STARTED → READY_FOR_PICKUP → FINALIZED nas retiradas (PICKUP) e STARTED → SERVED → FINALIZED no consumo no local
+// @Description (DINE_IN). Cancelamentos são feitos por POST /pedidos/{id}/cancelar
+// @Tags pedidos
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do Pedido"
+// @Param status body models.PedidoStatusRequest true "Novo status"
+// @Success 200 {object} models.PedidoResponse
+// @Failure 400 {object} models.ErroResponse "Status inválido"
+// @Failure 404 {object} models.ErroResponse "Pedido não encontrado"
+// @Failure 409 {object} models.ErroResponse "Transição de status não permitida"
+// @Router /pedidos/{id}/status [post]
+func (ctrl *PedidoController) UpdatePedidoStatus(c *gin.Context) {
+	id, ok := lerIDPedido(c)
+	if !ok {
+		return
+	}
 
-			valorTotal += hamburguer.Preco * float64(hamburguerReq.Quantidade)
-		}
-	} else {
-		// Se não foram fornecidos novos hambúrgueres, calcular o valor total com os existentes
-		var pedidoHamburgueres []models.PedidoHamburguer
-		tx.Preload("Hamburguer").Where("pedido_id = ?", pedido.ID).Find(&pedidoHamburgueres)
-		for _, ph := range pedidoHamburgueres {
-			valorTotal += ph.Hamburguer.Preco * float64(ph.Quantidade)
-		}
+	var request models.PedidoStatusRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		responderDadosInvalidos(c, err)
+		return
 	}
 
-	// Atualizar bebidas se fornecidas
-	if len(request.Bebidas) > 0 {
-		// Remover relacionamentos existentes
-		if err := tx.Where("pedido_id = ?", pedido.ID).Delete(&models.PedidoBebida{}).Error; err != nil {
-			tx.Rollback()
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Erro ao atualizar bebidas"})
-			return
-		}
+	pedido, err := ctrl.pedidos.ChangeStatus(id, request)
+	if err != nil {
+		responderErroServico(c, err)
+		return
+	}
 
-		// Adicionar novos relacionamentos
-		for _, bebidaReq := range request.Bebidas {
-			var bebida models.Item
-			if err := tx.First(&bebida, bebidaReq.ID).Error; err != nil {
-				tx.Rollback()
-				c.JSON(http.StatusBadRequest, gin.H{"error": "Bebida não encontrada"})
-				return
-			}
+	c.JSON(http.StatusOK, pedido)
+}
 
-			pedidoBebida := models.PedidoBebida{
-				PedidoID:   pedido.ID,
-				ItemID:     bebida.ID,
-				Quantidade: bebidaReq.Quantidade,
-			}
+// @Summary Cancela um pedido
+// @Description Cancela um pedido ainda não finalizado, registrando quem cancelou, quando, o motivo e se o valor deve ser reembolsado. Os itens consumidos voltam ao estoque
+// @Tags pedidos
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do Pedido"
+// @Param cancelamento body models.PedidoCancelamentoRequest true "Dados do cancelamento"
+// @Success 200 {object} models.PedidoResponse
+// @Failure 400 {object} models.ErroResponse "Motivo de cancelamento inválido"
+// @Failure 404 {object} models.ErroResponse "Pedido não encontrado"
+// @Failure 409 {object} models.ErroResponse "Pedido não pode ser cancelado"
+// @Router /pedidos/{id}/cancelar [post]
+func (ctrl *PedidoController) CancelPedido(c *gin.Context) {
+	id, ok := lerIDPedido(c)
+	if !ok {
+		return
+	}
 
-			if err := tx.Create(&pedidoBebida).Error; err != nil {
-				tx.Rollback()
-				c.JSON(http.StatusInternalServerError, gin.H{"error": "Erro ao adicionar bebida ao pedido"})
-				return
-			}
+	var request models.PedidoCancelamentoRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		responderDadosInvalidos(c, err)
+		return
+	}
 
-			valorTotal += bebida.Preco * float64(bebidaReq.Quantidade)
-		}
-	} else {
-		// Se não foram fornecidas novas bebidas, calcular o valor total com as existentes
-		var pedidoBebidas []models.PedidoBebida
-		tx.Preload("Bebida").Where("pedido_id = ?", pedido.ID).Find(&pedidoBebidas)
-		for _, pb := range pedidoBebidas {
-			valorTotal += pb.Bebida.Preco * float64(pb.Quantidade)
-		}
+	pedido, err := ctrl.pedidos.CancelOrder(id, request)
+	if err != nil {
+		responderErroServico(c, err)
+		return
 	}
 
-	// Atualizar o valor total
-	pedido.ValorTotal = valorTotal
+	c.JSON(http.StatusOK, pedido)
+}
 
-	if err := tx.Save(&pedido).Error; err != nil {
-		tx.Rollback()
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Erro ao atualizar pedido"})
+// @Summary Registra o reembolso de um pedido cancelado
+// @Description Marca como reembolsado um pedido cancelado que tinha reembolso pendente
+// @Tags pedidos
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do Pedido"
+// @Success 200 {object} models.PedidoResponse
+// @Failure 404 {object} models.ErroResponse "Pedido não encontrado"
+// @Failure 409 {object} models.ErroResponse "Pedido sem reembolso pendente"
+// @Router /pedidos/{id}/reembolso [post]
+func (ctrl *PedidoController) RefundPedido(c *gin.Context) {
+	id, ok := lerIDPedido(c)
+	if !ok {
 		return
 	}
 
-	tx.Commit()
-
-	// Carregar os relacionamentos atualizados
-	database.DB.Preload("PedidoHamburgueres.Hamburguer").Preload("PedidoBebidas.Bebida").First(&pedido, pedido.ID)
+	pedido, err := ctrl.pedidos.RefundOrder(id)
+	if err != nil {
+		responderErroServico(c, err)
+		return
+	}
 
 	c.JSON(http.StatusOK, pedido)
 }
 
 // @Summary Deleta um pedido
-// @Description Deleta um pedido existente
+// @Description Remove logicamente um pedido, mantendo linhas e histórico; ele pode ser restaurado em /admin/pedidos/{id}/restaurar
 // @Tags pedidos
 // @Accept json
 // @Produce json
 // @Param id path string true "ID do Pedido"
 // @Success 204 "No Content"
-// @Failure 400 {object} string "Erro ao deletar pedido"
-// @Failure 404 {object} string "Pedido não encontrado"
+// @Failure 400 {object} models.ErroResponse "Erro ao deletar pedido"
+// @Failure 404 {object} models.ErroResponse "Pedido não encontrado"
 // @Router /pedidos/{id} [delete]
-func DeletePedido(c *gin.Context) {
-	id := c.Param("id")
-	var pedido models.Pedido
+func (ctrl *PedidoController) DeletePedido(c *gin.Context) {
+	id, ok := lerIDPedido(c)
+	if !ok {
+		return
+	}
 
-	if err := database.DB.First(&pedido, "id = ?", id).Error; err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "Pedido não encontrado"})
+	// Linhas, personalizações e histórico são mantidos até o expurgo
+	err := ctrl.store.Pedidos().Remover(id)
+	if errors.Is(err, repository.ErrNaoEncontrado) {
+		responderErro(c, http.StatusNotFound, models.ErroNaoEncontrado, traduzir(c, mensagens.PedidoNaoEncontrado))
+		return
+	}
+	if err != nil {
+		responderErro(c, http.StatusInternalServerError, models.ErroInterno, traduzir(c, mensagens.PedidoErroDeletar))
 		return
 	}
 
-	tx := database.DB.Begin()
+	c.JSON(http.StatusOK, gin.H{"message": traduzir(c, mensagens.PedidoDeletado)})
+}
 
-	// Remover relacionamentos com hambúrgueres
-	if err := tx.Where("pedido_id = ?", pedido.ID).Delete(&models.PedidoHamburguer{}).Error; err != nil {
-		tx.Rollback()
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Erro ao deletar relacionamentos com hambúrgueres"})
+// @Summary Restaura um pedido removido
+// @Description Desfaz a remoção lógica de um pedido, com suas linhas e seu histórico
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do Pedido"
+// @Success 200 {object} models.PedidoResponse
+// @Failure 404 {object} models.ErroResponse "Nenhum pedido removido com este ID"
+// @Router /admin/pedidos/{id}/restaurar [post]
+func (ctrl *PedidoController) RestorePedido(c *gin.Context) {
+	id, ok := lerIDPedido(c)
+	if !ok {
 		return
 	}
 
-	// Remover relacionamentos com bebidas
-	if err := tx.Where("pedido_id = ?", pedido.ID).Delete(&models.PedidoBebida{}).Error; err != nil {
-		tx.Rollback()
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Erro ao deletar relacionamentos com bebidas"})
+	err := ctrl.store.Pedidos().Restaurar(id)
+	if errors.Is(err, repository.ErrNaoEncontrado) {
+		responderErro(c, http.StatusNotFound, models.ErroNaoEncontrado, traduzir(c, mensagens.PedidoRemovidoNaoEncontrado))
+		return
+	}
+	if err != nil {
+		responderErro(c, http.StatusInternalServerError, models.ErroInterno, traduzir(c, mensagens.PedidoErroRestaurar))
 		return
 	}
 
-	// Deletar o pedido
-	if err := tx.Delete(&pedido).Error; err != nil {
-		tx.Rollback()
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Erro ao deletar pedido"})
+	pedido, err := ctrl.store.Pedidos().Buscar(id)
+	if err != nil {
+		responderErro(c, http.StatusInternalServerError, models.ErroInterno, traduzir(c, mensagens.PedidoErroRestaurar))
 		return
 	}
 
-	tx.Commit()
+	c.JSON(http.StatusOK, pedido)
+}
 
-	c.JSON(http.StatusOK, gin.H{"message": "Pedido deletado com sucesso"})
+// lerIDPedido interpreta o parâmetro id da rota; um ID inválido não corresponde a nenhum pedido
+func lerIDPedido(c *gin.Context) (uuid.UUID, bool) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		responderErro(c, http.StatusNotFound, models.ErroNaoEncontrado, traduzir(c, mensagens.PedidoNaoEncontrado))
+		return uuid.Nil, false
+	}
+	return id, true
 }
 
+// respostaCotacao detalha a cotação do serviço no formato devolvido ao cliente
+func respostaCotacao(cotacao service.Cotacao) models.PedidoCotacaoResponse {
+	response := models.PedidoCotacaoResponse{
+		Hamburgueres: make([]models.CotacaoHamburguer, 0, len(cotacao.Hamburgueres)),
+		Bebidas:      make([]models.CotacaoBebida, 0, len(cotacao.Bebidas)),
+		Subtotal:     cotacao.Subtotal,
+		TaxaEntrega:  cotacao.TaxaEntrega,
+		Desconto:     cotacao.Desconto,
+		Descontos:    make([]models.CotacaoDesconto, 0, len(cotacao.Descontos)),
+		ValorTotal:   cotacao.ValorTotal,
+	}
+	for _, desconto := range cotacao.Descontos {
+		response.Descontos = append(response.Descontos, models.CotacaoDesconto{
+			PromocaoID: *desconto.PromocaoID,
+			Descricao:  desconto.Descricao,
+			Cupom:      desconto.Cupom,
+			Valor:      desconto.Valor,
+		})
+	}
+	if zona := cotacao.Zona; zona != nil {
+		response.Entrega = &models.CotacaoEntrega{
+			ZonaID:       zona.ID,
+			Zona:         zona.Nome,
+			Taxa:         zona.Taxa,
+			PedidoMinimo: zona.PedidoMinimo,
+			PrazoMinutos: zona.PrazoMinutos,
+		}
+	}
+
+	for _, linha := range cotacao.Hamburgueres {
+		item := models.CotacaoHamburguer{
+			HamburguerID:  linha.HamburguerID,
+			Descricao:     linha.Descricao,
+			Quantidade:    linha.Quantidade,
+			PrecoUnitario: linha.PrecoUnitario,
+			Adicionais:    []models.CotacaoAdicional{},
+			Removidos:     []models.CotacaoRemovido{},
+			Subtotal:      linha.Subtotal(),
+		}
+		for _, personalizacao := range linha.Personalizacoes {
+			if personalizacao.Acao == models.AcaoAdicionar {
+				item.Adicionais = append(item.Adicionais, models.CotacaoAdicional{
+					ItemID:        personalizacao.ItemID,
+					Descricao:     personalizacao.Descricao,
+					Quantidade:    personalizacao.Quantidade,
+					PrecoUnitario: personalizacao.PrecoUnitario,
+				})
+				continue
+			}
+			item.Removidos = append(item.Removidos, models.CotacaoRemovido{
+				ItemID:    personalizacao.ItemID,
+				Descricao: personalizacao.Descricao,
+			})
+		}
+		response.Hamburgueres = append(response.Hamburgueres, item)
+	}
+
+	for _, linha := range cotacao.Bebidas {
+		response.Bebidas = append(response.Bebidas, models.CotacaoBebida{
+			ItemID:        linha.ItemID,
+			Descricao:     linha.Descricao,
+			Quantidade:    linha.Quantidade,
+			PrecoUnitario: linha.PrecoUnitario,
+			Subtotal:      linha.Subtotal(),
+		})
+	}
+
+	return response
+}
+
+// responderErroServico traduz a categoria do erro do serviço de pedidos no código e no status HTTP
+func responderErroServico(c *gin.Context, err error) {
+	var linhas *service.ErroLinhas
+	if errors.As(err, &linhas) {
+		detalhes := make([]models.ErroDetalhe, 0, len(linhas.Linhas))
+		for _, linha := range linhas.Linhas {
+			detalhes = append(detalhes, models.ErroDetalhe{
+				Campo:    linha.Campo,
+				Mensagem: linha.Motivo.Traduzir(idioma(c)),
+				Valor:    strconv.FormatUint(uint64(linha.ID), 10),
+			})
+		}
+		responderErro(c, http.StatusBadRequest, models.ErroDadosInvalidos, linhas.Resumo().Traduzir(idioma(c)), detalhes...)
+		return
+	}
+
+	var transicao *service.ErroTransicao
+	if errors.As(err, &transicao) {
+		responderErro(c, http.StatusConflict, models.ErroTransicaoInvalida, transicao.Mensagem.Traduzir(idioma(c)), models.ErroDetalhe{
+			Campo:      "status",
+			Mensagem:   traduzir(c, mensagens.TransicaoNaoPermitida),
+			Valor:      string(transicao.StatusAtual),
+			Permitidos: textos(transicao.StatusPermitidos),
+		})
+		return
+	}
+
+	var estoque *service.ErroEstoque
+	if errors.As(err, &estoque) {
+		detalhes := make([]models.ErroDetalhe, 0, len(estoque.Faltas))
+		for _, falta := range estoque.Faltas {
+			detalhes = append(detalhes, models.ErroDetalhe{
+				Campo:    "estoque",
+				Mensagem: falta.Motivo().Traduzir(idioma(c)),
+				Valor:    strconv.FormatUint(uint64(falta.ItemID), 10),
+			})
+		}
+		responderErro(c, http.StatusConflict, models.ErroEstoqueInsuficiente, estoque.Resumo().Traduzir(idioma(c)), detalhes...)
+		return
+	}
+
+	mensagem := traduzir(c, mensagens.PedidoErroProcessar)
+	var erro *service.Erro
+	if errors.As(err, &erro) {
+		mensagem = erro.Mensagem.Traduzir(idioma(c))
+	}
+
+	switch {
+	case errors.Is(err, service.ErrForaDaArea):
+		responderErro(c, http.StatusBadRequest, models.ErroForaDaArea, mensagem)
+	case errors.Is(err, service.ErrCupomInvalido):
+		responderErro(c, http.StatusBadRequest, models.ErroCupomInvalido, mensagem)
+	case errors.Is(err, service.ErrValidacao):
+		responderErro(c, http.StatusBadRequest, models.ErroDadosInvalidos, mensagem)
+	case errors.Is(err, service.ErrNaoEncontrado):
+		responderErro(c, http.StatusNotFound, models.ErroNaoEncontrado, mensagem)
+	case errors.Is(err, service.ErrConflito):
+		responderErro(c, http.StatusConflict, models.ErroConflito, mensagem)
+	default:
+		responderErro(c, http.StatusInternalServerError, models.ErroInterno, mensagem)
+	}
+}
+
+// textos converte valores enumerados, como os status, para a lista de valores aceitos
+func textos[T ~string](valores []T) []string {
+	lista := make([]string, len(valores))
+	for i, valor := range valores {
+		lista[i] = string(valor)
+	}
+	return lista
+}
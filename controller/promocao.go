@@ -0,0 +1,340 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"lanchonete/mensagens"
+	"lanchonete/models"
+	"lanchonete/repository"
+)
+
+// PromocaoController atende as rotas das promoções. Os descontos são calculados pelo
+// serviço de pedidos, na criação, na cotação e na alteração.
+type PromocaoController struct {
+	promocoes   repository.PromocaoRepository
+	hamburguers repository.HamburguerRepository
+	itens       repository.ItemRepository
+}
+
+func NewPromocaoController(promocoes repository.PromocaoRepository, hamburguers repository.HamburguerRepository, itens repository.ItemRepository) *PromocaoController {
+	return &PromocaoController{promocoes: promocoes, hamburguers: hamburguers, itens: itens}
+}
+
+// @Summary Lista as promoções
+// @Description Retorna as promoções com o tipo, o cupom, a validade, o happy hour e os limites de uso
+// @Tags promocoes
+// @Accept json
+// @Produce json
+// @Param ativas query bool false "Somente as promoções ativas"
+// @Param limit query int false "Quantidade máxima de promoções por página (padrão 20, máximo 100)"
+// @Param cursor query string false "Cursor da próxima página, retornado em next_cursor"
+// @Success 200 {object} models.Pagina[models.Promocao]
+// @Failure 400 {object} models.ErroResponse "Paginação inválida"
+// @Router /promocoes [get]
+func (ctrl *PromocaoController) GetAllPromocoes(c *gin.Context) {
+	limite, atual, err := lerPaginacao(c)
+	if err != nil {
+		responderDadosInvalidos(c, err)
+		return
+	}
+
+	filtro := repository.FiltroPromocoes{Ativas: c.Query("ativas") == "true", Limite: limite + 1}
+	if atual != nil {
+		id, err := atual.idNumerico()
+		if err != nil {
+			responderDadosInvalidos(c, err)
+			return
+		}
+		filtro.AposID = uint(id)
+	}
+
+	promocoes, err := ctrl.promocoes.Listar(filtro)
+	if err != nil {
+		responderErro(c, http.StatusInternalServerError, models.ErroInterno, traduzir(c, mensagens.PromocaoErroBuscar))
+		return
+	}
+
+	c.JSON(http.StatusOK, paginar(promocoes, limite, func(promocao models.Promocao) cursor {
+		return cursor{ID: strconv.FormatUint(uint64(promocao.ID), 10)}
+	}))
+}
+
+// @Summary Busca uma promoção
+// @Description Retorna uma promoção pelo ID
+// @Tags promocoes
+// @Accept json
+// @Produce json
+// @Param id path int true "ID da promoção"
+// @Success 200 {object} models.Promocao
+// @Failure 400 {object} models.ErroResponse "ID inválido"
+// @Failure 404 {object} models.ErroResponse "Promoção não encontrada"
+// @Router /promocoes/{id} [get]
+func (ctrl *PromocaoController) GetPromocao(c *gin.Context) {
+	promocao, ok := ctrl.buscarPromocao(c)
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusOK, promocao)
+}
+
+// @Summary Cria uma promoção
+// @Description Cria uma promoção de um dos tipos: PERCENTUAL (percentual sobre o subtotal), VALOR_FIXO (valor abatido do
+// @Description subtotal), LEVE_GANHE (uma bebida grátis a cada quantidade do hambúrguer; sem bebida_id, a mais barata do
+// @Description pedido) ou COMBO (hambúrguer e bebida juntos pelo valor do combo). Com cupom, a promoção só vale nos pedidos
+// @Description que informam o código; sem cupom, é aplicada a todos os pedidos que cumprem a regra. hora_inicio e hora_fim
+// @Description restringem a promoção a uma janela diária, como um happy hour, que pode passar da meia-noite.
+// @Tags promocoes
+// @Accept json
+// @Produce json
+// @Param promocao body models.PromocaoRequest true "Dados da promoção"
+// @Success 201 {object} models.Promocao
+// @Failure 400 {object} models.ErroResponse "Erro na validação dos dados"
+// @Failure 409 {object} models.ErroResponse "Já existe uma promoção com o nome ou o cupom"
+// @Router /promocoes [post]
+func (ctrl *PromocaoController) CreatePromocao(c *gin.Context) {
+	var promocao models.Promocao
+	if !ctrl.lerPromocao(c, &promocao) {
+		return
+	}
+
+	if err := ctrl.promocoes.Criar(&promocao); err != nil {
+		responderErroBanco(c, err, models.ErroJaExiste, mensagens.PromocaoJaExiste, mensagens.PromocaoErroCriar)
+		return
+	}
+
+	c.JSON(http.StatusCreated, promocao)
+}
+
+// @Summary Atualiza uma promoção
+// @Description Substitui os dados da promoção. Pedidos já feitos mantêm o desconto concedido.
+// @Tags promocoes
+// @Accept json
+// @Produce json
+// @Param id path int true "ID da promoção"
+// @Param promocao body models.PromocaoRequest true "Dados da promoção"
+// @Success 200 {object} models.Promocao
+// @Failure 400 {object} models.ErroResponse "ID ou dados inválidos"
+// @Failure 404 {object} models.ErroResponse "Promoção não encontrada"
+// @Failure 409 {object} models.ErroResponse "Já existe outra promoção com o nome ou o cupom"
+// @Router /promocoes/{id} [put]
+func (ctrl *PromocaoController) UpdatePromocao(c *gin.Context) {
+	promocao, ok := ctrl.buscarPromocao(c)
+	if !ok {
+		return
+	}
+	if !ctrl.lerPromocao(c, &promocao) {
+		return
+	}
+
+	if err := ctrl.promocoes.Salvar(&promocao); err != nil {
+		responderErroBanco(c, err, models.ErroJaExiste, mensagens.PromocaoJaExiste, mensagens.PromocaoErroAtualizar)
+		return
+	}
+
+	c.JSON(http.StatusOK, promocao)
+}
+
+// @Summary Remove uma promoção
+// @Description Apaga a promoção; os pedidos que a usaram mantêm o desconto, sem o vínculo.
+// @Description Para suspender a promoção temporariamente, desative-a com ativa false.
+// @Tags promocoes
+// @Accept json
+// @Produce json
+// @Param id path int true "ID da promoção"
+// @Success 200 {object} string "Promoção removida com sucesso"
+// @Failure 400 {object} models.ErroResponse "ID inválido"
+// @Failure 404 {object} models.ErroResponse "Promoção não encontrada"
+// @Router /promocoes/{id} [delete]
+func (ctrl *PromocaoController) DeletePromocao(c *gin.Context) {
+	id, ok := lerIDPromocao(c)
+	if !ok {
+		return
+	}
+
+	err := ctrl.promocoes.Remover(id)
+	if errors.Is(err, repository.ErrNaoEncontrado) {
+		responderErro(c, http.StatusNotFound, models.ErroNaoEncontrado, traduzir(c, mensagens.PromocaoNaoEncontrada))
+		return
+	}
+	if err != nil {
+		responderErro(c, http.StatusInternalServerError, models.ErroInterno, traduzir(c, mensagens.PromocaoErroRemover))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": traduzir(c, mensagens.PromocaoRemovida)})
+}
+
+// buscarPromocao carrega a promoção do ID da rota, respondendo com o erro se não encontrar
+func (ctrl *PromocaoController) buscarPromocao(c *gin.Context) (models.Promocao, bool) {
+	id, ok := lerIDPromocao(c)
+	if !ok {
+		return models.Promocao{}, false
+	}
+
+	promocao, err := ctrl.promocoes.Buscar(id)
+	if errors.Is(err, repository.ErrNaoEncontrado) {
+		responderErro(c, http.StatusNotFound, models.ErroNaoEncontrado, traduzir(c, mensagens.PromocaoNaoEncontrada))
+		return models.Promocao{}, false
+	}
+	if err != nil {
+		responderErro(c, http.StatusInternalServerError, models.ErroInterno, traduzir(c, mensagens.PromocaoErroBuscar))
+		return models.Promocao{}, false
+	}
+	return promocao, true
+}
+
+// lerPromocao valida o corpo da requisição e o copia para a promoção. Cada tipo exige
+// os seus campos; os que não se aplicam ao tipo são descartados.
+func (ctrl *PromocaoController) lerPromocao(c *gin.Context, promocao *models.Promocao) bool {
+	var request models.PromocaoRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		responderDadosInvalidos(c, err)
+		return false
+	}
+
+	tipo := models.TipoPromocao(strings.ToUpper(string(request.Tipo)))
+	if !tipo.Valido() {
+		responderErro(c, http.StatusBadRequest, models.ErroDadosInvalidos, traduzir(c, mensagens.PromocaoTipoInvalido, request.Tipo),
+			models.ErroDetalhe{Campo: "tipo", Mensagem: traduzir(c, mensagens.PromocaoTipoInvalidoCampo), Valor: string(request.Tipo), Permitidos: textos(models.TiposPromocao)})
+		return false
+	}
+
+	var faltando []string
+	switch tipo {
+	case models.PromocaoPercentual:
+		if request.Percentual == 0 {
+			faltando = append(faltando, "percentual")
+		}
+	case models.PromocaoValorFixo:
+		if request.Valor == 0 {
+			faltando = append(faltando, "valor")
+		}
+	case models.PromocaoLeveGanhe:
+		if request.HamburguerID == nil {
+			faltando = append(faltando, "hamburguer_id")
+		}
+		if request.Quantidade == 0 {
+			faltando = append(faltando, "quantidade")
+		}
+	case models.PromocaoCombo:
+		if request.HamburguerID == nil {
+			faltando = append(faltando, "hamburguer_id")
+		}
+		if request.BebidaID == nil {
+			faltando = append(faltando, "bebida_id")
+		}
+		if request.Valor == 0 {
+			faltando = append(faltando, "valor")
+		}
+	}
+	if len(faltando) > 0 {
+		detalhes := make([]models.ErroDetalhe, 0, len(faltando))
+		for _, campo := range faltando {
+			detalhes = append(detalhes, models.ErroDetalhe{Campo: campo, Mensagem: traduzir(c, mensagens.CampoObrigatorio)})
+		}
+		responderErro(c, http.StatusBadRequest, models.ErroDadosInvalidos, traduzir(c, mensagens.PromocaoCamposObrigatorios, tipo), detalhes...)
+		return false
+	}
+
+	if !ctrl.produtosValidos(c, tipo, request) || !horarioValido(c, request) {
+		return false
+	}
+	if request.ValidoDe != nil && request.ValidoAte != nil && !request.ValidoDe.Before(*request.ValidoAte) {
+		responderErro(c, http.StatusBadRequest, models.ErroDadosInvalidos, traduzir(c, mensagens.PromocaoValidadeInvalida),
+			models.ErroDetalhe{Campo: "valido_ate", Mensagem: traduzir(c, mensagens.PromocaoValidadeInvalida), Valor: request.ValidoAte.Format(time.RFC3339)})
+		return false
+	}
+
+	*promocao = models.Promocao{
+		ID:               promocao.ID,
+		Nome:             strings.TrimSpace(request.Nome),
+		Tipo:             tipo,
+		Cupom:            strings.ToUpper(request.Cupom),
+		PedidoMinimo:     request.PedidoMinimo,
+		ValidoDe:         request.ValidoDe,
+		ValidoAte:        request.ValidoAte,
+		HoraInicio:       request.HoraInicio,
+		HoraFim:          request.HoraFim,
+		LimiteUsos:       request.LimiteUsos,
+		LimitePorCliente: request.LimitePorCliente,
+		Ativa:            request.Ativa == nil || *request.Ativa,
+	}
+	switch tipo {
+	case models.PromocaoPercentual:
+		promocao.Percentual = request.Percentual
+	case models.PromocaoValorFixo:
+		promocao.Valor = request.Valor
+	case models.PromocaoLeveGanhe:
+		promocao.HamburguerID, promocao.BebidaID, promocao.Quantidade = request.HamburguerID, request.BebidaID, request.Quantidade
+	case models.PromocaoCombo:
+		promocao.HamburguerID, promocao.BebidaID, promocao.Valor = request.HamburguerID, request.BebidaID, request.Valor
+	}
+	return true
+}
+
+// produtosValidos confere que o hambúrguer e a bebida usados pelo tipo existem no cardápio
+func (ctrl *PromocaoController) produtosValidos(c *gin.Context, tipo models.TipoPromocao, request models.PromocaoRequest) bool {
+	if tipo != models.PromocaoLeveGanhe && tipo != models.PromocaoCombo {
+		return true
+	}
+
+	id := *request.HamburguerID
+	if _, err := ctrl.hamburguers.Buscar(id); err != nil {
+		responderErro(c, http.StatusBadRequest, models.ErroDadosInvalidos, traduzir(c, mensagens.HamburguerNaoEncontrado),
+			models.ErroDetalhe{Campo: "hamburguer_id", Mensagem: traduzir(c, mensagens.HamburguerNaoEncontrado), Valor: strconv.FormatUint(uint64(id), 10)})
+		return false
+	}
+
+	if request.BebidaID == nil {
+		return true
+	}
+	id = *request.BebidaID
+	bebida, err := ctrl.itens.Buscar(id)
+	if err != nil {
+		responderErro(c, http.StatusBadRequest, models.ErroDadosInvalidos, traduzir(c, mensagens.BebidaNaoEncontrada),
+			models.ErroDetalhe{Campo: "bebida_id", Mensagem: traduzir(c, mensagens.BebidaNaoEncontrada), Valor: strconv.FormatUint(uint64(id), 10)})
+		return false
+	}
+	if bebida.Tipo != models.TipoBebida {
+		responderErro(c, http.StatusBadRequest, models.ErroDadosInvalidos, traduzir(c, mensagens.ItemNaoEBebida, bebida.Descricao),
+			models.ErroDetalhe{Campo: "bebida_id", Mensagem: traduzir(c, mensagens.ItemNaoEBebida, bebida.Descricao), Valor: strconv.FormatUint(uint64(id), 10)})
+		return false
+	}
+	return true
+}
+
+// horarioValido confere a janela do happy hour: as duas horas no formato HH:MM, diferentes, ou nenhuma
+func horarioValido(c *gin.Context, request models.PromocaoRequest) bool {
+	if request.HoraInicio == "" && request.HoraFim == "" {
+		return true
+	}
+
+	var detalhes []models.ErroDetalhe
+	campos := []struct{ nome, hora string }{{"hora_inicio", request.HoraInicio}, {"hora_fim", request.HoraFim}}
+	for _, campo := range campos {
+		if _, err := time.Parse("15:04", campo.hora); err != nil || len(campo.hora) != 5 {
+			detalhes = append(detalhes, models.ErroDetalhe{Campo: campo.nome, Mensagem: traduzir(c, mensagens.PromocaoHorarioInvalidoCampo), Valor: campo.hora})
+		}
+	}
+	if len(detalhes) > 0 || request.HoraInicio == request.HoraFim {
+		responderErro(c, http.StatusBadRequest, models.ErroDadosInvalidos, traduzir(c, mensagens.PromocaoHorarioInvalido), detalhes...)
+		return false
+	}
+	return true
+}
+
+func lerIDPromocao(c *gin.Context) (uint, bool) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		responderErro(c, http.StatusBadRequest, models.ErroDadosInvalidos, traduzir(c, mensagens.PromocaoIDInvalido),
+			models.ErroDetalhe{Campo: "id", Mensagem: traduzir(c, mensagens.NumeroInteiro), Valor: c.Param("id")})
+		return 0, false
+	}
+	return uint(id), true
+}
@@ -0,0 +1,229 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"lanchonete/mensagens"
+	"lanchonete/models"
+	"lanchonete/repository"
+)
+
+// ZonaEntregaController atende as rotas das zonas de entrega. A zona que atende o
+// endereço é escolhida pelo serviço de pedidos, na criação e na cotação.
+type ZonaEntregaController struct {
+	zonas repository.ZonaEntregaRepository
+}
+
+func NewZonaEntregaController(zonas repository.ZonaEntregaRepository) *ZonaEntregaController {
+	return &ZonaEntregaController{zonas: zonas}
+}
+
+// @Summary Lista as zonas de entrega
+// @Description Retorna as zonas de entrega com a faixa de CEPs ou o bairro atendido, a taxa, o pedido mínimo e o prazo
+// @Tags entrega
+// @Accept json
+// @Produce json
+// @Param ativas query bool false "Somente as zonas ativas"
+// @Param limit query int false "Quantidade máxima de zonas por página (padrão 20, máximo 100)"
+// @Param cursor query string false "Cursor da próxima página, retornado em next_cursor"
+// @Success 200 {object} models.Pagina[models.ZonaEntrega]
+// @Failure 400 {object} models.ErroResponse "Paginação inválida"
+// @Router /zonas-entrega [get]
+func (ctrl *ZonaEntregaController) GetAllZonasEntrega(c *gin.Context) {
+	limite, atual, err := lerPaginacao(c)
+	if err != nil {
+		responderDadosInvalidos(c, err)
+		return
+	}
+
+	filtro := repository.FiltroZonas{Ativas: c.Query("ativas") == "true", Limite: limite + 1}
+	if atual != nil {
+		id, err := atual.idNumerico()
+		if err != nil {
+			responderDadosInvalidos(c, err)
+			return
+		}
+		filtro.AposID = uint(id)
+	}
+
+	zonas, err := ctrl.zonas.Listar(filtro)
+	if err != nil {
+		responderErro(c, http.StatusInternalServerError, models.ErroInterno, traduzir(c, mensagens.ZonaErroBuscar))
+		return
+	}
+
+	c.JSON(http.StatusOK, paginar(zonas, limite, func(zona models.ZonaEntrega) cursor {
+		return cursor{ID: strconv.FormatUint(uint64(zona.ID), 10)}
+	}))
+}
+
+// @Summary Busca uma zona de entrega
+// @Description Retorna uma zona de entrega pelo ID
+// @Tags entrega
+// @Accept json
+// @Produce json
+// @Param id path int true "ID da zona"
+// @Success 200 {object} models.ZonaEntrega
+// @Failure 400 {object} models.ErroResponse "ID inválido"
+// @Failure 404 {object} models.ErroResponse "Zona não encontrada"
+// @Router /zonas-entrega/{id} [get]
+func (ctrl *ZonaEntregaController) GetZonaEntrega(c *gin.Context) {
+	zona, ok := ctrl.buscarZona(c)
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusOK, zona)
+}
+
+// @Summary Cria uma zona de entrega
+// @Description Cria uma zona delimitada por uma faixa de CEPs (cep_inicio e cep_fim) ou por um bairro, com cidade e UF.
+// @Description Quando mais de uma zona atende o endereço, vale a zona por bairro e, entre faixas, a mais estreita.
+// @Tags entrega
+// @Accept json
+// @Produce json
+// @Param zona body models.ZonaEntregaRequest true "Dados da zona"
+// @Success 201 {object} models.ZonaEntrega
+// @Failure 400 {object} models.ErroResponse "Erro na validação dos dados"
+// @Failure 409 {object} models.ErroResponse "Já existe uma zona com o nome"
+// @Router /zonas-entrega [post]
+func (ctrl *ZonaEntregaController) CreateZonaEntrega(c *gin.Context) {
+	var zona models.ZonaEntrega
+	if !lerZonaEntrega(c, &zona) {
+		return
+	}
+
+	if err := ctrl.zonas.Criar(&zona); err != nil {
+		responderErroBanco(c, err, models.ErroJaExiste, mensagens.ZonaJaExiste, mensagens.ZonaErroCriar)
+		return
+	}
+
+	c.JSON(http.StatusCreated, zona)
+}
+
+// @Summary Atualiza uma zona de entrega
+// @Description Substitui os dados da zona. Pedidos já feitos mantêm a taxa e o prazo cobrados.
+// @Tags entrega
+// @Accept json
+// @Produce json
+// @Param id path int true "ID da zona"
+// @Param zona body models.ZonaEntregaRequest true "Dados da zona"
+// @Success 200 {object} models.ZonaEntrega
+// @Failure 400 {object} models.ErroResponse "ID ou dados inválidos"
+// @Failure 404 {object} models.ErroResponse "Zona não encontrada"
+// @Failure 409 {object} models.ErroResponse "Já existe outra zona com o nome"
+// @Router /zonas-entrega/{id} [put]
+func (ctrl *ZonaEntregaController) UpdateZonaEntrega(c *gin.Context) {
+	zona, ok := ctrl.buscarZona(c)
+	if !ok {
+		return
+	}
+	if !lerZonaEntrega(c, &zona) {
+		return
+	}
+
+	if err := ctrl.zonas.Salvar(&zona); err != nil {
+		responderErroBanco(c, err, models.ErroJaExiste, mensagens.ZonaJaExiste, mensagens.ZonaErroAtualizar)
+		return
+	}
+
+	c.JSON(http.StatusOK, zona)
+}
+
+// @Summary Remove uma zona de entrega
+// @Description Apaga a zona; os endereços que só ela atendia passam a ficar fora da área de entrega.
+// @Description Para suspender a entrega temporariamente, desative a zona com ativa false.
+// @Tags entrega
+// @Accept json
+// @Produce json
+// @Param id path int true "ID da zona"
+// @Success 200 {object} string "Zona de entrega removida com sucesso"
+// @Failure 400 {object} models.ErroResponse "ID inválido"
+// @Failure 404 {object} models.ErroResponse "Zona não encontrada"
+// @Router /zonas-entrega/{id} [delete]
+func (ctrl *ZonaEntregaController) DeleteZonaEntrega(c *gin.Context) {
+	id, ok := lerIDZona(c)
+	if !ok {
+		return
+	}
+
+	err := ctrl.zonas.Remover(id)
+	if errors.Is(err, repository.ErrNaoEncontrado) {
+		responderErro(c, http.StatusNotFound, models.ErroNaoEncontrado, traduzir(c, mensagens.ZonaNaoEncontrada))
+		return
+	}
+	if err != nil {
+		responderErro(c, http.StatusInternalServerError, models.ErroInterno, traduzir(c, mensagens.ZonaErroRemover))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": traduzir(c, mensagens.ZonaRemovida)})
+}
+
+// buscarZona carrega a zona do ID da rota, respondendo com o erro se não encontrar
+func (ctrl *ZonaEntregaController) buscarZona(c *gin.Context) (models.ZonaEntrega, bool) {
+	id, ok := lerIDZona(c)
+	if !ok {
+		return models.ZonaEntrega{}, false
+	}
+
+	zona, err := ctrl.zonas.Buscar(id)
+	if errors.Is(err, repository.ErrNaoEncontrado) {
+		responderErro(c, http.StatusNotFound, models.ErroNaoEncontrado, traduzir(c, mensagens.ZonaNaoEncontrada))
+		return models.ZonaEntrega{}, false
+	}
+	if err != nil {
+		responderErro(c, http.StatusInternalServerError, models.ErroInterno, traduzir(c, mensagens.ZonaErroBuscar))
+		return models.ZonaEntrega{}, false
+	}
+	return zona, true
+}
+
+// lerZonaEntrega valida o corpo da requisição e o copia para a zona. A zona é
+// delimitada pela faixa de CEPs ou pelo bairro, nunca pelos dois.
+func lerZonaEntrega(c *gin.Context, zona *models.ZonaEntrega) bool {
+	var request models.ZonaEntregaRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		responderDadosInvalidos(c, err)
+		return false
+	}
+
+	inicio, _ := models.NormalizarCEP(request.CEPInicio)
+	fim, _ := models.NormalizarCEP(request.CEPFim)
+	porFaixa := inicio != "" && fim != "" && request.Bairro == "" && request.Cidade == "" && request.UF == ""
+	porBairro := inicio == "" && fim == "" && request.Bairro != "" && request.Cidade != "" && request.UF != ""
+	if !porFaixa && !porBairro {
+		responderErro(c, http.StatusBadRequest, models.ErroDadosInvalidos, traduzir(c, mensagens.ZonaCriterioInvalido))
+		return false
+	}
+	if inicio > fim {
+		responderErro(c, http.StatusBadRequest, models.ErroDadosInvalidos, traduzir(c, mensagens.ZonaFaixaInvalida),
+			models.ErroDetalhe{Campo: "cep_fim", Mensagem: traduzir(c, mensagens.ZonaFaixaInvalida), Valor: request.CEPFim})
+		return false
+	}
+
+	zona.Nome = strings.TrimSpace(request.Nome)
+	zona.CEPInicio, zona.CEPFim = inicio, fim
+	zona.Bairro = strings.TrimSpace(request.Bairro)
+	zona.Cidade = strings.TrimSpace(request.Cidade)
+	zona.UF = strings.ToUpper(request.UF)
+	zona.Taxa = request.Taxa
+	zona.PedidoMinimo = request.PedidoMinimo
+	zona.PrazoMinutos = request.PrazoMinutos
+	zona.Ativa = request.Ativa == nil || *request.Ativa
+	return true
+}
+
+func lerIDZona(c *gin.Context) (uint, bool) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		responderErro(c, http.StatusBadRequest, models.ErroDadosInvalidos, traduzir(c, mensagens.ZonaIDInvalido),
+			models.ErroDetalhe{Campo: "id", Mensagem: traduzir(c, mensagens.NumeroInteiro), Valor: c.Param("id")})
+		return 0, false
+	}
+	return uint(id), true
+}
@@ -6,7 +6,6 @@ import (
 
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
-	"lanchonete/models"
 )
 
 var (
@@ -14,34 +13,31 @@ var (
 	err error
 )
 
-func ConnectDB() {
+// OpenDB abre a conexão com o banco sem verificar nem alterar o schema
+func OpenDB() (*gorm.DB, error) {
 	dsn := os.Getenv("DATABASE_URL")
 	if dsn == "" {
 		dsn = "host=localhost user=root password=root dbname=lanchonete port=5432 sslmode=disable"
 	}
 
-	DB, err = gorm.Open(postgres.Open(dsn), &gorm.Config{
-		DisableForeignKeyConstraintWhenMigrating: true,
-	})
+	// TranslateError converte violações de chave estrangeira e de chave única
+	// em gorm.ErrForeignKeyViolated e gorm.ErrDuplicatedKey
+	return gorm.Open(postgres.Open(dsn), &gorm.Config{TranslateError: true})
+}
+
+// ConnectDB conecta ao banco e encerra o processo se houver migrações pendentes.
+// O schema só é alterado pelo comando cmd/migrate.
+func ConnectDB() {
+	DB, err = OpenDB()
 	if err != nil {
 		log.Fatal("Erro ao conectar ao banco de dados:", err)
 	}
 
-	// Desabilita as foreign keys durante a migração
-	DB.Exec("ALTER TABLE IF EXISTS pedido_hamburgueres DROP CONSTRAINT IF EXISTS fk_pedido_hamburgueres_pedido")
-	DB.Exec("ALTER TABLE IF EXISTS pedido_hamburgueres DROP CONSTRAINT IF EXISTS fk_pedido_hamburgueres_hamburguer")
-	DB.Exec("DROP TABLE IF EXISTS pedido_hamburgueres CASCADE")
-
-	// Auto Migrate na ordem correta
-	DB.AutoMigrate(
-		&models.Item{},
-		&models.Hamburguer{},
-		&models.HamburguerIngrediente{},
-		&models.Pedido{},
-		&models.PedidoHamburguer{},
-		&models.PedidoBebida{},
-	)
-
-	// Habilita as foreign keys após a migração
-	DB.Exec("SET CONSTRAINTS ALL IMMEDIATE")
+	pendentes, err := PendingMigrations(DB)
+	if err != nil {
+		log.Fatal("Erro ao verificar as migrações do banco de dados:", err)
+	}
+	if len(pendentes) > 0 {
+		log.Fatalf("Existem %d migrações pendentes (a primeira é %04d_%s). Execute: go run ./cmd/migrate up", len(pendentes), pendentes[0].Versao, pendentes[0].Nome)
+	}
 }
\ No newline at end of file
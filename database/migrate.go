@@ -0,0 +1,235 @@
+package database
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+//go:embed migrations/*.sql
+var arquivosMigracoes embed.FS
+
+// chaveLockMigracoes identifica o advisory lock que impede dois processos de migrarem ao mesmo tempo
+const chaveLockMigracoes = 7_458_001
+
+// Migracao é uma versão do schema com os scripts para aplicá-la e revertê-la
+type Migracao struct {
+	Versao int64
+	Nome   string
+	Up     string
+	Down   string
+}
+
+// MigracaoStatus indica se uma migração já foi aplicada e quando
+type MigracaoStatus struct {
+	Migracao
+	AplicadaEm *time.Time
+}
+
+type schemaMigration struct {
+	Version   int64     `gorm:"primaryKey;autoIncrement:false"`
+	Name      string    `gorm:"not null"`
+	AppliedAt time.Time `gorm:"not null"`
+}
+
+func (schemaMigration) TableName() string {
+	return "schema_migrations"
+}
+
+// carregarMigracoes lê os pares NNNN_nome.up.sql / NNNN_nome.down.sql embutidos no binário
+func carregarMigracoes() ([]Migracao, error) {
+	arquivos, err := fs.Glob(arquivosMigracoes, "migrations/*.sql")
+	if err != nil {
+		return nil, err
+	}
+
+	porVersao := map[int64]*Migracao{}
+	for _, arquivo := range arquivos {
+		nome := strings.TrimPrefix(arquivo, "migrations/")
+
+		base, direcao, ok := strings.Cut(strings.TrimSuffix(nome, ".sql"), ".")
+		if !ok || (direcao != "up" && direcao != "down") {
+			return nil, fmt.Errorf("nome de migração inválido: %s", nome)
+		}
+
+		textoVersao, descricao, ok := strings.Cut(base, "_")
+		versao, err := strconv.ParseInt(textoVersao, 10, 64)
+		if !ok || err != nil {
+			return nil, fmt.Errorf("nome de migração inválido: %s", nome)
+		}
+
+		conteudo, err := arquivosMigracoes.ReadFile(arquivo)
+		if err != nil {
+			return nil, err
+		}
+
+		migracao, existe := porVersao[versao]
+		if !existe {
+			migracao = &Migracao{Versao: versao, Nome: descricao}
+			porVersao[versao] = migracao
+		} else if migracao.Nome != descricao {
+			return nil, fmt.Errorf("versão %d usada por mais de uma migração", versao)
+		}
+
+		if direcao == "up" {
+			migracao.Up = string(conteudo)
+		} else {
+			migracao.Down = string(conteudo)
+		}
+	}
+
+	migracoes := make([]Migracao, 0, len(porVersao))
+	for _, migracao := range porVersao {
+		if migracao.Up == "" || migracao.Down == "" {
+			return nil, fmt.Errorf("migração %04d_%s precisa dos scripts up e down", migracao.Versao, migracao.Nome)
+		}
+		migracoes = append(migracoes, *migracao)
+	}
+	sort.Slice(migracoes, func(i, j int) bool { return migracoes[i].Versao < migracoes[j].Versao })
+
+	return migracoes, nil
+}
+
+// versoesAplicadas retorna as versões registradas em schema_migrations, sem criar a tabela
+func versoesAplicadas(db *gorm.DB) (map[int64]time.Time, error) {
+	aplicadas := map[int64]time.Time{}
+	if !db.Migrator().HasTable(&schemaMigration{}) {
+		return aplicadas, nil
+	}
+
+	var registros []schemaMigration
+	if err := db.Find(&registros).Error; err != nil {
+		return nil, err
+	}
+	for _, registro := range registros {
+		aplicadas[registro.Version] = registro.AppliedAt
+	}
+	return aplicadas, nil
+}
+
+// MigrationStatus lista todas as migrações conhecidas e quando cada uma foi aplicada
+func MigrationStatus(db *gorm.DB) ([]MigracaoStatus, error) {
+	migracoes, err := carregarMigracoes()
+	if err != nil {
+		return nil, err
+	}
+
+	aplicadas, err := versoesAplicadas(db)
+	if err != nil {
+		return nil, err
+	}
+
+	status := make([]MigracaoStatus, 0, len(migracoes))
+	for _, migracao := range migracoes {
+		item := MigracaoStatus{Migracao: migracao}
+		if quando, ok := aplicadas[migracao.Versao]; ok {
+			item.AplicadaEm = &quando
+		}
+		status = append(status, item)
+	}
+	return status, nil
+}
+
+// PendingMigrations retorna as migrações ainda não aplicadas, em ordem
+func PendingMigrations(db *gorm.DB) ([]Migracao, error) {
+	status, err := MigrationStatus(db)
+	if err != nil {
+		return nil, err
+	}
+
+	var pendentes []Migracao
+	for _, item := range status {
+		if item.AplicadaEm == nil {
+			pendentes = append(pendentes, item.Migracao)
+		}
+	}
+	return pendentes, nil
+}
+
+// MigrateUp aplica todas as migrações pendentes, cada uma em sua própria transação
+func MigrateUp(db *gorm.DB) ([]Migracao, error) {
+	if err := db.Exec(`CREATE TABLE IF NOT EXISTS schema_migrations (
+		version    bigint PRIMARY KEY,
+		name       text NOT NULL,
+		applied_at timestamptz NOT NULL
+	)`).Error; err != nil {
+		return nil, err
+	}
+
+	pendentes, err := PendingMigrations(db)
+	if err != nil {
+		return nil, err
+	}
+
+	var aplicadas []Migracao
+	for _, migracao := range pendentes {
+		err := db.Transaction(func(tx *gorm.DB) error {
+			if err := tx.Exec("SELECT pg_advisory_xact_lock(?)", chaveLockMigracoes).Error; err != nil {
+				return err
+			}
+
+			// Outro processo pode ter aplicado a migração enquanto esperávamos o lock
+			var count int64
+			if err := tx.Model(&schemaMigration{}).Where("version = ?", migracao.Versao).Count(&count).Error; err != nil {
+				return err
+			}
+			if count > 0 {
+				return nil
+			}
+
+			if err := tx.Exec(migracao.Up).Error; err != nil {
+				return err
+			}
+			return tx.Create(&schemaMigration{Version: migracao.Versao, Name: migracao.Nome, AppliedAt: time.Now()}).Error
+		})
+		if err != nil {
+			return aplicadas, fmt.Errorf("migração %04d_%s: %w", migracao.Versao, migracao.Nome, err)
+		}
+		aplicadas = append(aplicadas, migracao)
+	}
+
+	return aplicadas, nil
+}
+
+// MigrateDown reverte as últimas migrações aplicadas, da mais recente para a mais antiga
+func MigrateDown(db *gorm.DB, passos int) ([]Migracao, error) {
+	if passos < 1 {
+		return nil, errors.New("o número de migrações a reverter deve ser positivo")
+	}
+
+	status, err := MigrationStatus(db)
+	if err != nil {
+		return nil, err
+	}
+
+	var revertidas []Migracao
+	for i := len(status) - 1; i >= 0 && len(revertidas) < passos; i-- {
+		if status[i].AplicadaEm == nil {
+			continue
+		}
+
+		migracao := status[i].Migracao
+		err := db.Transaction(func(tx *gorm.DB) error {
+			if err := tx.Exec("SELECT pg_advisory_xact_lock(?)", chaveLockMigracoes).Error; err != nil {
+				return err
+			}
+			if err := tx.Exec(migracao.Down).Error; err != nil {
+				return err
+			}
+			return tx.Where("version = ?", migracao.Versao).Delete(&schemaMigration{}).Error
+		})
+		if err != nil {
+			return revertidas, fmt.Errorf("migração %04d_%s: %w", migracao.Versao, migracao.Nome, err)
+		}
+		revertidas = append(revertidas, migracao)
+	}
+
+	return revertidas, nil
+}
@@ -0,0 +1,179 @@
+package database
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// Modelos como eram antes das migrações versionadas, para reproduzir o schema
+// que o AutoMigrate criava nos bancos existentes
+type itemLegado struct {
+	ID        uint    `gorm:"primaryKey;autoIncrement:false"`
+	Tipo      string  `gorm:"not null"`
+	Descricao string  `gorm:"not null"`
+	Preco     float64 `gorm:"not null"`
+	Extra     bool
+}
+
+func (itemLegado) TableName() string { return "items" }
+
+type hamburguerLegado struct {
+	ID        uint    `gorm:"primaryKey;autoIncrement:false"`
+	Descricao string  `gorm:"not null"`
+	Preco     float64 `gorm:"not null"`
+}
+
+func (hamburguerLegado) TableName() string { return "hamburguers" }
+
+type hamburguerIngredienteLegado struct {
+	HamburguerID uint `gorm:"primaryKey"`
+	ItemID       uint `gorm:"primaryKey"`
+	Quantidade   int  `gorm:"not null;default:1"`
+}
+
+func (hamburguerIngredienteLegado) TableName() string { return "hamburguer_ingredientes" }
+
+type pedidoLegado struct {
+	ID          uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	Data        time.Time `gorm:"not null;default:CURRENT_TIMESTAMP"`
+	Descricao   string    `gorm:"not null"`
+	Status      string    `gorm:"not null;default:'STARTED'"`
+	Nome        string    `gorm:"not null"`
+	Endereco    string    `gorm:"not null"`
+	Telefone    string    `gorm:"not null"`
+	Observacoes string
+	ValorTotal  float64 `gorm:"not null"`
+}
+
+func (pedidoLegado) TableName() string { return "pedidos" }
+
+type pedidoHamburguerLegado struct {
+	PedidoID     uuid.UUID `gorm:"type:uuid;primaryKey"`
+	HamburguerID uint      `gorm:"primaryKey"`
+	Quantidade   int       `gorm:"not null;default:1"`
+}
+
+func (pedidoHamburguerLegado) TableName() string { return "pedido_hamburgueres" }
+
+type pedidoBebidaLegado struct {
+	PedidoID   uuid.UUID `gorm:"type:uuid;primaryKey"`
+	ItemID     uint      `gorm:"primaryKey"`
+	Quantidade int       `gorm:"not null;default:1"`
+}
+
+func (pedidoBebidaLegado) TableName() string { return "pedido_bebidas" }
+
+// bancoDeTeste abre TEST_DATABASE_URL com um schema exclusivo, removido no fim do teste
+func bancoDeTeste(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	dsn := os.Getenv("TEST_DATABASE_URL")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_URL não definida")
+	}
+
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{DisableForeignKeyConstraintWhenMigrating: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Uma única conexão, para que o search_path valha em todas as consultas
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+
+	schema := fmt.Sprintf("teste_migracoes_%d", time.Now().UnixNano())
+	if err := db.Exec("CREATE SCHEMA " + schema).Error; err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		db.Exec("DROP SCHEMA " + schema + " CASCADE")
+		sqlDB.Close()
+	})
+	if err := db.Exec("SET search_path TO " + schema).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	return db
+}
+
+func TestMigrateUpAdotaSchemaDoAutoMigrate(t *testing.T) {
+	db := bancoDeTeste(t)
+
+	if err := db.AutoMigrate(
+		&itemLegado{},
+		&hamburguerLegado{},
+		&hamburguerIngredienteLegado{},
+		&pedidoLegado{},
+		&pedidoHamburguerLegado{},
+		&pedidoBebidaLegado{},
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	pedidoID := uuid.New()
+	dados := []any{
+		&itemLegado{ID: 1, Tipo: "INGREDIENTE", Descricao: "Pão", Preco: 1.1},
+		&itemLegado{ID: 10, Tipo: "BEBIDA", Descricao: "Refrigerante", Preco: 5.999},
+		&hamburguerLegado{ID: 1, Descricao: "X-Burguer", Preco: 0.1 + 0.2 + 25.6},
+		&hamburguerIngredienteLegado{HamburguerID: 1, ItemID: 1, Quantidade: 2},
+		&pedidoLegado{ID: pedidoID, Descricao: "Pedido antigo", Nome: "Maria", Endereco: "Rua A, 10", Telefone: "11999998888", ValorTotal: 2*25.9 + 5.999},
+		&pedidoHamburguerLegado{PedidoID: pedidoID, HamburguerID: 1, Quantidade: 2},
+		&pedidoBebidaLegado{PedidoID: pedidoID, ItemID: 10, Quantidade: 1},
+	}
+	for _, dado := range dados {
+		if err := db.Create(dado).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if _, err := MigrateUp(db); err != nil {
+		t.Fatal(err)
+	}
+
+	pendentes, err := PendingMigrations(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pendentes) != 0 {
+		t.Fatalf("migrações pendentes após o up: %d", len(pendentes))
+	}
+
+	valores := map[string]string{
+		"SELECT preco::text FROM items WHERE id = 1":                                          "1.10",
+		"SELECT preco::text FROM items WHERE id = 10":                                         "6.00",
+		"SELECT preco::text FROM hamburguers WHERE id = 1":                                    "25.90",
+		"SELECT valor_total::text FROM pedidos":                                               "57.80",
+		"SELECT valor_reembolso::text FROM pedidos":                                           "0.00",
+		"SELECT descricao FROM pedido_hamburgueres":                                           "X-Burguer",
+		"SELECT preco_unitario::text FROM pedido_hamburgueres":                                "25.90",
+		"SELECT (id IS NOT NULL)::text FROM pedido_hamburgueres":                              "true",
+		"SELECT descricao FROM pedido_bebidas":                                                "Refrigerante",
+		"SELECT preco_unitario::text FROM pedido_bebidas":                                     "6.00",
+		"SELECT nome FROM clientes WHERE telefone = '11999998888'":                            "Maria",
+		"SELECT count(*)::text FROM cliente_enderecos WHERE cliente_telefone = '11999998888'": "1",
+	}
+	for consulta, esperado := range valores {
+		var obtido string
+		if err := db.Raw(consulta).Scan(&obtido).Error; err != nil {
+			t.Fatalf("%s: %v", consulta, err)
+		}
+		if obtido != esperado {
+			t.Errorf("%s = %q, esperado %q", consulta, obtido, esperado)
+		}
+	}
+
+	// As personalizações referenciam a nova chave da linha
+	if err := db.Exec(`INSERT INTO pedido_hamburguer_personalizacoes (pedido_hamburguer_id, item_id, acao)
+		SELECT id, 1, 'ADICIONAR' FROM pedido_hamburgueres`).Error; err != nil {
+		t.Fatal(err)
+	}
+}
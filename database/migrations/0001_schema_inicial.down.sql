@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS pedido_bebidas;
+DROP TABLE IF EXISTS pedido_hamburguer_personalizacoes;
+DROP TABLE IF EXISTS pedido_hamburgueres;
+DROP TABLE IF EXISTS pedido_status_historico;
+DROP TABLE IF EXISTS pedidos;
+DROP TABLE IF EXISTS hamburguer_ingredientes;
+DROP TABLE IF EXISTS hamburguers;
+DROP TABLE IF EXISTS items;
@@ -0,0 +1,118 @@
+-- Schema inicial. Bancos criados pelo AutoMigrate já têm parte das tabelas, que
+-- o IF NOT EXISTS mantém; as colunas que faltam nelas são criadas no fim do script.
+
+CREATE TABLE IF NOT EXISTS items (
+    id        bigint PRIMARY KEY,
+    tipo      text NOT NULL,
+    descricao text NOT NULL,
+    preco     numeric(12,2) NOT NULL,
+    extra     boolean
+);
+
+CREATE TABLE IF NOT EXISTS hamburguers (
+    id        bigint PRIMARY KEY,
+    descricao text NOT NULL,
+    preco     numeric(12,2) NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS hamburguer_ingredientes (
+    hamburguer_id bigint NOT NULL,
+    item_id       bigint NOT NULL,
+    quantidade    bigint NOT NULL DEFAULT 1,
+    PRIMARY KEY (hamburguer_id, item_id)
+);
+
+CREATE TABLE IF NOT EXISTS pedidos (
+    id                   uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    data                 timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    descricao            text NOT NULL,
+    status               text NOT NULL DEFAULT 'STARTED',
+    nome                 text NOT NULL,
+    endereco             text NOT NULL,
+    telefone             text NOT NULL,
+    observacoes          text,
+    valor_total          numeric(12,2) NOT NULL,
+    cancelado_por        text,
+    cancelado_em         timestamptz,
+    motivo_cancelamento  text,
+    reembolso_necessario boolean NOT NULL DEFAULT false,
+    valor_reembolso      numeric(12,2) NOT NULL DEFAULT 0,
+    reembolsado_em       timestamptz
+);
+
+CREATE TABLE IF NOT EXISTS pedido_status_historico (
+    id              bigserial PRIMARY KEY,
+    pedido_id       uuid NOT NULL,
+    status_anterior text,
+    status_novo     text NOT NULL,
+    ator            text NOT NULL,
+    data            timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS idx_pedido_status_historico_pedido_id ON pedido_status_historico (pedido_id);
+
+-- No AutoMigrate a linha do pedido era identificada por (pedido_id, hamburguer_id).
+-- As personalizações referenciam a linha, que passa a ter um id próprio.
+DO $$
+DECLARE
+    chave text;
+BEGIN
+    IF to_regclass('pedido_hamburgueres') IS NOT NULL AND NOT EXISTS (
+        SELECT 1 FROM information_schema.columns
+        WHERE table_schema = current_schema() AND table_name = 'pedido_hamburgueres' AND column_name = 'id'
+    ) THEN
+        SELECT conname INTO chave FROM pg_constraint
+        WHERE conrelid = 'pedido_hamburgueres'::regclass AND contype = 'p';
+        IF chave IS NOT NULL THEN
+            EXECUTE format('ALTER TABLE pedido_hamburgueres DROP CONSTRAINT %I', chave);
+        END IF;
+        ALTER TABLE pedido_hamburgueres ADD COLUMN id bigserial PRIMARY KEY;
+    END IF;
+END $$;
+
+CREATE TABLE IF NOT EXISTS pedido_hamburgueres (
+    id             bigserial PRIMARY KEY,
+    pedido_id      uuid NOT NULL,
+    hamburguer_id  bigint NOT NULL,
+    quantidade     bigint NOT NULL DEFAULT 1,
+    descricao      text NOT NULL DEFAULT '',
+    preco_unitario numeric(12,2) NOT NULL DEFAULT 0
+);
+CREATE INDEX IF NOT EXISTS idx_pedido_hamburgueres_pedido_id ON pedido_hamburgueres (pedido_id);
+
+CREATE TABLE IF NOT EXISTS pedido_hamburguer_personalizacoes (
+    id                   bigserial PRIMARY KEY,
+    pedido_hamburguer_id bigint NOT NULL,
+    item_id              bigint NOT NULL,
+    acao                 text NOT NULL,
+    quantidade           bigint NOT NULL DEFAULT 1,
+    descricao            text NOT NULL DEFAULT '',
+    preco_unitario       numeric(12,2) NOT NULL DEFAULT 0
+);
+CREATE INDEX IF NOT EXISTS idx_pedido_hamburguer_personalizacoes_pedido_hamburguer_id ON pedido_hamburguer_personalizacoes (pedido_hamburguer_id);
+
+CREATE TABLE IF NOT EXISTS pedido_bebidas (
+    pedido_id      uuid NOT NULL,
+    item_id        bigint NOT NULL,
+    quantidade     bigint NOT NULL DEFAULT 1,
+    descricao      text NOT NULL DEFAULT '',
+    preco_unitario numeric(12,2) NOT NULL DEFAULT 0,
+    PRIMARY KEY (pedido_id, item_id)
+);
+
+-- Colunas ausentes nas tabelas criadas pelo AutoMigrate. A descrição e o preço das
+-- linhas antigas são preenchidos pela migração 0002.
+ALTER TABLE pedidos
+    ADD COLUMN IF NOT EXISTS cancelado_por        text,
+    ADD COLUMN IF NOT EXISTS cancelado_em         timestamptz,
+    ADD COLUMN IF NOT EXISTS motivo_cancelamento  text,
+    ADD COLUMN IF NOT EXISTS reembolso_necessario boolean NOT NULL DEFAULT false,
+    ADD COLUMN IF NOT EXISTS valor_reembolso      numeric(12,2) NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS reembolsado_em       timestamptz;
+
+ALTER TABLE pedido_hamburgueres
+    ADD COLUMN IF NOT EXISTS descricao      text NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS preco_unitario numeric(12,2) NOT NULL DEFAULT 0;
+
+ALTER TABLE pedido_bebidas
+    ADD COLUMN IF NOT EXISTS descricao      text NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS preco_unitario numeric(12,2) NOT NULL DEFAULT 0;
@@ -0,0 +1,5 @@
+-- Os valores preenchidos nas linhas antigas são mantidos; apenas o tipo das colunas volta ao numeric sem escala do AutoMigrate.
+ALTER TABLE items ALTER COLUMN preco TYPE numeric;
+ALTER TABLE hamburguers ALTER COLUMN preco TYPE numeric;
+ALTER TABLE pedidos ALTER COLUMN valor_total TYPE numeric;
+ALTER TABLE pedidos ALTER COLUMN valor_reembolso TYPE numeric;
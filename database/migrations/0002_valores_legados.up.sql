@@ -0,0 +1,21 @@
+-- O AutoMigrate criava as colunas de valores como numeric sem escala, com o que o
+-- float64 gravava (por exemplo 25.899999999999999). Os valores são arredondados
+-- para centavos; em bancos novos as conversões não alteram nada.
+ALTER TABLE items ALTER COLUMN preco TYPE numeric(12,2) USING round(preco::numeric, 2);
+ALTER TABLE hamburguers ALTER COLUMN preco TYPE numeric(12,2) USING round(preco::numeric, 2);
+ALTER TABLE pedidos ALTER COLUMN valor_total TYPE numeric(12,2) USING round(valor_total::numeric, 2);
+ALTER TABLE pedidos ALTER COLUMN valor_reembolso TYPE numeric(12,2) USING round(valor_reembolso::numeric, 2);
+
+-- Linhas gravadas antes de guardarem descrição e preço da compra recebem os
+-- valores do cardápio atual como melhor aproximação.
+UPDATE pedido_hamburgueres SET descricao = hamburguers.descricao, preco_unitario = hamburguers.preco
+FROM hamburguers
+WHERE hamburguers.id = pedido_hamburgueres.hamburguer_id AND pedido_hamburgueres.descricao = '';
+
+UPDATE pedido_hamburguer_personalizacoes SET descricao = items.descricao, preco_unitario = items.preco
+FROM items
+WHERE items.id = pedido_hamburguer_personalizacoes.item_id AND pedido_hamburguer_personalizacoes.descricao = '';
+
+UPDATE pedido_bebidas SET descricao = items.descricao, preco_unitario = items.preco
+FROM items
+WHERE items.id = pedido_bebidas.item_id AND pedido_bebidas.descricao = '';
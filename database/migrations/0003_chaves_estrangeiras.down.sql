@@ -0,0 +1,14 @@
+DROP INDEX IF EXISTS idx_pedido_bebidas_item_id;
+DROP INDEX IF EXISTS idx_pedido_hamburguer_personalizacoes_item_id;
+DROP INDEX IF EXISTS idx_pedido_hamburgueres_hamburguer_id;
+DROP INDEX IF EXISTS idx_hamburguer_ingredientes_item_id;
+
+ALTER TABLE pedido_bebidas DROP CONSTRAINT IF EXISTS fk_pedido_bebidas_item;
+ALTER TABLE pedido_hamburguer_personalizacoes DROP CONSTRAINT IF EXISTS fk_pedido_hamburguer_personalizacoes_item;
+ALTER TABLE pedido_hamburgueres DROP CONSTRAINT IF EXISTS fk_pedido_hamburgueres_hamburguer;
+ALTER TABLE pedido_bebidas DROP CONSTRAINT IF EXISTS fk_pedido_bebidas_pedido;
+ALTER TABLE pedido_hamburguer_personalizacoes DROP CONSTRAINT IF EXISTS fk_pedido_hamburguer_personalizacoes_linha;
+ALTER TABLE pedido_hamburgueres DROP CONSTRAINT IF EXISTS fk_pedido_hamburgueres_pedido;
+ALTER TABLE pedido_status_historico DROP CONSTRAINT IF EXISTS fk_pedido_status_historico_pedido;
+ALTER TABLE hamburguer_ingredientes DROP CONSTRAINT IF EXISTS fk_hamburguer_ingredientes_item;
+ALTER TABLE hamburguer_ingredientes DROP CONSTRAINT IF EXISTS fk_hamburguer_ingredientes_hamburguer;
@@ -0,0 +1,52 @@
+-- Até aqui a integridade entre as tabelas era verificada apenas pelos controllers.
+-- Antes de criar as chaves, remove os registros que ficaram sem o registro pai.
+DELETE FROM hamburguer_ingredientes
+WHERE NOT EXISTS (SELECT 1 FROM hamburguers WHERE hamburguers.id = hamburguer_ingredientes.hamburguer_id)
+   OR NOT EXISTS (SELECT 1 FROM items WHERE items.id = hamburguer_ingredientes.item_id);
+
+DELETE FROM pedido_status_historico
+WHERE NOT EXISTS (SELECT 1 FROM pedidos WHERE pedidos.id = pedido_status_historico.pedido_id);
+
+DELETE FROM pedido_bebidas
+WHERE NOT EXISTS (SELECT 1 FROM pedidos WHERE pedidos.id = pedido_bebidas.pedido_id);
+
+DELETE FROM pedido_hamburgueres
+WHERE NOT EXISTS (SELECT 1 FROM pedidos WHERE pedidos.id = pedido_hamburgueres.pedido_id);
+
+DELETE FROM pedido_hamburguer_personalizacoes
+WHERE NOT EXISTS (SELECT 1 FROM pedido_hamburgueres WHERE pedido_hamburgueres.id = pedido_hamburguer_personalizacoes.pedido_hamburguer_id);
+
+-- A receita pertence ao hambúrguer; um ingrediente usado em receitas não pode ser removido.
+ALTER TABLE hamburguer_ingredientes
+    ADD CONSTRAINT fk_hamburguer_ingredientes_hamburguer FOREIGN KEY (hamburguer_id) REFERENCES hamburguers (id) ON DELETE CASCADE,
+    ADD CONSTRAINT fk_hamburguer_ingredientes_item FOREIGN KEY (item_id) REFERENCES items (id) ON DELETE RESTRICT;
+
+-- Linhas e histórico pertencem ao pedido e são apagados junto com ele.
+ALTER TABLE pedido_status_historico
+    ADD CONSTRAINT fk_pedido_status_historico_pedido FOREIGN KEY (pedido_id) REFERENCES pedidos (id) ON DELETE CASCADE;
+
+ALTER TABLE pedido_hamburgueres
+    ADD CONSTRAINT fk_pedido_hamburgueres_pedido FOREIGN KEY (pedido_id) REFERENCES pedidos (id) ON DELETE CASCADE;
+
+ALTER TABLE pedido_hamburguer_personalizacoes
+    ADD CONSTRAINT fk_pedido_hamburguer_personalizacoes_linha FOREIGN KEY (pedido_hamburguer_id) REFERENCES pedido_hamburgueres (id) ON DELETE CASCADE;
+
+ALTER TABLE pedido_bebidas
+    ADD CONSTRAINT fk_pedido_bebidas_pedido FOREIGN KEY (pedido_id) REFERENCES pedidos (id) ON DELETE CASCADE;
+
+-- Produtos vendidos em pedidos não podem ser removidos do cardápio. Pedidos antigos
+-- podem referenciar produtos já apagados (as linhas guardam descrição e preço), por
+-- isso essas chaves valem apenas para as linhas gravadas a partir de agora.
+ALTER TABLE pedido_hamburgueres
+    ADD CONSTRAINT fk_pedido_hamburgueres_hamburguer FOREIGN KEY (hamburguer_id) REFERENCES hamburguers (id) ON DELETE RESTRICT NOT VALID;
+
+ALTER TABLE pedido_hamburguer_personalizacoes
+    ADD CONSTRAINT fk_pedido_hamburguer_personalizacoes_item FOREIGN KEY (item_id) REFERENCES items (id) ON DELETE RESTRICT NOT VALID;
+
+ALTER TABLE pedido_bebidas
+    ADD CONSTRAINT fk_pedido_bebidas_item FOREIGN KEY (item_id) REFERENCES items (id) ON DELETE RESTRICT NOT VALID;
+
+CREATE INDEX IF NOT EXISTS idx_hamburguer_ingredientes_item_id ON hamburguer_ingredientes (item_id);
+CREATE INDEX IF NOT EXISTS idx_pedido_hamburgueres_hamburguer_id ON pedido_hamburgueres (hamburguer_id);
+CREATE INDEX IF NOT EXISTS idx_pedido_hamburguer_personalizacoes_item_id ON pedido_hamburguer_personalizacoes (item_id);
+CREATE INDEX IF NOT EXISTS idx_pedido_bebidas_item_id ON pedido_bebidas (item_id);
@@ -0,0 +1,8 @@
+-- Os registros removidos logicamente voltam a aparecer como ativos.
+DROP INDEX IF EXISTS idx_pedidos_deleted_at;
+DROP INDEX IF EXISTS idx_hamburguers_deleted_at;
+DROP INDEX IF EXISTS idx_items_deleted_at;
+
+ALTER TABLE pedidos DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE hamburguers DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE items DROP COLUMN IF EXISTS deleted_at;
@@ -0,0 +1,10 @@
+-- Itens, hambúrgueres e pedidos passam a ser removidos logicamente: a remoção
+-- apenas preenche deleted_at, e as consultas padrão ignoram essas linhas. A
+-- remoção definitiva é feita pelo comando cmd/purge, após o prazo de retenção.
+ALTER TABLE items ADD COLUMN IF NOT EXISTS deleted_at timestamptz;
+ALTER TABLE hamburguers ADD COLUMN IF NOT EXISTS deleted_at timestamptz;
+ALTER TABLE pedidos ADD COLUMN IF NOT EXISTS deleted_at timestamptz;
+
+CREATE INDEX IF NOT EXISTS idx_items_deleted_at ON items (deleted_at);
+CREATE INDEX IF NOT EXISTS idx_hamburguers_deleted_at ON hamburguers (deleted_at);
+CREATE INDEX IF NOT EXISTS idx_pedidos_deleted_at ON pedidos (deleted_at);
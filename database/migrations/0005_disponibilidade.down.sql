@@ -0,0 +1,2 @@
+ALTER TABLE hamburguers DROP COLUMN IF EXISTS disponivel;
+ALTER TABLE items DROP COLUMN IF EXISTS disponivel;
@@ -0,0 +1,4 @@
+-- Itens e hambúrgueres podem ser retirados temporariamente do cardápio, por
+-- exemplo quando um ingrediente acaba, sem precisar removê-los.
+ALTER TABLE items ADD COLUMN IF NOT EXISTS disponivel boolean NOT NULL DEFAULT true;
+ALTER TABLE hamburguers ADD COLUMN IF NOT EXISTS disponivel boolean NOT NULL DEFAULT true;
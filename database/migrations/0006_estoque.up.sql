@@ -0,0 +1,12 @@
+-- Saldo dos itens com controle de estoque. A venda baixa as quantidades da
+-- receita, dos adicionais e das bebidas, e o cancelamento as devolve.
+CREATE TABLE IF NOT EXISTS estoques (
+    item_id         bigint PRIMARY KEY REFERENCES items (id) ON DELETE CASCADE,
+    quantidade      bigint NOT NULL DEFAULT 0 CHECK (quantidade >= 0),
+    unidade         text NOT NULL DEFAULT 'UN',
+    ponto_reposicao bigint NOT NULL DEFAULT 0 CHECK (ponto_reposicao >= 0),
+    atualizado_em   timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Os alertas comparam o saldo com o ponto de reposição
+CREATE INDEX IF NOT EXISTS idx_estoques_alerta ON estoques ((quantidade - ponto_reposicao));
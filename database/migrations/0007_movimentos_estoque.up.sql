@@ -0,0 +1,26 @@
+-- Registro de movimentos do estoque: cada recebimento, consumo por pedido, estorno,
+-- perda ou ajuste vira uma linha, que nunca é alterada nem apagada pela API.
+CREATE TABLE IF NOT EXISTS movimentos_estoque (
+    id          bigserial PRIMARY KEY,
+    item_id     bigint NOT NULL REFERENCES items (id),
+    tipo        text NOT NULL CHECK (tipo IN ('RECEBIMENTO', 'CONSUMO', 'ESTORNO', 'PERDA', 'AJUSTE')),
+    quantidade  bigint NOT NULL,
+    saldo       bigint NOT NULL,
+    -- O expurgo de pedidos removidos mantém os movimentos, sem o vínculo
+    pedido_id   uuid REFERENCES pedidos (id) ON DELETE SET NULL,
+    fornecedor  text NOT NULL DEFAULT '',
+    documento   text NOT NULL DEFAULT '',
+    observacao  text NOT NULL DEFAULT '',
+    responsavel text NOT NULL DEFAULT '',
+    data        timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_movimentos_estoque_item_data ON movimentos_estoque (item_id, data);
+CREATE INDEX IF NOT EXISTS idx_movimentos_estoque_pedido_id ON movimentos_estoque (pedido_id);
+
+-- Os saldos já existentes entram no registro como um ajuste inicial, para que a
+-- soma dos movimentos de cada item bata com o saldo atual
+INSERT INTO movimentos_estoque (item_id, tipo, quantidade, saldo, observacao, data)
+SELECT item_id, 'AJUSTE', quantidade, quantidade, 'saldo inicial', atualizado_em
+FROM estoques
+WHERE quantidade <> 0;
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_pedidos_telefone;
+ALTER TABLE pedidos DROP CONSTRAINT IF EXISTS fk_pedidos_cliente;
+DROP TABLE IF EXISTS cliente_enderecos;
+DROP TABLE IF EXISTS clientes;
@@ -0,0 +1,39 @@
+-- Clientes identificados pelo telefone, com os endereços de entrega salvos. O
+-- pedido referencia o cliente pelo telefone e guarda uma cópia do nome e do
+-- endereço usados na entrega.
+CREATE TABLE IF NOT EXISTS clientes (
+    telefone  text PRIMARY KEY,
+    nome      text NOT NULL,
+    criado_em timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS cliente_enderecos (
+    id               bigserial PRIMARY KEY,
+    cliente_telefone text NOT NULL REFERENCES clientes (telefone) ON DELETE CASCADE ON UPDATE CASCADE,
+    apelido          text NOT NULL DEFAULT '',
+    endereco         text NOT NULL,
+    principal        boolean NOT NULL DEFAULT false
+);
+
+CREATE INDEX IF NOT EXISTS idx_cliente_enderecos_cliente_telefone ON cliente_enderecos (cliente_telefone);
+
+-- Cada telefone dos pedidos existentes vira um cliente, com o nome do pedido mais recente
+INSERT INTO clientes (telefone, nome, criado_em)
+SELECT DISTINCT ON (telefone) telefone, nome, MIN(data) OVER (PARTITION BY telefone)
+FROM pedidos
+ORDER BY telefone, data DESC
+ON CONFLICT (telefone) DO NOTHING;
+
+-- Os endereços já usados entram no cadastro; o do pedido mais recente é o principal
+INSERT INTO cliente_enderecos (cliente_telefone, endereco, principal)
+SELECT telefone, endereco, bool_or(ultimo)
+FROM (
+    SELECT telefone, endereco, row_number() OVER (PARTITION BY telefone ORDER BY data DESC) = 1 AS ultimo
+    FROM pedidos
+) enderecos
+GROUP BY telefone, endereco;
+
+ALTER TABLE pedidos
+    ADD CONSTRAINT fk_pedidos_cliente FOREIGN KEY (telefone) REFERENCES clientes (telefone) ON UPDATE CASCADE;
+
+CREATE INDEX IF NOT EXISTS idx_pedidos_telefone ON pedidos (telefone);
@@ -0,0 +1,19 @@
+ALTER TABLE cliente_enderecos
+    DROP CONSTRAINT IF EXISTS chk_cliente_enderecos_cep,
+    DROP COLUMN IF EXISTS cep,
+    DROP COLUMN IF EXISTS logradouro,
+    DROP COLUMN IF EXISTS numero,
+    DROP COLUMN IF EXISTS complemento,
+    DROP COLUMN IF EXISTS bairro,
+    DROP COLUMN IF EXISTS cidade,
+    DROP COLUMN IF EXISTS uf;
+
+ALTER TABLE pedidos
+    DROP CONSTRAINT IF EXISTS chk_pedidos_cep,
+    DROP COLUMN IF EXISTS cep,
+    DROP COLUMN IF EXISTS logradouro,
+    DROP COLUMN IF EXISTS numero,
+    DROP COLUMN IF EXISTS complemento,
+    DROP COLUMN IF EXISTS bairro,
+    DROP COLUMN IF EXISTS cidade,
+    DROP COLUMN IF EXISTS uf;
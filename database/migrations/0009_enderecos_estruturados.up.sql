@@ -0,0 +1,26 @@
+-- Endereço estruturado para a entrega, nos pedidos e nos endereços salvos dos
+-- clientes. A coluna endereco continua com o texto em uma linha; os registros
+-- anteriores a esta versão só têm o texto e ficam com os campos vazios.
+ALTER TABLE pedidos
+    ADD COLUMN IF NOT EXISTS cep         text NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS logradouro  text NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS numero      text NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS complemento text NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS bairro      text NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS cidade      text NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS uf          text NOT NULL DEFAULT '';
+
+ALTER TABLE cliente_enderecos
+    ADD COLUMN IF NOT EXISTS cep         text NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS logradouro  text NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS numero      text NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS complemento text NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS bairro      text NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS cidade      text NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS uf          text NOT NULL DEFAULT '';
+
+ALTER TABLE pedidos
+    ADD CONSTRAINT chk_pedidos_cep CHECK (cep = '' OR cep ~ '^[0-9]{8}$');
+
+ALTER TABLE cliente_enderecos
+    ADD CONSTRAINT chk_cliente_enderecos_cep CHECK (cep = '' OR cep ~ '^[0-9]{8}$');
@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_pedidos_zona_entrega_id;
+
+ALTER TABLE pedidos
+    DROP COLUMN IF EXISTS prazo_entrega_minutos,
+    DROP COLUMN IF EXISTS zona_entrega_id,
+    DROP COLUMN IF EXISTS taxa_entrega;
+
+DROP TABLE IF EXISTS zonas_entrega;
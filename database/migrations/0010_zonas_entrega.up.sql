@@ -0,0 +1,31 @@
+-- Zonas atendidas pela entrega, delimitadas por uma faixa de CEPs ou por um
+-- bairro, com a taxa, o valor mínimo do pedido e o prazo de entrega
+CREATE TABLE IF NOT EXISTS zonas_entrega (
+    id            bigserial PRIMARY KEY,
+    nome          text NOT NULL,
+    cep_inicio    text NOT NULL DEFAULT '',
+    cep_fim       text NOT NULL DEFAULT '',
+    bairro        text NOT NULL DEFAULT '',
+    cidade        text NOT NULL DEFAULT '',
+    uf            text NOT NULL DEFAULT '',
+    taxa          numeric(12,2) NOT NULL DEFAULT 0 CHECK (taxa >= 0),
+    pedido_minimo numeric(12,2) NOT NULL DEFAULT 0 CHECK (pedido_minimo >= 0),
+    prazo_minutos integer NOT NULL CHECK (prazo_minutos > 0),
+    ativa         boolean NOT NULL DEFAULT true,
+    atualizado_em timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT chk_zonas_entrega_criterio CHECK (
+        (bairro = '' AND cep_inicio ~ '^[0-9]{8}$' AND cep_fim ~ '^[0-9]{8}$' AND cep_inicio <= cep_fim)
+        OR (bairro <> '' AND cidade <> '' AND uf <> '' AND cep_inicio = '' AND cep_fim = '')
+    )
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_zonas_entrega_nome ON zonas_entrega (nome);
+
+-- O pedido guarda a taxa e o prazo da zona no momento da compra; ValorTotal passa
+-- a incluir a taxa. Pedidos anteriores ficam sem zona e com taxa zero.
+ALTER TABLE pedidos
+    ADD COLUMN IF NOT EXISTS taxa_entrega numeric(12,2) NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS zona_entrega_id bigint REFERENCES zonas_entrega (id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS prazo_entrega_minutos integer NOT NULL DEFAULT 0;
+
+CREATE INDEX IF NOT EXISTS idx_pedidos_zona_entrega_id ON pedidos (zona_entrega_id);
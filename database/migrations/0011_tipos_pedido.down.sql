@@ -0,0 +1,10 @@
+-- O fluxo anterior só conhece DELIVERY entre o preparo e a finalização
+UPDATE pedidos SET status = 'DELIVERY' WHERE status IN ('READY_FOR_PICKUP', 'SERVED');
+
+DROP INDEX IF EXISTS idx_pedidos_tipo;
+
+ALTER TABLE pedidos
+    DROP CONSTRAINT IF EXISTS chk_pedidos_mesa,
+    DROP CONSTRAINT IF EXISTS chk_pedidos_tipo,
+    DROP COLUMN IF EXISTS mesa,
+    DROP COLUMN IF EXISTS tipo;
@@ -0,0 +1,11 @@
+-- Tipo do pedido: entrega, retirada no balcão ou consumo no local, com o número
+-- da mesa. Os pedidos anteriores a esta versão são todos entregas.
+ALTER TABLE pedidos
+    ADD COLUMN IF NOT EXISTS tipo text NOT NULL DEFAULT 'DELIVERY',
+    ADD COLUMN IF NOT EXISTS mesa integer NOT NULL DEFAULT 0;
+
+ALTER TABLE pedidos
+    ADD CONSTRAINT chk_pedidos_tipo CHECK (tipo IN ('DELIVERY', 'PICKUP', 'DINE_IN')),
+    ADD CONSTRAINT chk_pedidos_mesa CHECK ((tipo = 'DINE_IN' AND mesa > 0) OR (tipo <> 'DINE_IN' AND mesa = 0));
+
+CREATE INDEX IF NOT EXISTS idx_pedidos_tipo ON pedidos (tipo);
@@ -0,0 +1,5 @@
+ALTER TABLE pedidos
+    DROP COLUMN IF EXISTS desconto;
+
+DROP TABLE IF EXISTS pedido_descontos;
+DROP TABLE IF EXISTS promocoes;
@@ -0,0 +1,49 @@
+-- Promoções: cupons percentuais ou de valor fixo, "leve N hambúrgueres e ganhe uma
+-- bebida", combos com preço fechado e janelas de happy hour. Sem cupom, a promoção
+-- vale para todos os pedidos que cumprem a regra.
+CREATE TABLE IF NOT EXISTS promocoes (
+    id                 bigserial PRIMARY KEY,
+    nome               text NOT NULL,
+    tipo               text NOT NULL CHECK (tipo IN ('PERCENTUAL', 'VALOR_FIXO', 'LEVE_GANHE', 'COMBO')),
+    cupom              text NOT NULL DEFAULT '',
+    percentual         integer NOT NULL DEFAULT 0 CHECK (percentual BETWEEN 0 AND 100),
+    valor              numeric(12,2) NOT NULL DEFAULT 0 CHECK (valor >= 0),
+    hamburguer_id      bigint REFERENCES hamburguers (id),
+    bebida_id          bigint REFERENCES items (id),
+    quantidade         integer NOT NULL DEFAULT 0 CHECK (quantidade >= 0),
+    pedido_minimo      numeric(12,2) NOT NULL DEFAULT 0 CHECK (pedido_minimo >= 0),
+    valido_de          timestamptz,
+    valido_ate         timestamptz,
+    hora_inicio        text NOT NULL DEFAULT '',
+    hora_fim           text NOT NULL DEFAULT '',
+    limite_usos        integer NOT NULL DEFAULT 0 CHECK (limite_usos >= 0),
+    limite_por_cliente integer NOT NULL DEFAULT 0 CHECK (limite_por_cliente >= 0),
+    ativa              boolean NOT NULL DEFAULT true,
+    atualizado_em      timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT chk_promocoes_validade CHECK (valido_de IS NULL OR valido_ate IS NULL OR valido_de < valido_ate),
+    CONSTRAINT chk_promocoes_horario CHECK (
+        (hora_inicio = '' AND hora_fim = '')
+        OR (hora_inicio ~ '^([01][0-9]|2[0-3]):[0-5][0-9]$' AND hora_fim ~ '^([01][0-9]|2[0-3]):[0-5][0-9]$' AND hora_inicio <> hora_fim)
+    )
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_promocoes_nome ON promocoes (nome);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_promocoes_cupom ON promocoes (cupom) WHERE cupom <> '';
+
+-- Descontos concedidos a cada pedido, com o nome e o valor do momento da compra;
+-- apagar a promoção mantém o desconto no pedido, sem o vínculo
+CREATE TABLE IF NOT EXISTS pedido_descontos (
+    id          bigserial PRIMARY KEY,
+    pedido_id   uuid NOT NULL REFERENCES pedidos (id) ON DELETE CASCADE,
+    promocao_id bigint REFERENCES promocoes (id) ON DELETE SET NULL,
+    descricao   text NOT NULL,
+    cupom       text NOT NULL DEFAULT '',
+    valor       numeric(12,2) NOT NULL CHECK (valor > 0)
+);
+
+CREATE INDEX IF NOT EXISTS idx_pedido_descontos_pedido_id ON pedido_descontos (pedido_id);
+CREATE INDEX IF NOT EXISTS idx_pedido_descontos_promocao_id ON pedido_descontos (promocao_id);
+
+-- ValorTotal passa a descontar a soma dos descontos; pedidos anteriores ficam sem desconto
+ALTER TABLE pedidos
+    ADD COLUMN IF NOT EXISTS desconto numeric(12,2) NOT NULL DEFAULT 0 CHECK (desconto >= 0);
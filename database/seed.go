@@ -7,12 +7,19 @@ import (
 
 func CleanDB() {
 	// Limpa todas as tabelas
+	DB.Exec("TRUNCATE TABLE pedido_hamburguer_personalizacoes CASCADE")
 	DB.Exec("TRUNCATE TABLE pedido_hamburgueres CASCADE")
 	DB.Exec("TRUNCATE TABLE pedido_bebidas CASCADE")
+	DB.Exec("TRUNCATE TABLE pedido_status_historico CASCADE")
+	DB.Exec("TRUNCATE TABLE pedido_descontos CASCADE")
 	DB.Exec("TRUNCATE TABLE hamburguer_ingredientes CASCADE")
 	DB.Exec("TRUNCATE TABLE pedidos CASCADE")
 	DB.Exec("TRUNCATE TABLE hamburguers CASCADE")
 	DB.Exec("TRUNCATE TABLE items CASCADE")
+	DB.Exec("TRUNCATE TABLE cliente_enderecos CASCADE")
+	DB.Exec("TRUNCATE TABLE clientes CASCADE")
+	DB.Exec("TRUNCATE TABLE zonas_entrega CASCADE")
+	DB.Exec("TRUNCATE TABLE promocoes CASCADE")
 }
 
 func SeedDB() {
@@ -21,21 +28,21 @@ func SeedDB() {
 	// Criando itens (bebidas e ingredientes)
 	itens := []models.Item{
 		// Bebidas
-		{ID: 1, Tipo: models.TipoBebida, Descricao: "Coca-Cola 350ml", Preco: 5.00, Extra: true},
-		{ID: 2, Tipo: models.TipoBebida, Descricao: "Coca-Cola Zero 350ml", Preco: 5.00, Extra: false},
-		{ID: 3, Tipo: models.TipoBebida, Descricao: "Guaraná Antarctica 350ml", Preco: 4.50, Extra: true},
-		{ID: 4, Tipo: models.TipoBebida, Descricao: "Água Mineral 500ml", Preco: 3.00, Extra: false},
+		{ID: 1, Tipo: models.TipoBebida, Descricao: "Coca-Cola 350ml", Preco: models.Centavos(500), Extra: true},
+		{ID: 2, Tipo: models.TipoBebida, Descricao: "Coca-Cola Zero 350ml", Preco: models.Centavos(500), Extra: false},
+		{ID: 3, Tipo: models.TipoBebida, Descricao: "Guaraná Antarctica 350ml", Preco: models.Centavos(450), Extra: true},
+		{ID: 4, Tipo: models.TipoBebida, Descricao: "Água Mineral 500ml", Preco: models.Centavos(300), Extra: false},
 		
 		// Ingredientes
-		{ID: 5, Tipo: models.TipoIngrediente, Descricao: "Pão Brioche", Preco: 2.00, Extra: false},
-		{ID: 6, Tipo: models.TipoIngrediente, Descricao: "Hambúrguer 180g", Preco: 8.00, Extra: false},
-		{ID: 7, Tipo: models.TipoIngrediente, Descricao: "Queijo Cheddar", Preco: 3.00, Extra: false},
-		{ID: 8, Tipo: models.TipoIngrediente, Descricao: "Bacon", Preco: 4.00, Extra: true},
-		{ID: 9, Tipo: models.TipoIngrediente, Descricao: "Alface", Preco: 1.00, Extra: false},
-		{ID: 10, Tipo: models.TipoIngrediente, Descricao: "Tomate", Preco: 1.00, Extra: false},
-		{ID: 11, Tipo: models.TipoIngrediente, Descricao: "Cebola Caramelizada", Preco: 2.00, Extra: true},
-		{ID: 12, Tipo: models.TipoIngrediente, Descricao: "Ovo", Preco: 2.50, Extra: true},
-		{ID: 13, Tipo: models.TipoIngrediente, Descricao: "Molho Especial", Preco: 1.50, Extra: false},
+		{ID: 5, Tipo: models.TipoIngrediente, Descricao: "Pão Brioche", Preco: models.Centavos(200), Extra: false},
+		{ID: 6, Tipo: models.TipoIngrediente, Descricao: "Hambúrguer 180g", Preco: models.Centavos(800), Extra: false},
+		{ID: 7, Tipo: models.TipoIngrediente, Descricao: "Queijo Cheddar", Preco: models.Centavos(300), Extra: false},
+		{ID: 8, Tipo: models.TipoIngrediente, Descricao: "Bacon", Preco: models.Centavos(400), Extra: true},
+		{ID: 9, Tipo: models.TipoIngrediente, Descricao: "Alface", Preco: models.Centavos(100), Extra: false},
+		{ID: 10, Tipo: models.TipoIngrediente, Descricao: "Tomate", Preco: models.Centavos(100), Extra: false},
+		{ID: 11, Tipo: models.TipoIngrediente, Descricao: "Cebola Caramelizada", Preco: models.Centavos(200), Extra: true},
+		{ID: 12, Tipo: models.TipoIngrediente, Descricao: "Ovo", Preco: models.Centavos(250), Extra: true},
+		{ID: 13, Tipo: models.TipoIngrediente, Descricao: "Molho Especial", Preco: models.Centavos(150), Extra: false},
 	}
 
 	for _, item := range itens {
@@ -49,22 +56,22 @@ func SeedDB() {
 		{
 			ID: 1,
 			Descricao: "Classic Burger",
-			Preco: 25.90,
+			Preco: models.Centavos(2590),
 		},
 		{
 			ID: 2,
 			Descricao: "Bacon Burger",
-			Preco: 29.90,
+			Preco: models.Centavos(2990),
 		},
 		{
 			ID: 3,
 			Descricao: "Mega Burger",
-			Preco: 34.90,
+			Preco: models.Centavos(3490),
 		},
 		{
 			ID: 4,
 			Descricao: "Duplo Burger Duplo Queijo",
-			Preco: 39.90,
+			Preco: models.Centavos(3990),
 		},
 	}
 
@@ -123,34 +130,100 @@ func SeedDB() {
 	var bebidasDisponiveis []models.Item
 	DB.Where("tipo = ?", models.TipoBebida).Find(&bebidasDisponiveis)
 
+	// Criando zonas de entrega; a zona por bairro vale antes das faixas de CEP
+	zonas := []models.ZonaEntrega{
+		{Nome: "Centro", CEPInicio: "01000000", CEPFim: "01599999", Taxa: models.Centavos(500), PedidoMinimo: models.Centavos(2000), PrazoMinutos: 30, Ativa: true},
+		{Nome: "Zona Oeste", CEPInicio: "05000000", CEPFim: "05899999", Taxa: models.Centavos(800), PedidoMinimo: models.Centavos(3000), PrazoMinutos: 45, Ativa: true},
+		{Nome: "Itaim Bibi", Bairro: "Itaim Bibi", Cidade: "São Paulo", UF: "SP", Taxa: models.Centavos(700), PedidoMinimo: models.Centavos(3000), PrazoMinutos: 40, Ativa: true},
+	}
+
+	for i := range zonas {
+		if err := DB.Create(&zonas[i]).Error; err != nil {
+			log.Printf("Erro ao criar zona de entrega %s: %v\n", zonas[i].Nome, err)
+		}
+	}
+
+	// Criando promoções; as sem cupom valem para todos os pedidos que cumprem a regra
+	classic, bacon, coca := hamburgueres[0].ID, hamburgueres[1].ID, bebidasDisponiveis[0].ID
+	promocoes := []models.Promocao{
+		{Nome: "Boas-vindas", Tipo: models.PromocaoPercentual, Cupom: "BEMVINDO10", Percentual: 10, LimitePorCliente: 1, Ativa: true},
+		{Nome: "R$ 5 de desconto", Tipo: models.PromocaoValorFixo, Cupom: "CINCO", Valor: models.Centavos(500), PedidoMinimo: models.Centavos(4000), LimiteUsos: 100, Ativa: true},
+		{Nome: "Leve 2 Classic Burger e ganhe uma bebida", Tipo: models.PromocaoLeveGanhe, HamburguerID: &classic, Quantidade: 2, Ativa: true},
+		{Nome: "Combo Bacon + Coca", Tipo: models.PromocaoCombo, HamburguerID: &bacon, BebidaID: &coca, Valor: models.Centavos(3190), Ativa: true},
+		{Nome: "Happy hour", Tipo: models.PromocaoPercentual, Percentual: 15, HoraInicio: "17:00", HoraFim: "19:00", Ativa: true},
+	}
+
+	for i := range promocoes {
+		if err := DB.Create(&promocoes[i]).Error; err != nil {
+			log.Printf("Erro ao criar promoção %s: %v\n", promocoes[i].Nome, err)
+		}
+	}
+
+	// Criando clientes; os pedidos referenciam o cliente pelo telefone
+	casaJoao := models.EnderecoEntrega{CEP: "01001000", Logradouro: "Praça da Sé", Numero: "123", Complemento: "Apto 4", Bairro: "Sé", Cidade: "São Paulo", UF: "SP"}
+	trabalhoJoao := models.EnderecoEntrega{CEP: "01310100", Logradouro: "Avenida Paulista", Numero: "1000", Bairro: "Bela Vista", Cidade: "São Paulo", UF: "SP"}
+	casaMaria := models.EnderecoEntrega{CEP: "04538133", Logradouro: "Avenida Brigadeiro Faria Lima", Numero: "456", Bairro: "Itaim Bibi", Cidade: "São Paulo", UF: "SP"}
+	casaPedro := models.EnderecoEntrega{CEP: "05422030", Logradouro: "Rua dos Pinheiros", Numero: "789", Bairro: "Pinheiros", Cidade: "São Paulo", UF: "SP"}
+
+	clientes := []models.Cliente{
+		{Telefone: "11999999999", Nome: "João Silva", Enderecos: []models.ClienteEndereco{
+			{Apelido: "Casa", Endereco: casaJoao.Texto(), EnderecoEntrega: casaJoao, Principal: true},
+			{Apelido: "Trabalho", Endereco: trabalhoJoao.Texto(), EnderecoEntrega: trabalhoJoao},
+		}},
+		{Telefone: "11988888888", Nome: "Maria Santos", Enderecos: []models.ClienteEndereco{
+			{Apelido: "Casa", Endereco: casaMaria.Texto(), EnderecoEntrega: casaMaria, Principal: true},
+		}},
+		{Telefone: "11966666666", Nome: "Pedro Oliveira", Enderecos: []models.ClienteEndereco{
+			{Endereco: casaPedro.Texto(), EnderecoEntrega: casaPedro, Principal: true},
+		}},
+	}
+
+	for _, cliente := range clientes {
+		if err := DB.Create(&cliente).Error; err != nil {
+			log.Printf("Erro ao criar cliente %s: %v\n", cliente.Nome, err)
+		}
+	}
+
 	// Criando pedidos
 	pedidos := []models.Pedido{
 		{
 			Descricao: "Pedido para João",
 			Status: models.StatusStarted,
 			Nome: "João Silva",
-			Endereco: "Rua das Flores, 123",
+			Endereco: casaJoao.Texto(),
+			EnderecoEntrega: casaJoao,
 			Telefone: "11999999999",
 			Observacoes: "Sem cebola, por favor",
-			ValorTotal: hamburgueres[0].Preco + bebidasDisponiveis[0].Preco,
+			TaxaEntrega: zonas[0].Taxa,
+			ZonaEntregaID: &zonas[0].ID,
+			PrazoEntregaMinutos: zonas[0].PrazoMinutos,
+			ValorTotal: hamburgueres[0].Preco + bebidasDisponiveis[0].Preco + zonas[0].Taxa,
 		},
 		{
 			Descricao: "Pedido para Maria",
 			Status: models.StatusDelivery,
 			Nome: "Maria Santos",
-			Endereco: "Av. Principal, 456",
+			Endereco: casaMaria.Texto(),
+			EnderecoEntrega: casaMaria,
 			Telefone: "11988888888",
 			Observacoes: "Bacon bem passado",
-			ValorTotal: hamburgueres[1].Preco + hamburgueres[2].Preco + bebidasDisponiveis[1].Preco + bebidasDisponiveis[3].Preco,
+			TaxaEntrega: zonas[2].Taxa,
+			ZonaEntregaID: &zonas[2].ID,
+			PrazoEntregaMinutos: zonas[2].PrazoMinutos,
+			ValorTotal: hamburgueres[1].Preco + hamburgueres[2].Preco + bebidasDisponiveis[1].Preco + bebidasDisponiveis[3].Preco + zonas[2].Taxa,
 		},
 		{
 			Descricao: "Pedido para Pedro",
 			Status: models.StatusStarted,
 			Nome: "Pedro Oliveira",
-			Endereco: "Rua dos Pinheiros, 789",
+			Endereco: casaPedro.Texto(),
+			EnderecoEntrega: casaPedro,
 			Telefone: "11966666666",
 			Observacoes: "Todos os hambúrgueres sem tomate",
-			ValorTotal: (hamburgueres[0].Preco * 2) + (bebidasDisponiveis[0].Preco * 2),
+			TaxaEntrega: zonas[1].Taxa,
+			ZonaEntregaID: &zonas[1].ID,
+			PrazoEntregaMinutos: zonas[1].PrazoMinutos,
+			ValorTotal: hamburgueres[0].Preco.Multiplicar(2) + bebidasDisponiveis[0].Preco.Multiplicar(2) + zonas[1].Taxa,
 		},
 	}
 
@@ -160,12 +233,23 @@ func SeedDB() {
 			continue
 		}
 
+		historico := models.PedidoStatusHistorico{
+			PedidoID:   pedido.ID,
+			StatusNovo: pedido.Status,
+			Ator:       "seed",
+		}
+		if err := DB.Create(&historico).Error; err != nil {
+			log.Printf("Erro ao registrar histórico do pedido %s: %v\n", pedido.Descricao, err)
+		}
+
 		// Criar relacionamentos com hambúrgueres
 		switch pedido.Descricao {
 		case "Pedido para João":
 			pedidoHamburguer := models.PedidoHamburguer{
 				PedidoID: pedido.ID,
 				HamburguerID: hamburgueres[0].ID,
+				Descricao: hamburgueres[0].Descricao,
+				PrecoUnitario: hamburgueres[0].Preco,
 				Quantidade: 1,
 			}
 			if err := DB.Create(&pedidoHamburguer).Error; err != nil {
@@ -175,6 +259,8 @@ func SeedDB() {
 			pedidoBebida := models.PedidoBebida{
 				PedidoID: pedido.ID,
 				ItemID: bebidasDisponiveis[0].ID,
+				Descricao: bebidasDisponiveis[0].Descricao,
+				PrecoUnitario: bebidasDisponiveis[0].Preco,
 				Quantidade: 1,
 			}
 			if err := DB.Create(&pedidoBebida).Error; err != nil {
@@ -186,6 +272,8 @@ func SeedDB() {
 			pedidoHamburguer1 := models.PedidoHamburguer{
 				PedidoID: pedido.ID,
 				HamburguerID: hamburgueres[1].ID,
+				Descricao: hamburgueres[1].Descricao,
+				PrecoUnitario: hamburgueres[1].Preco,
 				Quantidade: 1,
 			}
 			if err := DB.Create(&pedidoHamburguer1).Error; err != nil {
@@ -196,6 +284,8 @@ func SeedDB() {
 			pedidoHamburguer2 := models.PedidoHamburguer{
 				PedidoID: pedido.ID,
 				HamburguerID: hamburgueres[2].ID,
+				Descricao: hamburgueres[2].Descricao,
+				PrecoUnitario: hamburgueres[2].Preco,
 				Quantidade: 1,
 			}
 			if err := DB.Create(&pedidoHamburguer2).Error; err != nil {
@@ -206,6 +296,8 @@ func SeedDB() {
 			pedidoBebida1 := models.PedidoBebida{
 				PedidoID: pedido.ID,
 				ItemID: bebidasDisponiveis[1].ID,
+				Descricao: bebidasDisponiveis[1].Descricao,
+				PrecoUnitario: bebidasDisponiveis[1].Preco,
 				Quantidade: 1,
 			}
 			if err := DB.Create(&pedidoBebida1).Error; err != nil {
@@ -216,6 +308,8 @@ func SeedDB() {
 			pedidoBebida2 := models.PedidoBebida{
 				PedidoID: pedido.ID,
 				ItemID: bebidasDisponiveis[3].ID,
+				Descricao: bebidasDisponiveis[3].Descricao,
+				PrecoUnitario: bebidasDisponiveis[3].Preco,
 				Quantidade: 1,
 			}
 			if err := DB.Create(&pedidoBebida2).Error; err != nil {
@@ -227,6 +321,8 @@ func SeedDB() {
 			pedidoHamburguer := models.PedidoHamburguer{
 				PedidoID: pedido.ID,
 				HamburguerID: hamburgueres[0].ID,
+				Descricao: hamburgueres[0].Descricao,
+				PrecoUnitario: hamburgueres[0].Preco,
 				Quantidade: 2,
 			}
 			if err := DB.Create(&pedidoHamburguer).Error; err != nil {
@@ -237,6 +333,8 @@ func SeedDB() {
 			pedidoBebida := models.PedidoBebida{
 				PedidoID: pedido.ID,
 				ItemID: bebidasDisponiveis[0].ID,
+				Descricao: bebidasDisponiveis[0].Descricao,
+				PrecoUnitario: bebidasDisponiveis[0].Preco,
 				Quantidade: 2,
 			}
 			if err := DB.Create(&pedidoBebida).Error; err != nil {
@@ -15,9 +15,9 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/hamburguers": {
+        "/admin/hamburguers/removidos": {
             "get": {
-                "description": "Retorna uma lista de todos os hamburgueres disponíveis",
+                "description": "Retorna os hamburgueres removidos logicamente e ainda não expurgados, com suas receitas",
                 "consumes": [
                     "application/json"
                 ],
@@ -25,23 +25,42 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "hamburgueres"
+                    "admin"
+                ],
+                "summary": "Lista os hamburgueres removidos",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Quantidade máxima de hamburgueres por página (padrão 20, máximo 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor da próxima página, retornado em next_cursor",
+                        "name": "cursor",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Lista todos os hamburgueres",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.Hamburguer"
-                            }
+                            "$ref": "#/definitions/models.Pagina-models_Hamburguer"
+                        }
+                    },
+                    "400": {
+                        "description": "Paginação inválida",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/admin/hamburguers/{id}/restaurar": {
             "post": {
-                "description": "Cria um novo hamburguer com os dados fornecidos",
+                "description": "Desfaz a remoção lógica de um hamburguer; todos os ingredientes da receita precisam estar ativos",
                 "consumes": [
                     "application/json"
                 ],
@@ -49,45 +68,49 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "hamburgueres"
+                    "admin"
                 ],
-                "summary": "Cria um novo hamburguer",
+                "summary": "Restaura um hamburguer removido",
                 "parameters": [
                     {
-                        "description": "Dados do Hamburguer",
-                        "name": "hamburguer",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.HamburguerRequest"
-                        }
+                        "type": "integer",
+                        "description": "ID do Hamburguer",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/models.Hamburguer"
                         }
                     },
                     "400": {
-                        "description": "Erro na validação dos dados",
+                        "description": "ID inválido",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/models.ErroResponse"
                         }
                     },
                     "404": {
-                        "description": "Ingrediente não encontrado",
+                        "description": "Nenhum hamburguer removido com este ID",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "A receita usa ingredientes removidos",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
                         }
                     }
                 }
             }
         },
-        "/hamburguers/nome/{nome}": {
+        "/admin/itens/removidos": {
             "get": {
-                "description": "Retorna um hamburguer específico baseado no nome",
+                "description": "Retorna os itens (bebidas e ingredientes) removidos logicamente e ainda não expurgados",
                 "consumes": [
                     "application/json"
                 ],
@@ -95,37 +118,42 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "hamburgueres"
+                    "admin"
                 ],
-                "summary": "Busca um hamburguer por nome",
+                "summary": "Lista os itens removidos",
                 "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Quantidade máxima de itens por página (padrão 20, máximo 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
-                        "description": "Nome do Hamburguer",
-                        "name": "nome",
-                        "in": "path",
-                        "required": true
+                        "description": "Cursor da próxima página, retornado em next_cursor",
+                        "name": "cursor",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Hamburguer"
+                            "$ref": "#/definitions/models.Pagina-models_ItemResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "400": {
+                        "description": "Paginação inválida",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/models.ErroResponse"
                         }
                     }
                 }
             }
         },
-        "/hamburguers/{id}": {
-            "get": {
-                "description": "Retorna um hamburguer específico baseado no ID",
+        "/admin/itens/{codigo}/restaurar": {
+            "post": {
+                "description": "Desfaz a remoção lógica de um item (bebida ou ingrediente)",
                 "consumes": [
                     "application/json"
                 ],
@@ -133,14 +161,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "hamburgueres"
+                    "admin"
                 ],
-                "summary": "Busca um hamburguer por ID",
+                "summary": "Restaura um item removido",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "ID do Hamburguer",
-                        "name": "id",
+                        "type": "string",
+                        "description": "Código do item",
+                        "name": "codigo",
                         "in": "path",
                         "required": true
                     }
@@ -149,19 +177,27 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Hamburguer"
+                            "$ref": "#/definitions/models.ItemResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Código inválido",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
                         }
                     },
                     "404": {
-                        "description": "Hamburguer não encontrado",
+                        "description": "Nenhum item removido com este código",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/models.ErroResponse"
                         }
                     }
                 }
-            },
-            "put": {
-                "description": "Atualiza um hamburguer existente com os dados fornecidos",
+            }
+        },
+        "/admin/pedidos/removidos": {
+            "get": {
+                "description": "Retorna os pedidos removidos logicamente e ainda não expurgados, com os mesmos filtros da listagem de pedidos",
                 "consumes": [
                     "application/json"
                 ],
@@ -169,50 +205,115 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "hamburgueres"
+                    "admin"
                 ],
-                "summary": "Atualiza um hamburguer existente",
+                "summary": "Lista os pedidos removidos",
                 "parameters": [
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Filtra por um ou mais status (ex.: status=STARTED,DELIVERY)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "DELIVERY",
+                            "PICKUP",
+                            "DINE_IN"
+                        ],
+                        "type": "string",
+                        "description": "Filtra pelo tipo do pedido",
+                        "name": "tipo",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Somente pedidos não finalizados nem cancelados",
+                        "name": "abertos",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Data inicial (AAAA-MM-DD)",
+                        "name": "data_inicio",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Data final, inclusiva (AAAA-MM-DD)",
+                        "name": "data_fim",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Telefone do cliente",
+                        "name": "telefone",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Parte do nome do cliente",
+                        "name": "nome",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "data",
+                            "valor_total",
+                            "nome",
+                            "status"
+                        ],
+                        "type": "string",
+                        "description": "Campo de ordenação",
+                        "name": "ordem",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Direção da ordenação",
+                        "name": "direcao",
+                        "in": "query"
+                    },
                     {
                         "type": "integer",
-                        "description": "ID do Hamburguer",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Quantidade máxima de pedidos por página (padrão 20, máximo 100)",
+                        "name": "limit",
+                        "in": "query"
                     },
                     {
-                        "description": "Dados do Hamburguer",
-                        "name": "hamburguer",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.HamburguerUpdateRequest"
-                        }
+                        "type": "string",
+                        "description": "Cursor da próxima página, retornado em next_cursor",
+                        "name": "cursor",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Hamburguer"
+                            "$ref": "#/definitions/models.Pagina-models_PedidoResponse"
                         }
                     },
                     "400": {
-                        "description": "Erro na validação dos dados",
-                        "schema": {
-                            "type": "string"
-                        }
-                    },
-                    "404": {
-                        "description": "Hamburguer não encontrado",
+                        "description": "Filtro ou paginação inválidos",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/models.ErroResponse"
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Deleta um hamburguer existente",
+            }
+        },
+        "/admin/pedidos/{id}/restaurar": {
+            "post": {
+                "description": "Desfaz a remoção lógica de um pedido, com suas linhas e seu histórico",
                 "consumes": [
                     "application/json"
                 ],
@@ -220,40 +321,37 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "hamburgueres"
+                    "admin"
                 ],
-                "summary": "Deleta um hamburguer",
+                "summary": "Restaura um pedido removido",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "ID do Hamburguer",
+                        "type": "string",
+                        "description": "ID do Pedido",
                         "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
-                    },
-                    "400": {
-                        "description": "Erro ao deletar hamburguer",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/models.PedidoResponse"
                         }
                     },
                     "404": {
-                        "description": "Hamburguer não encontrado",
+                        "description": "Nenhum pedido removido com este ID",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/models.ErroResponse"
                         }
                     }
                 }
             }
         },
-        "/itens": {
+        "/clientes": {
             "post": {
-                "description": "Cria um novo item (bebida ou ingrediente) com os dados fornecidos",
+                "description": "Cadastra um cliente com, opcionalmente, seus endereços. O primeiro endereço é o principal se nenhum for marcado.\nCom um CEP conhecido, logradouro, bairro, cidade e UF são completados pela consulta de CEP.",
                 "consumes": [
                     "application/json"
                 ],
@@ -261,17 +359,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "itens"
+                    "clientes"
                 ],
-                "summary": "Cria um novo item",
+                "summary": "Cadastra um cliente",
                 "parameters": [
                     {
-                        "description": "Dados do Item",
-                        "name": "item",
+                        "description": "Dados do cliente",
+                        "name": "cliente",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.ItemRequest"
+                            "$ref": "#/definitions/models.ClienteRequest"
                         }
                     }
                 ],
@@ -279,21 +377,27 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/models.ItemResponse"
+                            "$ref": "#/definitions/models.Cliente"
                         }
                     },
                     "400": {
-                        "description": "Erro na validação dos dados",
+                        "description": "Erro na validação dos dados, CEP não encontrado ou endereço incompleto",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Telefone já cadastrado",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
                         }
                     }
                 }
             }
         },
-        "/itens/bebidas": {
+        "/clientes/{telefone}": {
             "get": {
-                "description": "Retorna uma lista de todas as bebidas disponíveis",
+                "description": "Retorna o cliente do telefone com os endereços salvos",
                 "consumes": [
                     "application/json"
                 ],
@@ -301,31 +405,41 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "itens"
+                    "clientes"
+                ],
+                "summary": "Busca um cliente",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Telefone do cliente, só com números e DDD",
+                        "name": "telefone",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Lista todas as bebidas",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.ItemResponse"
-                            }
+                            "$ref": "#/definitions/models.Cliente"
+                        }
+                    },
+                    "400": {
+                        "description": "Telefone inválido",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
                         }
                     },
                     "404": {
-                        "description": "Nenhuma bebida encontrada",
+                        "description": "Cliente não encontrado",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/models.ErroResponse"
                         }
                     }
                 }
-            }
-        },
-        "/itens/ingredientes": {
-            "get": {
-                "description": "Retorna uma lista de todos os ingredientes disponíveis",
+            },
+            "put": {
+                "description": "Altera o nome do cliente. Pedidos já feitos mantêm o nome usado na compra.",
                 "consumes": [
                     "application/json"
                 ],
@@ -333,31 +447,52 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "itens"
+                    "clientes"
+                ],
+                "summary": "Atualiza um cliente",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Telefone do cliente, só com números e DDD",
+                        "name": "telefone",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Novo nome",
+                        "name": "cliente",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.ClienteUpdateRequest"
+                        }
+                    }
                 ],
-                "summary": "Lista todos os ingredientes",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.ItemResponse"
-                            }
+                            "$ref": "#/definitions/models.Cliente"
+                        }
+                    },
+                    "400": {
+                        "description": "Telefone ou dados inválidos",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
                         }
                     },
                     "404": {
-                        "description": "Nenhum ingrediente encontrado",
+                        "description": "Cliente não encontrado",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/models.ErroResponse"
                         }
                     }
                 }
             }
         },
-        "/itens/todos": {
-            "get": {
-                "description": "Retorna uma lista de todos os itens (bebidas e ingredientes)",
+        "/clientes/{telefone}/enderecos": {
+            "post": {
+                "description": "Salva um endereço de entrega no cadastro. Marcado como principal, substitui o principal anterior.\nCom um CEP conhecido, logradouro, bairro, cidade e UF são completados pela consulta de CEP.",
                 "consumes": [
                     "application/json"
                 ],
@@ -365,31 +500,52 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "itens"
+                    "clientes"
                 ],
-                "summary": "Lista todos os itens",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.ItemResponse"
-                            }
-                        }
-                    },
-                    "404": {
-                        "description": "Nenhum item encontrado",
+                "summary": "Adiciona um endereço ao cliente",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Telefone do cliente, só com números e DDD",
+                        "name": "telefone",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Endereço de entrega",
+                        "name": "endereco",
+                        "in": "body",
+                        "required": true,
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/models.ClienteEnderecoRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.Cliente"
+                        }
+                    },
+                    "400": {
+                        "description": "Telefone ou dados inválidos, CEP não encontrado ou endereço incompleto",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Cliente não encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
                         }
                     }
                 }
             }
         },
-        "/itens/{codigo}": {
-            "get": {
-                "description": "Retorna um item específico (bebida ou ingrediente) buscando por código",
+        "/clientes/{telefone}/enderecos/{id}": {
+            "delete": {
+                "description": "Apaga o endereço do cadastro; se era o principal, o mais antigo dos restantes assume. Pedidos já feitos mantêm o endereço de entrega.",
                 "consumes": [
                     "application/json"
                 ],
@@ -397,41 +553,50 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "itens"
+                    "clientes"
                 ],
-                "summary": "Busca um item por código",
+                "summary": "Remove um endereço do cliente",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Código do item",
-                        "name": "codigo",
+                        "description": "Telefone do cliente, só com números e DDD",
+                        "name": "telefone",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID do endereço",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Endereço removido com sucesso",
                         "schema": {
-                            "$ref": "#/definitions/models.ItemResponse"
+                            "type": "string"
                         }
                     },
                     "400": {
-                        "description": "Código inválido",
+                        "description": "Telefone ou ID inválido",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/models.ErroResponse"
                         }
                     },
                     "404": {
-                        "description": "Item não encontrado",
+                        "description": "Cliente ou endereço não encontrado",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/models.ErroResponse"
                         }
                     }
                 }
-            },
-            "put": {
-                "description": "Atualiza um item (bebida ou ingrediente) existente",
+            }
+        },
+        "/clientes/{telefone}/pedidos": {
+            "get": {
+                "description": "Retorna o histórico de pedidos do cliente, com os mesmos filtros da listagem de pedidos",
                 "consumes": [
                     "application/json"
                 ],
@@ -439,50 +604,116 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "itens"
+                    "clientes"
                 ],
-                "summary": "Atualiza um item existente",
+                "summary": "Lista os pedidos de um cliente",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Código do item",
-                        "name": "codigo",
+                        "description": "Telefone do cliente, só com números e DDD",
+                        "name": "telefone",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Dados do Item",
-                        "name": "item",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.ItemUpdateRequest"
-                        }
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Filtra por um ou mais status (ex.: status=STARTED,DELIVERY)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "DELIVERY",
+                            "PICKUP",
+                            "DINE_IN"
+                        ],
+                        "type": "string",
+                        "description": "Filtra pelo tipo do pedido",
+                        "name": "tipo",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Somente pedidos não finalizados nem cancelados",
+                        "name": "abertos",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Data inicial (AAAA-MM-DD)",
+                        "name": "data_inicio",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Data final, inclusiva (AAAA-MM-DD)",
+                        "name": "data_fim",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "data",
+                            "valor_total",
+                            "nome",
+                            "status"
+                        ],
+                        "type": "string",
+                        "description": "Campo de ordenação",
+                        "name": "ordem",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Direção da ordenação",
+                        "name": "direcao",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Quantidade máxima de pedidos por página (padrão 20, máximo 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor da próxima página, retornado em next_cursor",
+                        "name": "cursor",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.ItemResponse"
+                            "$ref": "#/definitions/models.Pagina-models_PedidoResponse"
                         }
                     },
                     "400": {
-                        "description": "Código inválido",
+                        "description": "Telefone, filtro ou paginação inválidos",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/models.ErroResponse"
                         }
                     },
                     "404": {
-                        "description": "Item não encontrado",
+                        "description": "Cliente não encontrado",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/models.ErroResponse"
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Remove um item (bebida ou ingrediente) existente pelo código",
+            }
+        },
+        "/estoque": {
+            "get": {
+                "description": "Retorna o saldo de todos os itens com estoque controlado",
                 "consumes": [
                     "application/json"
                 ],
@@ -490,43 +721,42 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "itens"
+                    "estoque"
                 ],
-                "summary": "Deleta um item existente",
+                "summary": "Lista o estoque",
                 "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Quantidade máxima de itens por página (padrão 20, máximo 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
-                        "description": "Código do item",
-                        "name": "codigo",
-                        "in": "path",
-                        "required": true
+                        "description": "Cursor da próxima página, retornado em next_cursor",
+                        "name": "cursor",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Item removido com sucesso",
+                        "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/models.Pagina-models_EstoqueResponse"
                         }
                     },
                     "400": {
-                        "description": "Código inválido",
-                        "schema": {
-                            "type": "string"
-                        }
-                    },
-                    "404": {
-                        "description": "Item não encontrado",
+                        "description": "Paginação inválida",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/models.ErroResponse"
                         }
                     }
                 }
             }
         },
-        "/pedidos": {
+        "/estoque/alertas": {
             "get": {
-                "description": "Retorna uma lista de todos os pedidos cadastrados, com opção de filtrar por status não finalizado",
+                "description": "Retorna os itens cujo saldo chegou ao ponto de reposição ou ficou abaixo dele",
                 "consumes": [
                     "application/json"
                 ],
@@ -534,14 +764,20 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "pedidos"
+                    "estoque"
                 ],
-                "summary": "Lista todos os pedidos",
+                "summary": "Lista os alertas de estoque baixo",
                 "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Quantidade máxima de itens por página (padrão 20, máximo 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
-                        "description": "Filtrar por status não finalizado (true/false)",
-                        "name": "status",
+                        "description": "Cursor da próxima página, retornado em next_cursor",
+                        "name": "cursor",
                         "in": "query"
                     }
                 ],
@@ -549,16 +785,21 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.PedidoResponse"
-                            }
+                            "$ref": "#/definitions/models.Pagina-models_EstoqueResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Paginação inválida",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "Cria um novo pedido com os dados fornecidos",
+            }
+        },
+        "/estoque/relatorio": {
+            "get": {
+                "description": "Retorna o saldo de cada item ao fim do dia informado, somado a partir do registro de movimentos",
                 "consumes": [
                     "application/json"
                 ],
@@ -566,39 +807,36 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "pedidos"
+                    "estoque"
                 ],
-                "summary": "Cria um novo pedido",
+                "summary": "Relatório de estoque em uma data",
                 "parameters": [
                     {
-                        "description": "Dados do Pedido",
-                        "name": "pedido",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.PedidoRequest"
-                        }
+                        "type": "string",
+                        "description": "Data do relatório (AAAA-MM-DD); padrão hoje",
+                        "name": "data",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.PedidoResponse"
+                            "$ref": "#/definitions/models.RelatorioEstoqueResponse"
                         }
                     },
                     "400": {
-                        "description": "Erro na validação dos dados",
+                        "description": "Data inválida",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/models.ErroResponse"
                         }
                     }
                 }
             }
         },
-        "/pedidos/{id}": {
+        "/estoque/{codigo}": {
             "get": {
-                "description": "Retorna um pedido específico baseado no ID",
+                "description": "Retorna o saldo, a unidade e o ponto de reposição de um item com estoque controlado",
                 "consumes": [
                     "application/json"
                 ],
@@ -606,14 +844,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "pedidos"
+                    "estoque"
                 ],
-                "summary": "Busca um pedido por ID",
+                "summary": "Busca o estoque de um item",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID do Pedido",
-                        "name": "id",
+                        "description": "Código do item",
+                        "name": "codigo",
                         "in": "path",
                         "required": true
                     }
@@ -622,19 +860,25 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.PedidoResponse"
+                            "$ref": "#/definitions/models.EstoqueResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Código inválido",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
                         }
                     },
                     "404": {
-                        "description": "Pedido não encontrado",
+                        "description": "Item sem estoque controlado",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/models.ErroResponse"
                         }
                     }
                 }
             },
             "put": {
-                "description": "Atualiza um pedido existente com os dados fornecidos",
+                "description": "Passa a controlar o estoque do item ou altera a unidade e o ponto de reposição. As quantidades\ndas receitas são lidas na mesma unidade. Se a quantidade contada for informada, a diferença\npara o saldo atual é registrada como um movimento AJUSTE.",
                 "consumes": [
                     "application/json"
                 ],
@@ -642,24 +886,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "pedidos"
+                    "estoque"
                 ],
-                "summary": "Atualiza um pedido existente",
+                "summary": "Define o estoque de um item",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID do Pedido",
-                        "name": "id",
+                        "description": "Código do item",
+                        "name": "codigo",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Dados do Pedido",
-                        "name": "pedido",
+                        "description": "Saldo e parâmetros do estoque",
+                        "name": "estoque",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.PedidoUpdateRequest"
+                            "$ref": "#/definitions/models.EstoqueRequest"
                         }
                     }
                 ],
@@ -667,25 +911,31 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.PedidoResponse"
+                            "$ref": "#/definitions/models.EstoqueResponse"
                         }
                     },
                     "400": {
-                        "description": "Erro na validação dos dados",
+                        "description": "Código, unidade ou dados inválidos",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/models.ErroResponse"
                         }
                     },
                     "404": {
-                        "description": "Pedido não encontrado",
+                        "description": "Item não encontrado",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Saldo alterado por um pedido durante a contagem",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
                         }
                     }
                 }
             },
             "delete": {
-                "description": "Deleta um pedido existente",
+                "description": "Zera o saldo do item com um movimento AJUSTE e deixa de controlá-lo; a partir daí os pedidos\nnão baixam mais o seu estoque. Os movimentos anteriores são mantidos.",
                 "consumes": [
                     "application/json"
                 ],
@@ -693,292 +943,3247 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "pedidos"
+                    "estoque"
                 ],
-                "summary": "Deleta um pedido",
+                "summary": "Deixa de controlar o estoque de um item",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID do Pedido",
-                        "name": "id",
+                        "description": "Código do item",
+                        "name": "codigo",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "O estoque do item deixou de ser controlado",
+                        "schema": {
+                            "type": "string"
+                        }
                     },
                     "400": {
-                        "description": "Erro ao deletar pedido",
+                        "description": "Código inválido",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/models.ErroResponse"
                         }
                     },
                     "404": {
-                        "description": "Pedido não encontrado",
+                        "description": "Item sem estoque controlado",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/models.ErroResponse"
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "models.Hamburguer": {
-            "type": "object",
-            "required": [
-                "descricao",
-                "preco"
-            ],
-            "properties": {
-                "descricao": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "integer"
-                },
-                "ingredientes": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.HamburguerIngrediente"
-                    }
-                },
-                "preco": {
-                    "type": "number"
-                }
-            }
         },
-        "models.HamburguerIngrediente": {
-            "type": "object",
-            "properties": {
-                "hamburguerID": {
-                    "type": "integer"
-                },
-                "item": {
-                    "$ref": "#/definitions/models.Item"
-                },
-                "itemID": {
-                    "type": "integer"
-                },
+        "/estoque/{codigo}/ajustes": {
+            "post": {
+                "description": "Lança uma PERDA (desperdício, vencimento ou quebra), sempre com quantidade positiva, ou um\nAJUSTE manual, com quantidade negativa para retirar do estoque. A observação explica o motivo.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "estoque"
+                ],
+                "summary": "Registra uma perda ou um ajuste",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Código do item",
+                        "name": "codigo",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dados do ajuste",
+                        "name": "ajuste",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.AjusteEstoqueRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.MovimentoEstoque"
+                        }
+                    },
+                    "400": {
+                        "description": "Código, tipo ou dados inválidos",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Item sem estoque controlado",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Estoque insuficiente",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/estoque/{codigo}/movimentos": {
+            "get": {
+                "description": "Retorna o registro de movimentos do item em ordem de lançamento, com o saldo após cada um",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "estoque"
+                ],
+                "summary": "Lista os movimentos de um item",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Código do item",
+                        "name": "codigo",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "RECEBIMENTO",
+                            "CONSUMO",
+                            "ESTORNO",
+                            "PERDA",
+                            "AJUSTE"
+                        ],
+                        "type": "string",
+                        "description": "Tipo do movimento",
+                        "name": "tipo",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Data inicial (AAAA-MM-DD)",
+                        "name": "data_inicio",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Data final, inclusiva (AAAA-MM-DD)",
+                        "name": "data_fim",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Quantidade máxima de movimentos por página (padrão 20, máximo 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor da próxima página, retornado em next_cursor",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Pagina-models_MovimentoEstoque"
+                        }
+                    },
+                    "400": {
+                        "description": "Código, filtro ou paginação inválidos",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/estoque/{codigo}/recebimentos": {
+            "post": {
+                "description": "Lança no estoque a mercadoria entregue por um fornecedor, com o documento de entrada",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "estoque"
+                ],
+                "summary": "Registra um recebimento",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Código do item",
+                        "name": "codigo",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dados do recebimento",
+                        "name": "recebimento",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.RecebimentoRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.MovimentoEstoque"
+                        }
+                    },
+                    "400": {
+                        "description": "Código ou dados inválidos",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Item sem estoque controlado",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/hamburguers": {
+            "get": {
+                "description": "Retorna uma lista de todos os hamburgueres disponíveis",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "hamburgueres"
+                ],
+                "summary": "Lista todos os hamburgueres",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Quantidade máxima de hamburgueres por página (padrão 20, máximo 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor da próxima página, retornado em next_cursor",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Pagina-models_Hamburguer"
+                        }
+                    },
+                    "400": {
+                        "description": "Paginação inválida",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Cria um novo hamburguer com os dados fornecidos",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "hamburgueres"
+                ],
+                "summary": "Cria um novo hamburguer",
+                "parameters": [
+                    {
+                        "description": "Dados do Hamburguer",
+                        "name": "hamburguer",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.HamburguerRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.Hamburguer"
+                        }
+                    },
+                    "400": {
+                        "description": "Erro na validação dos dados ou ingrediente inválido",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Já existe um hambúrguer com este ID",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/hamburguers/nome/{nome}": {
+            "get": {
+                "description": "Retorna um hamburguer específico baseado no nome",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "hamburgueres"
+                ],
+                "summary": "Busca um hamburguer por nome",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Nome do Hamburguer",
+                        "name": "nome",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Quantidade máxima de hamburgueres por página (padrão 20, máximo 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor da próxima página, retornado em next_cursor",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Pagina-models_Hamburguer"
+                        }
+                    },
+                    "400": {
+                        "description": "Paginação inválida",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/hamburguers/{id}": {
+            "get": {
+                "description": "Retorna um hamburguer específico baseado no ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "hamburgueres"
+                ],
+                "summary": "Busca um hamburguer por ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID do Hamburguer",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Hamburguer"
+                        }
+                    },
+                    "404": {
+                        "description": "Hamburguer não encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Atualiza um hamburguer existente com os dados fornecidos",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "hamburgueres"
+                ],
+                "summary": "Atualiza um hamburguer existente",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID do Hamburguer",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dados do Hamburguer",
+                        "name": "hamburguer",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.HamburguerUpdateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Hamburguer"
+                        }
+                    },
+                    "400": {
+                        "description": "Erro na validação dos dados",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Hamburguer não encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Hamburguer em pedidos em aberto",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deleta um hamburguer existente",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "hamburgueres"
+                ],
+                "summary": "Deleta um hamburguer",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID do Hamburguer",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Erro ao deletar hamburguer",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Hamburguer não encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Hamburguer está em pedidos em aberto",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/hamburguers/{id}/disponibilidade": {
+            "put": {
+                "description": "Marca um hamburguer como disponível ou em falta. Mesmo marcado como disponível, ele só\npode ser vendido se todos os ingredientes da receita estiverem disponíveis (disponivel_para_venda).",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "hamburgueres"
+                ],
+                "summary": "Altera a disponibilidade de um hamburguer",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID do Hamburguer",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Disponibilidade do hamburguer",
+                        "name": "disponibilidade",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.DisponibilidadeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Hamburguer"
+                        }
+                    },
+                    "400": {
+                        "description": "ID ou dados inválidos",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Hamburguer não encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/itens": {
+            "post": {
+                "description": "Cria um novo item (bebida ou ingrediente) com os dados fornecidos",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "itens"
+                ],
+                "summary": "Cria um novo item",
+                "parameters": [
+                    {
+                        "description": "Dados do Item",
+                        "name": "item",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.ItemRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.ItemResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Erro na validação dos dados",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Item já existe",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/itens/bebidas": {
+            "get": {
+                "description": "Retorna uma lista de todas as bebidas disponíveis",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "itens"
+                ],
+                "summary": "Lista todas as bebidas",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Quantidade máxima de bebidas por página (padrão 20, máximo 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor da próxima página, retornado em next_cursor",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Pagina-models_ItemResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Paginação inválida",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/itens/ingredientes": {
+            "get": {
+                "description": "Retorna uma lista de todos os ingredientes disponíveis",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "itens"
+                ],
+                "summary": "Lista todos os ingredientes",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Quantidade máxima de ingredientes por página (padrão 20, máximo 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor da próxima página, retornado em next_cursor",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Pagina-models_ItemResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Paginação inválida",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/itens/todos": {
+            "get": {
+                "description": "Retorna uma lista de todos os itens (bebidas e ingredientes)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "itens"
+                ],
+                "summary": "Lista todos os itens",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Quantidade máxima de itens por página (padrão 20, máximo 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor da próxima página, retornado em next_cursor",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Pagina-models_ItemResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Paginação inválida",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/itens/{codigo}": {
+            "get": {
+                "description": "Retorna um item específico (bebida ou ingrediente) buscando por código",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "itens"
+                ],
+                "summary": "Busca um item por código",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Código do item",
+                        "name": "codigo",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ItemResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Código inválido",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Item não encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Atualiza um item (bebida ou ingrediente) existente",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "itens"
+                ],
+                "summary": "Atualiza um item existente",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Código do item",
+                        "name": "codigo",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dados do Item",
+                        "name": "item",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.ItemUpdateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ItemResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Código inválido",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Item não encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Item em pedidos em aberto",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove logicamente um item (bebida ou ingrediente) pelo código; ele pode ser restaurado em /admin/itens/{codigo}/restaurar",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "itens"
+                ],
+                "summary": "Deleta um item existente",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Código do item",
+                        "name": "codigo",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Item removido com sucesso",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Código inválido",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Item não encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Item na receita de hambúrgueres ativos ou em pedidos em aberto",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/itens/{codigo}/disponibilidade": {
+            "put": {
+                "description": "Marca um item como disponível ou em falta. Hambúrgueres com um ingrediente em falta\nficam indisponíveis para venda, e pedidos com itens em falta são recusados.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "itens"
+                ],
+                "summary": "Altera a disponibilidade de um item",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Código do item",
+                        "name": "codigo",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Disponibilidade do item",
+                        "name": "disponibilidade",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.DisponibilidadeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ItemResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Código ou dados inválidos",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Item não encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pedidos": {
+            "get": {
+                "description": "Retorna os pedidos cadastrados, com filtros por status, tipo, período, telefone e nome do cliente",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pedidos"
+                ],
+                "summary": "Lista todos os pedidos",
+                "parameters": [
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Filtra por um ou mais status (ex.: status=STARTED,DELIVERY)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "DELIVERY",
+                            "PICKUP",
+                            "DINE_IN"
+                        ],
+                        "type": "string",
+                        "description": "Filtra pelo tipo do pedido",
+                        "name": "tipo",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Somente pedidos não finalizados nem cancelados",
+                        "name": "abertos",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Data inicial (AAAA-MM-DD)",
+                        "name": "data_inicio",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Data final, inclusiva (AAAA-MM-DD)",
+                        "name": "data_fim",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Telefone do cliente",
+                        "name": "telefone",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Parte do nome do cliente",
+                        "name": "nome",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "data",
+                            "valor_total",
+                            "nome",
+                            "status"
+                        ],
+                        "type": "string",
+                        "description": "Campo de ordenação",
+                        "name": "ordem",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Direção da ordenação",
+                        "name": "direcao",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Quantidade máxima de pedidos por página (padrão 20, máximo 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor da próxima página, retornado em next_cursor",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Pagina-models_PedidoResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Filtro ou paginação inválidos",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Cria um novo pedido com os dados fornecidos e baixa do estoque os itens consumidos. O cliente é\nidentificado pelo telefone: no primeiro pedido, nome e endereço são obrigatórios e o cadastro é\ncriado; depois, o endereço pode ser um dos salvos (endereco_id), um novo, que é adicionado ao\ncadastro, ou omitido para usar o principal. A zona de entrega que atende o endereço define a taxa,\nsomada ao valor total, o pedido mínimo e o prazo; endereços fora de todas as zonas são recusados com FORA_DA_AREA.\nO tipo pode ser DELIVERY (padrão), PICKUP, retirado no balcão, ou DINE_IN, consumido na mesa informada em\nmesa; esses dois não têm endereço nem taxa de entrega, e no primeiro pedido basta o nome do cliente.\nAs promoções automáticas vigentes são aplicadas e gravadas em descontos, junto com a do cupom informado;\num cupom inexistente, fora da validade, esgotado ou que não se aplica às linhas é recusado com CUPOM_INVALIDO.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pedidos"
+                ],
+                "summary": "Cria um novo pedido",
+                "parameters": [
+                    {
+                        "description": "Dados do Pedido",
+                        "name": "pedido",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.PedidoRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.PedidoResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Erro na validação dos dados, linhas inválidas, endereço fora da área de entrega ou cupom inválido",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Produto removido do cardápio durante o pedido ou estoque insuficiente",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pedidos/cotacao": {
+            "post": {
+                "description": "Valida os hambúrgueres e bebidas e retorna o pedido precificado linha a linha, sem gravar nada. Com o\nendereço informado ou salvo no cadastro do cliente, inclui a taxa de entrega da zona que atende o endereço.\nO desconto das promoções vigentes e do cupom aparece somado em desconto e detalhado em descontos.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pedidos"
+                ],
+                "summary": "Cota um pedido",
+                "parameters": [
+                    {
+                        "description": "Dados do Pedido",
+                        "name": "pedido",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.PedidoRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.PedidoCotacaoResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Erro na validação dos dados, linhas inválidas, endereço fora da área de entrega ou cupom inválido",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pedidos/{id}": {
+            "get": {
+                "description": "Retorna um pedido específico baseado no ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pedidos"
+                ],
+                "summary": "Busca um pedido por ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do Pedido",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Inclui o histórico de status do pedido",
+                        "name": "historico",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.PedidoResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Pedido não encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Atualiza os dados de um pedido existente. O status é alterado apenas por POST /pedidos/{id}/status.\nTelefone e endereço seguem as regras do cadastro de clientes da criação do pedido. O tipo só pode ser\ntrocado enquanto o pedido está em STARTED; ao deixar de ser entrega, o pedido perde o endereço e a taxa.\nOs descontos são recalculados quando as linhas ou o cupom mudam, com as promoções vigentes no horário do\npedido; sem novo cupom, o já aplicado é mantido enquanto se aplicar às linhas.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pedidos"
+                ],
+                "summary": "Atualiza um pedido existente",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do Pedido",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dados do Pedido",
+                        "name": "pedido",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.PedidoUpdateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.PedidoResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Erro na validação dos dados, linhas inválidas, endereço fora da área de entrega ou cupom inválido",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Pedido não encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Pedido finalizado ou cancelado, produto removido do cardápio durante o pedido, estoque insuficiente ou troca de tipo depois do preparo",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove logicamente um pedido, mantendo linhas e histórico; ele pode ser restaurado em /admin/pedidos/{id}/restaurar",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pedidos"
+                ],
+                "summary": "Deleta um pedido",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do Pedido",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Erro ao deletar pedido",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Pedido não encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pedidos/{id}/cancelar": {
+            "post": {
+                "description": "Cancela um pedido ainda não finalizado, registrando quem cancelou, quando, o motivo e se o valor deve ser reembolsado. Os itens consumidos voltam ao estoque",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pedidos"
+                ],
+                "summary": "Cancela um pedido",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do Pedido",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dados do cancelamento",
+                        "name": "cancelamento",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.PedidoCancelamentoRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.PedidoResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Motivo de cancelamento inválido",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Pedido não encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Pedido não pode ser cancelado",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pedidos/{id}/historico": {
+            "get": {
+                "description": "Retorna a linha do tempo de status do pedido, com o tempo que ele permaneceu em cada etapa",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pedidos"
+                ],
+                "summary": "Histórico de status de um pedido",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do Pedido",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.PedidoHistoricoResponse"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Pedido não encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pedidos/{id}/reembolso": {
+            "post": {
+                "description": "Marca como reembolsado um pedido cancelado que tinha reembolso pendente",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pedidos"
+                ],
+                "summary": "Registra o reembolso de um pedido cancelado",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do Pedido",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.PedidoResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Pedido não encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Pedido sem reembolso pendente",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pedidos/{id}/status": {
+            "post": {
+                "description": "Move o pedido para o próximo status respeitando o fluxo do seu tipo: STARTED → DELIVERY → FINALIZED nas entregas,\nSTARTED → READY_FOR_PICKUP → FINALIZED nas retiradas (PICKUP) e STARTED → SERVED → FINALIZED no consumo no local\n(DINE_IN). Cancelamentos são feitos por POST /pedidos/{id}/cancelar",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pedidos"
+                ],
+                "summary": "Altera o status de um pedido",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do Pedido",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Novo status",
+                        "name": "status",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.PedidoStatusRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.PedidoResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Status inválido",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Pedido não encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Transição de status não permitida",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/promocoes": {
+            "get": {
+                "description": "Retorna as promoções com o tipo, o cupom, a validade, o happy hour e os limites de uso",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "promocoes"
+                ],
+                "summary": "Lista as promoções",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Somente as promoções ativas",
+                        "name": "ativas",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Quantidade máxima de promoções por página (padrão 20, máximo 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor da próxima página, retornado em next_cursor",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Pagina-models_Promocao"
+                        }
+                    },
+                    "400": {
+                        "description": "Paginação inválida",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Cria uma promoção de um dos tipos: PERCENTUAL (percentual sobre o subtotal), VALOR_FIXO (valor abatido do\nsubtotal), LEVE_GANHE (uma bebida grátis a cada quantidade do hambúrguer; sem bebida_id, a mais barata do\npedido) ou COMBO (hambúrguer e bebida juntos pelo valor do combo). Com cupom, a promoção só vale nos pedidos\nque informam o código; sem cupom, é aplicada a todos os pedidos que cumprem a regra. hora_inicio e hora_fim\nrestringem a promoção a uma janela diária, como um happy hour, que pode passar da meia-noite.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "promocoes"
+                ],
+                "summary": "Cria uma promoção",
+                "parameters": [
+                    {
+                        "description": "Dados da promoção",
+                        "name": "promocao",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.PromocaoRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.Promocao"
+                        }
+                    },
+                    "400": {
+                        "description": "Erro na validação dos dados",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Já existe uma promoção com o nome ou o cupom",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/promocoes/{id}": {
+            "get": {
+                "description": "Retorna uma promoção pelo ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "promocoes"
+                ],
+                "summary": "Busca uma promoção",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID da promoção",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Promocao"
+                        }
+                    },
+                    "400": {
+                        "description": "ID inválido",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Promoção não encontrada",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Substitui os dados da promoção. Pedidos já feitos mantêm o desconto concedido.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "promocoes"
+                ],
+                "summary": "Atualiza uma promoção",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID da promoção",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dados da promoção",
+                        "name": "promocao",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.PromocaoRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Promocao"
+                        }
+                    },
+                    "400": {
+                        "description": "ID ou dados inválidos",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Promoção não encontrada",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Já existe outra promoção com o nome ou o cupom",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Apaga a promoção; os pedidos que a usaram mantêm o desconto, sem o vínculo.\nPara suspender a promoção temporariamente, desative-a com ativa false.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "promocoes"
+                ],
+                "summary": "Remove uma promoção",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID da promoção",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Promoção removida com sucesso",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "ID inválido",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Promoção não encontrada",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/zonas-entrega": {
+            "get": {
+                "description": "Retorna as zonas de entrega com a faixa de CEPs ou o bairro atendido, a taxa, o pedido mínimo e o prazo",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "entrega"
+                ],
+                "summary": "Lista as zonas de entrega",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Somente as zonas ativas",
+                        "name": "ativas",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Quantidade máxima de zonas por página (padrão 20, máximo 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor da próxima página, retornado em next_cursor",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Pagina-models_ZonaEntrega"
+                        }
+                    },
+                    "400": {
+                        "description": "Paginação inválida",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Cria uma zona delimitada por uma faixa de CEPs (cep_inicio e cep_fim) ou por um bairro, com cidade e UF.\nQuando mais de uma zona atende o endereço, vale a zona por bairro e, entre faixas, a mais estreita.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "entrega"
+                ],
+                "summary": "Cria uma zona de entrega",
+                "parameters": [
+                    {
+                        "description": "Dados da zona",
+                        "name": "zona",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.ZonaEntregaRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.ZonaEntrega"
+                        }
+                    },
+                    "400": {
+                        "description": "Erro na validação dos dados",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Já existe uma zona com o nome",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/zonas-entrega/{id}": {
+            "get": {
+                "description": "Retorna uma zona de entrega pelo ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "entrega"
+                ],
+                "summary": "Busca uma zona de entrega",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID da zona",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ZonaEntrega"
+                        }
+                    },
+                    "400": {
+                        "description": "ID inválido",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Zona não encontrada",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Substitui os dados da zona. Pedidos já feitos mantêm a taxa e o prazo cobrados.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "entrega"
+                ],
+                "summary": "Atualiza uma zona de entrega",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID da zona",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dados da zona",
+                        "name": "zona",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.ZonaEntregaRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ZonaEntrega"
+                        }
+                    },
+                    "400": {
+                        "description": "ID ou dados inválidos",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Zona não encontrada",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Já existe outra zona com o nome",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Apaga a zona; os endereços que só ela atendia passam a ficar fora da área de entrega.\nPara suspender a entrega temporariamente, desative a zona com ativa false.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "entrega"
+                ],
+                "summary": "Remove uma zona de entrega",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID da zona",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Zona de entrega removida com sucesso",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "ID inválido",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Zona não encontrada",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErroResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "models.AcaoPersonalizacao": {
+            "type": "string",
+            "enum": [
+                "ADICIONAR",
+                "REMOVER"
+            ],
+            "x-enum-varnames": [
+                "AcaoAdicionar",
+                "AcaoRemover"
+            ]
+        },
+        "models.AjusteEstoqueRequest": {
+            "type": "object",
+            "required": [
+                "observacao",
+                "quantidade",
+                "responsavel",
+                "tipo"
+            ],
+            "properties": {
+                "observacao": {
+                    "type": "string"
+                },
+                "quantidade": {
+                    "type": "integer"
+                },
+                "responsavel": {
+                    "type": "string"
+                },
+                "tipo": {
+                    "$ref": "#/definitions/models.TipoMovimento"
+                }
+            }
+        },
+        "models.Cliente": {
+            "type": "object",
+            "properties": {
+                "criado_em": {
+                    "type": "string"
+                },
+                "enderecos": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ClienteEndereco"
+                    }
+                },
+                "nome": {
+                    "type": "string"
+                },
+                "telefone": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ClienteEndereco": {
+            "type": "object",
+            "properties": {
+                "apelido": {
+                    "type": "string",
+                    "example": "Casa"
+                },
+                "bairro": {
+                    "type": "string",
+                    "example": "Bela Vista"
+                },
+                "cep": {
+                    "type": "string",
+                    "example": "01310100"
+                },
+                "cidade": {
+                    "type": "string",
+                    "example": "São Paulo"
+                },
+                "complemento": {
+                    "type": "string",
+                    "example": "Apto 12"
+                },
+                "endereco": {
+                    "description": "endereço em uma linha; os cadastros antigos só têm o texto",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "logradouro": {
+                    "type": "string",
+                    "example": "Avenida Paulista"
+                },
+                "numero": {
+                    "type": "string",
+                    "example": "1000"
+                },
+                "principal": {
+                    "description": "usado quando o pedido não informa o endereço",
+                    "type": "boolean"
+                },
+                "uf": {
+                    "type": "string",
+                    "example": "SP"
+                }
+            }
+        },
+        "models.ClienteEnderecoRequest": {
+            "type": "object",
+            "properties": {
+                "apelido": {
+                    "type": "string"
+                },
+                "endereco": {
+                    "$ref": "#/definitions/models.EnderecoRequest"
+                },
+                "principal": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "models.ClienteRequest": {
+            "type": "object",
+            "required": [
+                "nome",
+                "telefone"
+            ],
+            "properties": {
+                "enderecos": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ClienteEnderecoRequest"
+                    }
+                },
+                "nome": {
+                    "type": "string"
+                },
+                "telefone": {
+                    "type": "string",
+                    "example": "11987654321"
+                }
+            }
+        },
+        "models.ClienteUpdateRequest": {
+            "type": "object",
+            "required": [
+                "nome"
+            ],
+            "properties": {
+                "nome": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.CodigoErro": {
+            "type": "string",
+            "enum": [
+                "DADOS_INVALIDOS",
+                "NAO_ENCONTRADO",
+                "JA_EXISTE",
+                "EM_USO",
+                "TRANSICAO_INVALIDA",
+                "CONFLITO",
+                "ESTOQUE_INSUFICIENTE",
+                "FORA_DA_AREA",
+                "CUPOM_INVALIDO",
+                "ERRO_INTERNO"
+            ],
+            "x-enum-comments": {
+                "ErroConflito": "a operação não combina com o estado atual",
+                "ErroCupomInvalido": "o cupom não existe, não vale agora ou não se aplica ao pedido",
+                "ErroDadosInvalidos": "corpo, parâmetro ou linha do pedido recusados",
+                "ErroEmUso": "o recurso está em receitas ou pedidos",
+                "ErroEstoqueInsuficiente": "o saldo não cobre os itens do pedido",
+                "ErroForaDaArea": "nenhuma zona de entrega atende o endereço",
+                "ErroJaExiste": "já existe um recurso com o mesmo ID",
+                "ErroNaoEncontrado": "o recurso da rota não existe",
+                "ErroTransicaoInvalida": "o fluxo de status do pedido não permite a mudança"
+            },
+            "x-enum-varnames": [
+                "ErroDadosInvalidos",
+                "ErroNaoEncontrado",
+                "ErroJaExiste",
+                "ErroEmUso",
+                "ErroTransicaoInvalida",
+                "ErroConflito",
+                "ErroEstoqueInsuficiente",
+                "ErroForaDaArea",
+                "ErroCupomInvalido",
+                "ErroInterno"
+            ]
+        },
+        "models.CotacaoAdicional": {
+            "type": "object",
+            "properties": {
+                "descricao": {
+                    "type": "string"
+                },
+                "item_id": {
+                    "type": "integer"
+                },
+                "preco_unitario": {
+                    "type": "number"
+                },
+                "quantidade": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.CotacaoBebida": {
+            "type": "object",
+            "properties": {
+                "descricao": {
+                    "type": "string"
+                },
+                "item_id": {
+                    "type": "integer"
+                },
+                "preco_unitario": {
+                    "type": "number"
+                },
+                "quantidade": {
+                    "type": "integer"
+                },
+                "subtotal": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.CotacaoDesconto": {
+            "type": "object",
+            "properties": {
+                "cupom": {
+                    "type": "string"
+                },
+                "descricao": {
+                    "type": "string"
+                },
+                "promocao_id": {
+                    "type": "integer"
+                },
+                "valor": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.CotacaoEntrega": {
+            "type": "object",
+            "properties": {
+                "pedido_minimo": {
+                    "type": "number"
+                },
+                "prazo_minutos": {
+                    "type": "integer"
+                },
+                "taxa": {
+                    "type": "number"
+                },
+                "zona": {
+                    "type": "string"
+                },
+                "zona_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.CotacaoHamburguer": {
+            "type": "object",
+            "properties": {
+                "adicionais": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.CotacaoAdicional"
+                    }
+                },
+                "descricao": {
+                    "type": "string"
+                },
+                "hamburguer_id": {
+                    "type": "integer"
+                },
+                "preco_unitario": {
+                    "type": "number"
+                },
+                "quantidade": {
+                    "type": "integer"
+                },
+                "removidos": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.CotacaoRemovido"
+                    }
+                },
+                "subtotal": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.CotacaoRemovido": {
+            "type": "object",
+            "properties": {
+                "descricao": {
+                    "type": "string"
+                },
+                "item_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.DisponibilidadeRequest": {
+            "type": "object",
+            "required": [
+                "disponivel"
+            ],
+            "properties": {
+                "disponivel": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "models.EnderecoRequest": {
+            "type": "object",
+            "required": [
+                "cep",
+                "numero"
+            ],
+            "properties": {
+                "bairro": {
+                    "type": "string"
+                },
+                "cep": {
+                    "type": "string",
+                    "example": "01310-100"
+                },
+                "cidade": {
+                    "type": "string"
+                },
+                "complemento": {
+                    "type": "string"
+                },
+                "logradouro": {
+                    "type": "string"
+                },
+                "numero": {
+                    "type": "string"
+                },
+                "uf": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ErroDetalhe": {
+            "type": "object",
+            "properties": {
+                "allowed": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "field": {
+                    "type": "string",
+                    "example": "hamburgueres[0].quantidade"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "deve ser no mínimo 1"
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ErroResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.CodigoErro"
+                        }
+                    ],
+                    "example": "DADOS_INVALIDOS"
+                },
+                "details": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ErroDetalhe"
+                    }
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Dados inválidos"
+                },
+                "request_id": {
+                    "type": "string",
+                    "example": "3f0c9a52-8d0e-4c1b-9a57-2a1f0f6c1e7d"
+                }
+            }
+        },
+        "models.EstoqueRequest": {
+            "type": "object",
+            "required": [
+                "unidade"
+            ],
+            "properties": {
+                "observacao": {
+                    "type": "string"
+                },
+                "ponto_reposicao": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "quantidade": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "responsavel": {
+                    "type": "string"
+                },
+                "unidade": {
+                    "$ref": "#/definitions/models.UnidadeEstoque"
+                }
+            }
+        },
+        "models.EstoqueResponse": {
+            "type": "object",
+            "properties": {
+                "abaixo_do_minimo": {
+                    "type": "boolean"
+                },
+                "atualizado_em": {
+                    "type": "string"
+                },
+                "descricao": {
+                    "type": "string"
+                },
+                "item_id": {
+                    "type": "integer"
+                },
+                "ponto_reposicao": {
+                    "type": "integer"
+                },
+                "quantidade": {
+                    "type": "integer"
+                },
+                "unidade": {
+                    "$ref": "#/definitions/models.UnidadeEstoque"
+                }
+            }
+        },
+        "models.Hamburguer": {
+            "type": "object",
+            "required": [
+                "descricao",
+                "preco"
+            ],
+            "properties": {
+                "descricao": {
+                    "type": "string"
+                },
+                "disponivel": {
+                    "description": "marcado manualmente; veja DisponivelParaVenda",
+                    "type": "boolean"
+                },
+                "disponivel_para_venda": {
+                    "description": "Calculados por VerificarDisponibilidade quando a receita é carregada com os itens",
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "ingredientes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.HamburguerIngrediente"
+                    }
+                },
+                "ingredientes_em_falta": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "preco": {
+                    "type": "number"
+                },
+                "removido_em": {
+                    "description": "a receita é mantida na remoção lógica",
+                    "type": "string",
+                    "format": "date-time"
+                }
+            }
+        },
+        "models.HamburguerIngrediente": {
+            "type": "object",
+            "properties": {
+                "hamburguerID": {
+                    "type": "integer"
+                },
+                "item": {
+                    "$ref": "#/definitions/models.Item"
+                },
+                "itemID": {
+                    "type": "integer"
+                },
+                "quantidade": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.HamburguerRequest": {
+            "type": "object",
+            "required": [
+                "descricao",
+                "id",
+                "ingredientes",
+                "preco"
+            ],
+            "properties": {
+                "descricao": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "ingredientes": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/models.IngredienteRequest"
+                    }
+                },
+                "preco": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.HamburguerUpdateRequest": {
+            "type": "object",
+            "required": [
+                "descricao",
+                "ingredientes",
+                "preco"
+            ],
+            "properties": {
+                "descricao": {
+                    "type": "string"
+                },
+                "ingredientes": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/models.IngredienteRequest"
+                    }
+                },
+                "preco": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.IngredienteRequest": {
+            "type": "object",
+            "required": [
+                "id",
+                "quantidade"
+            ],
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "quantidade": {
+                    "type": "integer",
+                    "minimum": 1
+                }
+            }
+        },
+        "models.Item": {
+            "type": "object",
+            "properties": {
+                "descricao": {
+                    "type": "string"
+                },
+                "disponivel": {
+                    "description": "false quando o item acabou",
+                    "type": "boolean"
+                },
+                "extra": {
+                    "description": "true para \"Com açúcar\" em bebidas ou \"Adicional\" em ingredientes",
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "preco": {
+                    "type": "number"
+                },
+                "removido_em": {
+                    "description": "preenchido pela remoção lógica",
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "tipo": {
+                    "$ref": "#/definitions/models.TipoItem"
+                }
+            }
+        },
+        "models.ItemRequest": {
+            "type": "object",
+            "required": [
+                "descricao",
+                "extra",
+                "id",
+                "preco",
+                "tipo"
+            ],
+            "properties": {
+                "descricao": {
+                    "type": "string"
+                },
+                "extra": {
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "preco": {
+                    "type": "number"
+                },
+                "tipo": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ItemResponse": {
+            "type": "object",
+            "properties": {
+                "descricao": {
+                    "type": "string"
+                },
+                "disponivel": {
+                    "type": "boolean"
+                },
+                "extra": {
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "preco": {
+                    "type": "number"
+                },
+                "removido_em": {
+                    "type": "string"
+                },
+                "tipo": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ItemUpdateRequest": {
+            "type": "object",
+            "required": [
+                "descricao",
+                "extra",
+                "preco"
+            ],
+            "properties": {
+                "descricao": {
+                    "type": "string"
+                },
+                "extra": {
+                    "type": "boolean"
+                },
+                "preco": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.MotivoCancelamento": {
+            "type": "string",
+            "enum": [
+                "CLIENTE_DESISTIU",
+                "ITEM_INDISPONIVEL",
+                "ENDERECO_NAO_ATENDIDO",
+                "PAGAMENTO_RECUSADO",
+                "ATRASO_ENTREGA",
+                "OUTRO"
+            ],
+            "x-enum-varnames": [
+                "MotivoClienteDesistiu",
+                "MotivoItemIndisponivel",
+                "MotivoEnderecoNaoAtendido",
+                "MotivoPagamentoRecusado",
+                "MotivoAtrasoEntrega",
+                "MotivoOutro"
+            ]
+        },
+        "models.MovimentoEstoque": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "string"
+                },
+                "documento": {
+                    "description": "nota fiscal ou romaneio do recebimento",
+                    "type": "string"
+                },
+                "fornecedor": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "item_id": {
+                    "type": "integer"
+                },
+                "observacao": {
+                    "type": "string"
+                },
+                "pedido_id": {
+                    "type": "string"
+                },
+                "quantidade": {
+                    "description": "positiva nas entradas e negativa nas saídas",
+                    "type": "integer"
+                },
+                "responsavel": {
+                    "type": "string"
+                },
+                "saldo": {
+                    "description": "saldo do item logo após o movimento",
+                    "type": "integer"
+                },
+                "tipo": {
+                    "$ref": "#/definitions/models.TipoMovimento"
+                }
+            }
+        },
+        "models.Pagina-models_EstoqueResponse": {
+            "type": "object",
+            "properties": {
+                "dados": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.EstoqueResponse"
+                    }
+                },
+                "next_cursor": {
+                    "description": "ausente na última página",
+                    "type": "string"
+                }
+            }
+        },
+        "models.Pagina-models_Hamburguer": {
+            "type": "object",
+            "properties": {
+                "dados": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Hamburguer"
+                    }
+                },
+                "next_cursor": {
+                    "description": "ausente na última página",
+                    "type": "string"
+                }
+            }
+        },
+        "models.Pagina-models_ItemResponse": {
+            "type": "object",
+            "properties": {
+                "dados": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ItemResponse"
+                    }
+                },
+                "next_cursor": {
+                    "description": "ausente na última página",
+                    "type": "string"
+                }
+            }
+        },
+        "models.Pagina-models_MovimentoEstoque": {
+            "type": "object",
+            "properties": {
+                "dados": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.MovimentoEstoque"
+                    }
+                },
+                "next_cursor": {
+                    "description": "ausente na última página",
+                    "type": "string"
+                }
+            }
+        },
+        "models.Pagina-models_PedidoResponse": {
+            "type": "object",
+            "properties": {
+                "dados": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PedidoResponse"
+                    }
+                },
+                "next_cursor": {
+                    "description": "ausente na última página",
+                    "type": "string"
+                }
+            }
+        },
+        "models.Pagina-models_Promocao": {
+            "type": "object",
+            "properties": {
+                "dados": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Promocao"
+                    }
+                },
+                "next_cursor": {
+                    "description": "ausente na última página",
+                    "type": "string"
+                }
+            }
+        },
+        "models.Pagina-models_ZonaEntrega": {
+            "type": "object",
+            "properties": {
+                "dados": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ZonaEntrega"
+                    }
+                },
+                "next_cursor": {
+                    "description": "ausente na última página",
+                    "type": "string"
+                }
+            }
+        },
+        "models.PedidoBebida": {
+            "type": "object",
+            "properties": {
+                "bebida": {
+                    "$ref": "#/definitions/models.Item"
+                },
+                "descricao": {
+                    "description": "descrição no momento da compra",
+                    "type": "string"
+                },
+                "itemID": {
+                    "type": "integer"
+                },
+                "pedidoID": {
+                    "type": "string"
+                },
+                "preco_unitario": {
+                    "description": "preço no momento da compra",
+                    "type": "number"
+                },
                 "quantidade": {
                     "type": "integer"
                 }
             }
         },
-        "models.HamburguerRequest": {
+        "models.PedidoCancelamentoRequest": {
+            "type": "object",
+            "required": [
+                "cancelado_por",
+                "motivo"
+            ],
+            "properties": {
+                "cancelado_por": {
+                    "type": "string"
+                },
+                "motivo": {
+                    "$ref": "#/definitions/models.MotivoCancelamento"
+                },
+                "reembolsar": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "models.PedidoCotacaoResponse": {
+            "type": "object",
+            "properties": {
+                "bebidas": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.CotacaoBebida"
+                    }
+                },
+                "desconto": {
+                    "type": "number"
+                },
+                "descontos": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.CotacaoDesconto"
+                    }
+                },
+                "entrega": {
+                    "description": "ausente quando a cotação não informa o endereço",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.CotacaoEntrega"
+                        }
+                    ]
+                },
+                "hamburgueres": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.CotacaoHamburguer"
+                    }
+                },
+                "subtotal": {
+                    "type": "number"
+                },
+                "taxa_entrega": {
+                    "type": "number"
+                },
+                "valor_total": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.PedidoDesconto": {
+            "type": "object",
+            "properties": {
+                "cupom": {
+                    "type": "string"
+                },
+                "descricao": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "promocao_id": {
+                    "description": "nulo depois que a promoção é apagada",
+                    "type": "integer"
+                },
+                "valor": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.PedidoHamburguer": {
             "type": "object",
-            "required": [
-                "descricao",
-                "id",
-                "ingredientes",
-                "preco"
-            ],
             "properties": {
                 "descricao": {
+                    "description": "descrição no momento da compra",
                     "type": "string"
                 },
+                "hamburguer": {
+                    "$ref": "#/definitions/models.Hamburguer"
+                },
+                "hamburguerID": {
+                    "type": "integer"
+                },
                 "id": {
                     "type": "integer"
                 },
-                "ingredientes": {
+                "pedidoID": {
+                    "type": "string"
+                },
+                "personalizacoes": {
                     "type": "array",
-                    "minItems": 1,
                     "items": {
-                        "$ref": "#/definitions/models.IngredienteRequest"
+                        "$ref": "#/definitions/models.PedidoHamburguerPersonalizacao"
                     }
                 },
-                "preco": {
+                "preco_unitario": {
+                    "description": "preço no momento da compra",
                     "type": "number"
+                },
+                "quantidade": {
+                    "type": "integer"
                 }
             }
         },
-        "models.HamburguerUpdateRequest": {
+        "models.PedidoHamburguerPersonalizacao": {
             "type": "object",
-            "required": [
-                "descricao",
-                "ingredientes",
-                "preco"
-            ],
             "properties": {
+                "acao": {
+                    "$ref": "#/definitions/models.AcaoPersonalizacao"
+                },
                 "descricao": {
                     "type": "string"
                 },
-                "ingredientes": {
-                    "type": "array",
-                    "minItems": 1,
-                    "items": {
-                        "$ref": "#/definitions/models.IngredienteRequest"
-                    }
+                "id": {
+                    "type": "integer"
                 },
-                "preco": {
+                "item": {
+                    "$ref": "#/definitions/models.Item"
+                },
+                "item_id": {
+                    "type": "integer"
+                },
+                "preco_unitario": {
+                    "description": "cobrado por unidade quando adicionado",
                     "type": "number"
+                },
+                "quantidade": {
+                    "type": "integer"
                 }
             }
         },
-        "models.IngredienteRequest": {
+        "models.PedidoHamburguerRequest": {
             "type": "object",
             "required": [
                 "id",
                 "quantidade"
             ],
             "properties": {
+                "adicionar": {
+                    "description": "ingredientes extras por unidade",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.IngredienteRequest"
+                    }
+                },
                 "id": {
                     "type": "integer"
                 },
                 "quantidade": {
                     "type": "integer",
                     "minimum": 1
+                },
+                "remover": {
+                    "description": "IDs de ingredientes da receita a retirar",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
                 }
             }
         },
-        "models.Item": {
+        "models.PedidoHistoricoResponse": {
             "type": "object",
             "properties": {
-                "descricao": {
+                "ator": {
                     "type": "string"
                 },
-                "extra": {
-                    "description": "true para \"Com açúcar\" em bebidas ou \"Adicional\" em ingredientes",
-                    "type": "boolean"
+                "data": {
+                    "type": "string"
                 },
-                "id": {
+                "duracao_segundos": {
+                    "description": "tempo que o pedido permaneceu em StatusNovo",
                     "type": "integer"
                 },
-                "preco": {
-                    "type": "number"
+                "status_anterior": {
+                    "$ref": "#/definitions/models.StatusPedido"
                 },
-                "tipo": {
-                    "$ref": "#/definitions/models.TipoItem"
+                "status_novo": {
+                    "$ref": "#/definitions/models.StatusPedido"
                 }
             }
         },
-        "models.ItemRequest": {
+        "models.PedidoItemRequest": {
             "type": "object",
             "required": [
-                "descricao",
-                "extra",
                 "id",
-                "preco",
-                "tipo"
+                "quantidade"
             ],
             "properties": {
-                "descricao": {
-                    "type": "string"
-                },
-                "extra": {
-                    "type": "boolean"
-                },
                 "id": {
                     "type": "integer"
                 },
-                "preco": {
-                    "type": "number"
-                },
-                "tipo": {
-                    "type": "string"
+                "quantidade": {
+                    "type": "integer",
+                    "minimum": 1
                 }
             }
         },
-        "models.ItemResponse": {
+        "models.PedidoRequest": {
             "type": "object",
+            "required": [
+                "descricao",
+                "hamburgueres",
+                "telefone"
+            ],
             "properties": {
+                "bebidas": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PedidoItemRequest"
+                    }
+                },
+                "cupom": {
+                    "type": "string",
+                    "example": "BEMVINDO10"
+                },
                 "descricao": {
                     "type": "string"
                 },
-                "extra": {
-                    "type": "boolean"
+                "endereco": {
+                    "$ref": "#/definitions/models.EnderecoRequest"
                 },
-                "id": {
+                "endereco_id": {
                     "type": "integer"
                 },
-                "preco": {
-                    "type": "number"
+                "hamburgueres": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/models.PedidoHamburguerRequest"
+                    }
                 },
-                "tipo": {
+                "mesa": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "nome": {
                     "type": "string"
+                },
+                "observacoes": {
+                    "type": "string"
+                },
+                "telefone": {
+                    "type": "string",
+                    "example": "11987654321"
+                },
+                "tipo": {
+                    "description": "DELIVERY quando omitido",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.TipoPedido"
+                        }
+                    ],
+                    "example": "DELIVERY"
                 }
             }
         },
-        "models.ItemUpdateRequest": {
+        "models.PedidoResponse": {
             "type": "object",
-            "required": [
-                "descricao",
-                "extra",
-                "preco"
-            ],
             "properties": {
+                "bairro": {
+                    "type": "string",
+                    "example": "Bela Vista"
+                },
+                "bebidas": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PedidoBebida"
+                    }
+                },
+                "cancelado_em": {
+                    "type": "string"
+                },
+                "cancelado_por": {
+                    "type": "string"
+                },
+                "cep": {
+                    "type": "string",
+                    "example": "01310100"
+                },
+                "cidade": {
+                    "type": "string",
+                    "example": "São Paulo"
+                },
+                "complemento": {
+                    "type": "string",
+                    "example": "Apto 12"
+                },
+                "data": {
+                    "type": "string"
+                },
+                "desconto": {
+                    "type": "number"
+                },
+                "descontos": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PedidoDesconto"
+                    }
+                },
                 "descricao": {
                     "type": "string"
                 },
-                "extra": {
+                "endereco": {
+                    "type": "string"
+                },
+                "hamburgueres": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PedidoHamburguer"
+                    }
+                },
+                "historico": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PedidoStatusHistorico"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "logradouro": {
+                    "type": "string",
+                    "example": "Avenida Paulista"
+                },
+                "mesa": {
+                    "type": "integer"
+                },
+                "motivo_cancelamento": {
+                    "$ref": "#/definitions/models.MotivoCancelamento"
+                },
+                "nome": {
+                    "type": "string"
+                },
+                "numero": {
+                    "type": "string",
+                    "example": "1000"
+                },
+                "observacoes": {
+                    "type": "string"
+                },
+                "prazo_entrega_minutos": {
+                    "type": "integer"
+                },
+                "reembolsado_em": {
+                    "type": "string"
+                },
+                "reembolso_necessario": {
                     "type": "boolean"
                 },
-                "preco": {
+                "removido_em": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/models.StatusPedido"
+                },
+                "taxa_entrega": {
                     "type": "number"
-                }
-            }
-        },
-        "models.PedidoBebida": {
-            "type": "object",
-            "properties": {
-                "bebida": {
-                    "$ref": "#/definitions/models.Item"
                 },
-                "itemID": {
-                    "type": "integer"
+                "telefone": {
+                    "type": "string"
+                },
+                "tipo": {
+                    "$ref": "#/definitions/models.TipoPedido"
+                },
+                "uf": {
+                    "type": "string",
+                    "example": "SP"
+                },
+                "valor_reembolso": {
+                    "type": "number"
                 },
-                "pedidoID": {
-                    "type": "string"
+                "valor_total": {
+                    "type": "number"
                 },
-                "quantidade": {
+                "zona_entrega_id": {
                     "type": "integer"
                 }
             }
         },
-        "models.PedidoHamburguer": {
+        "models.PedidoStatusHistorico": {
             "type": "object",
             "properties": {
-                "hamburguer": {
-                    "$ref": "#/definitions/models.Hamburguer"
+                "ator": {
+                    "type": "string"
                 },
-                "hamburguerID": {
+                "data": {
+                    "type": "string"
+                },
+                "id": {
                     "type": "integer"
                 },
-                "pedidoID": {
+                "pedido_id": {
                     "type": "string"
                 },
-                "quantidade": {
-                    "type": "integer"
+                "status_anterior": {
+                    "$ref": "#/definitions/models.StatusPedido"
+                },
+                "status_novo": {
+                    "$ref": "#/definitions/models.StatusPedido"
                 }
             }
         },
-        "models.PedidoItemRequest": {
+        "models.PedidoStatusRequest": {
             "type": "object",
             "required": [
-                "id",
-                "quantidade"
+                "ator",
+                "status"
             ],
             "properties": {
-                "id": {
-                    "type": "integer"
+                "ator": {
+                    "type": "string"
                 },
-                "quantidade": {
-                    "type": "integer",
-                    "minimum": 1
+                "status": {
+                    "$ref": "#/definitions/models.StatusPedido"
                 }
             }
         },
-        "models.PedidoRequest": {
+        "models.PedidoUpdateRequest": {
             "type": "object",
-            "required": [
-                "descricao",
-                "endereco",
-                "hamburgueres",
-                "nome",
-                "telefone"
-            ],
             "properties": {
                 "bebidas": {
                     "type": "array",
@@ -986,19 +4191,29 @@ const docTemplate = `{
                         "$ref": "#/definitions/models.PedidoItemRequest"
                     }
                 },
+                "cupom": {
+                    "description": "troca o cupom aplicado e recalcula os descontos",
+                    "type": "string"
+                },
                 "descricao": {
                     "type": "string"
                 },
                 "endereco": {
-                    "type": "string"
+                    "$ref": "#/definitions/models.EnderecoRequest"
+                },
+                "endereco_id": {
+                    "type": "integer"
                 },
                 "hamburgueres": {
                     "type": "array",
-                    "minItems": 1,
                     "items": {
-                        "$ref": "#/definitions/models.PedidoItemRequest"
+                        "$ref": "#/definitions/models.PedidoHamburguerRequest"
                     }
                 },
+                "mesa": {
+                    "type": "integer",
+                    "minimum": 1
+                },
                 "nome": {
                     "type": "string"
                 },
@@ -1007,85 +4222,225 @@ const docTemplate = `{
                 },
                 "telefone": {
                     "type": "string"
+                },
+                "tipo": {
+                    "description": "só pode mudar enquanto o pedido está em STARTED",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.TipoPedido"
+                        }
+                    ]
                 }
             }
         },
-        "models.PedidoResponse": {
+        "models.Promocao": {
             "type": "object",
             "properties": {
-                "bebidas": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.PedidoBebida"
-                    }
+                "ativa": {
+                    "type": "boolean"
                 },
-                "data": {
+                "atualizado_em": {
                     "type": "string"
                 },
-                "descricao": {
-                    "type": "string"
+                "bebida_id": {
+                    "description": "bebida do COMBO ou brinde do LEVE_GANHE",
+                    "type": "integer"
                 },
-                "endereco": {
-                    "type": "string"
+                "cupom": {
+                    "type": "string",
+                    "example": "BEMVINDO10"
                 },
-                "hamburgueres": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.PedidoHamburguer"
-                    }
+                "hamburguer_id": {
+                    "type": "integer"
+                },
+                "hora_fim": {
+                    "type": "string",
+                    "example": "19:00"
+                },
+                "hora_inicio": {
+                    "description": "janela diária do happy hour",
+                    "type": "string",
+                    "example": "17:00"
                 },
                 "id": {
-                    "type": "string"
+                    "type": "integer"
+                },
+                "limite_por_cliente": {
+                    "description": "pedidos por telefone; 0 é sem limite",
+                    "type": "integer"
+                },
+                "limite_usos": {
+                    "description": "pedidos que podem usar a promoção; 0 é sem limite",
+                    "type": "integer"
                 },
                 "nome": {
+                    "type": "string",
+                    "example": "Happy hour"
+                },
+                "pedido_minimo": {
+                    "type": "number"
+                },
+                "percentual": {
+                    "type": "integer"
+                },
+                "quantidade": {
+                    "description": "hambúrgueres por brinde no LEVE_GANHE",
+                    "type": "integer"
+                },
+                "tipo": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.TipoPromocao"
+                        }
+                    ],
+                    "example": "PERCENTUAL"
+                },
+                "valido_ate": {
                     "type": "string"
                 },
-                "observacoes": {
+                "valido_de": {
                     "type": "string"
                 },
-                "status": {
-                    "$ref": "#/definitions/models.StatusPedido"
+                "valor": {
+                    "description": "desconto do VALOR_FIXO ou preço do COMBO",
+                    "type": "number"
+                }
+            }
+        },
+        "models.PromocaoRequest": {
+            "type": "object",
+            "required": [
+                "nome",
+                "tipo"
+            ],
+            "properties": {
+                "ativa": {
+                    "description": "padrão true",
+                    "type": "boolean"
                 },
-                "telefone": {
+                "bebida_id": {
+                    "type": "integer"
+                },
+                "cupom": {
+                    "description": "vazio para aplicar a todos os pedidos",
+                    "type": "string",
+                    "maxLength": 30,
+                    "example": "BEMVINDO10"
+                },
+                "hamburguer_id": {
+                    "type": "integer"
+                },
+                "hora_fim": {
+                    "type": "string",
+                    "example": "19:00"
+                },
+                "hora_inicio": {
+                    "type": "string",
+                    "example": "17:00"
+                },
+                "limite_por_cliente": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "limite_usos": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "nome": {
                     "type": "string"
                 },
-                "valor_total": {
-                    "type": "number"
+                "pedido_minimo": {
+                    "type": "number",
+                    "minimum": 0
+                },
+                "percentual": {
+                    "type": "integer",
+                    "maximum": 100,
+                    "minimum": 1
+                },
+                "quantidade": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "tipo": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.TipoPromocao"
+                        }
+                    ],
+                    "example": "PERCENTUAL"
+                },
+                "valido_ate": {
+                    "type": "string"
+                },
+                "valido_de": {
+                    "type": "string"
+                },
+                "valor": {
+                    "type": "number",
+                    "minimum": 0
                 }
             }
         },
-        "models.PedidoUpdateRequest": {
+        "models.RecebimentoRequest": {
             "type": "object",
+            "required": [
+                "fornecedor",
+                "quantidade",
+                "responsavel"
+            ],
             "properties": {
-                "bebidas": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.PedidoItemRequest"
-                    }
+                "documento": {
+                    "type": "string"
                 },
-                "descricao": {
+                "fornecedor": {
                     "type": "string"
                 },
-                "endereco": {
+                "observacao": {
                     "type": "string"
                 },
-                "hamburgueres": {
+                "quantidade": {
+                    "type": "integer"
+                },
+                "responsavel": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.RelatorioEstoqueResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "string",
+                    "example": "2024-05-31"
+                },
+                "saldos": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.PedidoItemRequest"
+                        "$ref": "#/definitions/models.SaldoEstoque"
                     }
-                },
-                "nome": {
+                }
+            }
+        },
+        "models.SaldoEstoque": {
+            "type": "object",
+            "properties": {
+                "descricao": {
                     "type": "string"
                 },
-                "observacoes": {
-                    "type": "string"
+                "item_id": {
+                    "type": "integer"
                 },
-                "status": {
-                    "$ref": "#/definitions/models.StatusPedido"
+                "quantidade": {
+                    "type": "integer"
                 },
-                "telefone": {
-                    "type": "string"
+                "unidade": {
+                    "description": "vazia se o item deixou de ter estoque controlado",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.UnidadeEstoque"
+                        }
+                    ]
                 }
             }
         },
@@ -1094,12 +4449,18 @@ const docTemplate = `{
             "enum": [
                 "STARTED",
                 "DELIVERY",
-                "FINALIZED"
+                "READY_FOR_PICKUP",
+                "SERVED",
+                "FINALIZED",
+                "CANCELLED"
             ],
             "x-enum-varnames": [
                 "StatusStarted",
                 "StatusDelivery",
-                "StatusFinalized"
+                "StatusReadyForPickup",
+                "StatusServed",
+                "StatusFinalized",
+                "StatusCancelled"
             ]
         },
         "models.TipoItem": {
@@ -1112,6 +4473,170 @@ const docTemplate = `{
                 "TipoBebida",
                 "TipoIngrediente"
             ]
+        },
+        "models.TipoMovimento": {
+            "type": "string",
+            "enum": [
+                "RECEBIMENTO",
+                "CONSUMO",
+                "ESTORNO",
+                "PERDA",
+                "AJUSTE"
+            ],
+            "x-enum-comments": {
+                "MovimentoAjuste": "correção manual ou contagem",
+                "MovimentoConsumo": "baixa pela venda de um pedido",
+                "MovimentoEstorno": "devolução pelo cancelamento ou alteração de um pedido",
+                "MovimentoPerda": "desperdício, vencimento ou quebra",
+                "MovimentoRecebimento": "entrada de mercadoria do fornecedor"
+            },
+            "x-enum-varnames": [
+                "MovimentoRecebimento",
+                "MovimentoConsumo",
+                "MovimentoEstorno",
+                "MovimentoPerda",
+                "MovimentoAjuste"
+            ]
+        },
+        "models.TipoPedido": {
+            "type": "string",
+            "enum": [
+                "DELIVERY",
+                "PICKUP",
+                "DINE_IN"
+            ],
+            "x-enum-comments": {
+                "TipoDelivery": "entrega no endereço do cliente, com taxa da zona",
+                "TipoDineIn": "consumo no local, com o número da mesa",
+                "TipoPickup": "retirada no balcão"
+            },
+            "x-enum-varnames": [
+                "TipoDelivery",
+                "TipoPickup",
+                "TipoDineIn"
+            ]
+        },
+        "models.TipoPromocao": {
+            "type": "string",
+            "enum": [
+                "PERCENTUAL",
+                "VALOR_FIXO",
+                "LEVE_GANHE",
+                "COMBO"
+            ],
+            "x-enum-comments": {
+                "PromocaoCombo": "hambúrguer e bebida juntos pelo preço do combo",
+                "PromocaoLeveGanhe": "uma bebida grátis a cada Quantidade do hambúrguer",
+                "PromocaoPercentual": "percentual sobre o subtotal",
+                "PromocaoValorFixo": "valor abatido do subtotal"
+            },
+            "x-enum-varnames": [
+                "PromocaoPercentual",
+                "PromocaoValorFixo",
+                "PromocaoLeveGanhe",
+                "PromocaoCombo"
+            ]
+        },
+        "models.UnidadeEstoque": {
+            "type": "string",
+            "enum": [
+                "UN",
+                "G",
+                "ML"
+            ],
+            "x-enum-varnames": [
+                "UnidadeUnidade",
+                "UnidadeGrama",
+                "UnidadeMililitro"
+            ]
+        },
+        "models.ZonaEntrega": {
+            "type": "object",
+            "properties": {
+                "ativa": {
+                    "type": "boolean"
+                },
+                "atualizado_em": {
+                    "type": "string"
+                },
+                "bairro": {
+                    "type": "string"
+                },
+                "cep_fim": {
+                    "type": "string",
+                    "example": "01599999"
+                },
+                "cep_inicio": {
+                    "type": "string",
+                    "example": "01000000"
+                },
+                "cidade": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "nome": {
+                    "type": "string",
+                    "example": "Centro"
+                },
+                "pedido_minimo": {
+                    "description": "sobre o subtotal, antes dos descontos",
+                    "type": "number"
+                },
+                "prazo_minutos": {
+                    "type": "integer"
+                },
+                "taxa": {
+                    "type": "number"
+                },
+                "uf": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ZonaEntregaRequest": {
+            "type": "object",
+            "required": [
+                "nome",
+                "prazo_minutos"
+            ],
+            "properties": {
+                "ativa": {
+                    "type": "boolean"
+                },
+                "bairro": {
+                    "type": "string"
+                },
+                "cep_fim": {
+                    "type": "string",
+                    "example": "01599-999"
+                },
+                "cep_inicio": {
+                    "type": "string",
+                    "example": "01000-000"
+                },
+                "cidade": {
+                    "type": "string"
+                },
+                "nome": {
+                    "type": "string"
+                },
+                "pedido_minimo": {
+                    "type": "number",
+                    "minimum": 0
+                },
+                "prazo_minutos": {
+                    "type": "integer"
+                },
+                "taxa": {
+                    "type": "number",
+                    "minimum": 0
+                },
+                "uf": {
+                    "type": "string"
+                }
+            }
         }
     }
 }`
@@ -2,9 +2,13 @@ package main
 
 import (
 	"fmt"
+	"log"
+	"os"
 
 	"github.com/gin-gonic/gin"
+	"lanchonete/cep"
 	"lanchonete/database"
+	"lanchonete/repository"
 	"lanchonete/routes"
 )
 
@@ -18,7 +22,8 @@ func main() {
 	r.Use(func(c *gin.Context) {
 		c.Writer.Header().Set("Access-Control-Allow-Origin", "http://localhost:4200")
 		c.Writer.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-		c.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+		c.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, X-Request-ID, Accept-Language")
+		c.Writer.Header().Set("Access-Control-Expose-Headers", "X-Request-ID, Content-Language")
 		c.Writer.Header().Set("Access-Control-Allow-Credentials", "true")
 		
 		if c.Request.Method == "OPTIONS" {
@@ -31,7 +36,17 @@ func main() {
 
 	// Conectar ao banco
 	database.ConnectDB()
+
+	// Base de CEPs para completar os endereços; sem ela, o endereço é informado por completo
+	var ceps cep.Consulta = cep.SemConsulta{}
+	if caminho := os.Getenv("CEP_ARQUIVO"); caminho != "" {
+		arquivo, err := cep.NovoArquivo(caminho)
+		if err != nil {
+			log.Fatal(err)
+		}
+		ceps = arquivo
+	}
 	
 	// Configurar rotas passando o router
-	routes.HandleRequests(r)
+	routes.HandleRequests(r, repository.NewGormStore(database.DB), ceps)
 }
\ No newline at end of file
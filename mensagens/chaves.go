@@ -0,0 +1,194 @@
+package mensagens
+
+const (
+	// Validação de requisições
+	DadosInvalidos          Chave = "dados_invalidos"
+	CampoObrigatorio        Chave = "campo_obrigatorio"
+	MinimoItens             Chave = "minimo_itens"
+	MinimoCaracteres        Chave = "minimo_caracteres"
+	ValorMinimo             Chave = "valor_minimo"
+	TamanhoExato            Chave = "tamanho_exato"
+	MaiorQue                Chave = "maior_que"
+	TelefoneInvalidoCampo   Chave = "telefone_invalido"
+	CEPInvalidoCampo        Chave = "cep_invalido"
+	UFInvalidaCampo         Chave = "uf_invalida"
+	ValorInvalido           Chave = "valor_invalido"
+	TipoValorInvalido       Chave = "tipo_valor_invalido"
+	JSONMalformado          Chave = "json_malformado"
+	CorpoVazio              Chave = "corpo_vazio"
+	ValorMonetarioInvalido  Chave = "valor_monetario_invalido"
+	NumeroInteiro           Chave = "numero_inteiro"
+	InteiroPositivo         Chave = "inteiro_positivo"
+	CursorInvalido          Chave = "cursor_invalido"
+	CursorOutraOrdenacao    Chave = "cursor_outra_ordenacao"
+	CursorInvalidoOrdenacao Chave = "cursor_invalido_ordenacao"
+	IDInvalido              Chave = "id_invalido"
+
+	// Itens
+	ItemCodigoObrigatorio       Chave = "item.codigo_obrigatorio"
+	ItemCodigoInvalido          Chave = "item.codigo_invalido"
+	ItemNaoEncontrado           Chave = "item.nao_encontrado"
+	ItemTipoInvalido            Chave = "item.tipo_invalido"
+	ItemTipoInvalidoCampo       Chave = "item.tipo_invalido_campo"
+	ItemJaExiste                Chave = "item.ja_existe"
+	ItemEmPedidosAbertos        Chave = "item.em_pedidos_abertos"
+	ItemEmUso                   Chave = "item.em_uso"
+	ItemRemovido                Chave = "item.removido"
+	ItemErroVerificarExistencia Chave = "item.erro_verificar_existencia"
+	ItemErroCriar               Chave = "item.erro_criar"
+	ItemErroAtualizar           Chave = "item.erro_atualizar"
+	ItemErroDeletar             Chave = "item.erro_deletar"
+	ItemRemovidoNaoEncontrado   Chave = "item.removido_nao_encontrado"
+	ItemErroRestaurar           Chave = "item.erro_restaurar"
+	ItensErroBuscar             Chave = "item.erro_buscar_itens"
+	BebidasErroBuscar           Chave = "item.erro_buscar_bebidas"
+	IngredientesErroBuscar      Chave = "item.erro_buscar_ingredientes"
+	ErroVerificarPedidos        Chave = "item.erro_verificar_pedidos"
+
+	// Hambúrgueres
+	HamburguerNaoEncontrado                 Chave = "hamburguer.nao_encontrado"
+	HamburguerJaExiste                      Chave = "hamburguer.ja_existe"
+	HamburguerJaExisteOuIngredienteRemovido Chave = "hamburguer.ja_existe_ou_ingrediente_removido"
+	HamburguerEmPedidosAbertos              Chave = "hamburguer.em_pedidos_abertos"
+	HamburguerEmUso                         Chave = "hamburguer.em_uso"
+	HamburguerRemovidoNaoEncontrado         Chave = "hamburguer.removido_nao_encontrado"
+	HamburguerReceitaComItemRemovido        Chave = "hamburguer.receita_com_item_removido"
+	IngredienteRemovidoOperacao             Chave = "hamburguer.ingrediente_removido"
+	IngredienteNaoEncontradoCodigo          Chave = "hamburguer.ingrediente_nao_encontrado_codigo"
+	IngredienteNaoEncontrado                Chave = "hamburguer.ingrediente_nao_encontrado"
+	ItemNaoEIngredienteCodigo               Chave = "hamburguer.item_nao_e_ingrediente_codigo"
+	ItemNaoEIngrediente                     Chave = "hamburguer.item_nao_e_ingrediente"
+	HamburguerErroBuscar                    Chave = "hamburguer.erro_buscar"
+	HamburguersErroBuscar                   Chave = "hamburguer.erro_buscar_todos"
+	HamburguerErroVerificarExistente        Chave = "hamburguer.erro_verificar_existente"
+	HamburguerErroVerificar                 Chave = "hamburguer.erro_verificar"
+	HamburguerErroCriar                     Chave = "hamburguer.erro_criar"
+	HamburguerErroSalvar                    Chave = "hamburguer.erro_salvar"
+	HamburguerErroDeletar                   Chave = "hamburguer.erro_deletar"
+	HamburguerErroRestaurar                 Chave = "hamburguer.erro_restaurar"
+
+	// Pedidos
+	PedidoNaoEncontrado             Chave = "pedido.nao_encontrado"
+	PedidoDeletado                  Chave = "pedido.deletado"
+	StatusInvalido                  Chave = "pedido.status_invalido"
+	StatusInvalidoCampo             Chave = "pedido.status_invalido_campo"
+	OrdenacaoInvalida               Chave = "pedido.ordenacao_invalida"
+	OrdenacaoInvalidaCampo          Chave = "pedido.ordenacao_invalida_campo"
+	DirecaoInvalida                 Chave = "pedido.direcao_invalida"
+	DirecaoInvalidaCampo            Chave = "pedido.direcao_invalida_campo"
+	TransicaoNaoPermitida           Chave = "pedido.transicao_nao_permitida"
+	MudancaStatusNaoPermitida       Chave = "pedido.mudanca_status_nao_permitida"
+	CancelarPelaRota                Chave = "pedido.cancelar_pela_rota"
+	MotivoCancelamentoInvalido      Chave = "pedido.motivo_cancelamento_invalido"
+	TipoPedidoInvalido              Chave = "pedido.tipo_invalido"
+	TipoPedidoInvalidoCampo         Chave = "pedido.tipo_invalido_campo"
+	PedidoMesaObrigatoria           Chave = "pedido.mesa_obrigatoria"
+	PedidoMesaForaDoLocal           Chave = "pedido.mesa_fora_do_local"
+	PedidoSemEntrega                Chave = "pedido.sem_entrega"
+	TipoAlteracaoNaoPermitida       Chave = "pedido.tipo_alteracao_nao_permitida"
+	CancelamentoNaoPermitido        Chave = "pedido.cancelamento_nao_permitido"
+	AlteracaoNaoPermitida           Chave = "pedido.alteracao_nao_permitida"
+	SemReembolsoPendente            Chave = "pedido.sem_reembolso_pendente"
+	PedidoLinhaInvalida             Chave = "pedido.linha_invalida"
+	PedidoLinhasInvalidas           Chave = "pedido.linhas_invalidas"
+	ItemForaDaReceita               Chave = "pedido.item_fora_da_receita"
+	ItemAdicionadoERemovido         Chave = "pedido.item_adicionado_e_removido"
+	ItemNaoPodeSerExtra             Chave = "pedido.item_nao_pode_ser_extra"
+	BebidaNaoEncontrada             Chave = "pedido.bebida_nao_encontrada"
+	ItemNaoEBebida                  Chave = "pedido.item_nao_e_bebida"
+	BebidaRepetida                  Chave = "pedido.bebida_repetida"
+	HamburguerIndisponivel          Chave = "pedido.hamburguer_indisponivel"
+	HamburguerSemIngrediente        Chave = "pedido.hamburguer_sem_ingrediente"
+	ItemIndisponivel                Chave = "pedido.item_indisponivel"
+	HamburguerRemovidoDoCardapio    Chave = "pedido.hamburguer_removido_do_cardapio"
+	BebidaRepetidaOuRemovida        Chave = "pedido.bebida_repetida_ou_removida"
+	PedidosErroBuscar               Chave = "pedido.erro_buscar_todos"
+	PedidoErroBuscarHistorico       Chave = "pedido.erro_buscar_historico"
+	PedidoErroRegistrarHistorico    Chave = "pedido.erro_registrar_historico"
+	PedidoErroCriar                 Chave = "pedido.erro_criar"
+	PedidoErroCarregar              Chave = "pedido.erro_carregar"
+	PedidoErroAtualizar             Chave = "pedido.erro_atualizar"
+	PedidoErroAtualizarHamburgueres Chave = "pedido.erro_atualizar_hamburgueres"
+	PedidoErroAtualizarBebidas      Chave = "pedido.erro_atualizar_bebidas"
+	PedidoErroAdicionarHamburguer   Chave = "pedido.erro_adicionar_hamburguer"
+	PedidoErroAdicionarBebida       Chave = "pedido.erro_adicionar_bebida"
+	PedidoErroAtualizarStatus       Chave = "pedido.erro_atualizar_status"
+	PedidoErroCancelar              Chave = "pedido.erro_cancelar"
+	PedidoErroRegistrarReembolso    Chave = "pedido.erro_registrar_reembolso"
+	PedidoErroDeletar               Chave = "pedido.erro_deletar"
+	PedidoRemovidoNaoEncontrado     Chave = "pedido.removido_nao_encontrado"
+	PedidoErroRestaurar             Chave = "pedido.erro_restaurar"
+	PedidoErroProcessar             Chave = "pedido.erro_processar"
+
+	// Estoque
+	EstoqueNaoControlado         Chave = "estoque.nao_controlado"
+	EstoqueUnidadeInvalida       Chave = "estoque.unidade_invalida"
+	EstoqueUnidadeInvalidaCampo  Chave = "estoque.unidade_invalida_campo"
+	EstoqueRemovido              Chave = "estoque.removido"
+	EstoqueInsuficienteItem      Chave = "estoque.insuficiente_item"
+	EstoqueInsuficienteItens     Chave = "estoque.insuficiente_itens"
+	EstoqueFalta                 Chave = "estoque.falta"
+	EstoqueErroBuscar            Chave = "estoque.erro_buscar"
+	EstoqueErroSalvar            Chave = "estoque.erro_salvar"
+	EstoqueErroRemover           Chave = "estoque.erro_remover"
+	EstoqueTipoAjusteInvalido    Chave = "estoque.tipo_ajuste_invalido"
+	EstoqueTipoInvalidoCampo     Chave = "estoque.tipo_invalido_campo"
+	EstoqueTipoMovimentoInvalido Chave = "estoque.tipo_movimento_invalido"
+	EstoquePerdaPositiva         Chave = "estoque.perda_positiva"
+	EstoqueErroRelatorio         Chave = "estoque.erro_relatorio"
+	EstoqueErroMovimentar        Chave = "estoque.erro_movimentar"
+
+	// Clientes
+	ClienteNaoEncontrado       Chave = "cliente.nao_encontrado"
+	ClienteJaExiste            Chave = "cliente.ja_existe"
+	ClienteNovoSemDados        Chave = "cliente.novo_sem_dados"
+	ClienteNovoSemNome         Chave = "cliente.novo_sem_nome"
+	ClienteSemEndereco         Chave = "cliente.sem_endereco"
+	EnderecoNaoEncontrado      Chave = "cliente.endereco_nao_encontrado"
+	ClienteTelefoneInvalido    Chave = "cliente.telefone_invalido"
+	ClienteEnderecoIDInvalido  Chave = "cliente.endereco_id_invalido"
+	ClienteEnderecoRemovido    Chave = "cliente.endereco_removido"
+	ClienteErroBuscar          Chave = "cliente.erro_buscar"
+	ClienteErroCriar           Chave = "cliente.erro_criar"
+	ClienteErroAtualizar       Chave = "cliente.erro_atualizar"
+	CEPNaoEncontrado           Chave = "cliente.cep_nao_encontrado"
+	CEPOutraUF                 Chave = "cliente.cep_outra_uf"
+	EnderecoIncompleto         Chave = "cliente.endereco_incompleto"
+	ClienteErroRemoverEndereco Chave = "cliente.erro_remover_endereco"
+
+	// Entrega
+	EntregaEnderecoSemCEP Chave = "entrega.endereco_sem_cep"
+	EntregaForaDaArea     Chave = "entrega.fora_da_area"
+	EntregaPedidoMinimo   Chave = "entrega.pedido_minimo"
+	ZonaNaoEncontrada     Chave = "entrega.zona_nao_encontrada"
+	ZonaJaExiste          Chave = "entrega.zona_ja_existe"
+	ZonaCriterioInvalido  Chave = "entrega.zona_criterio_invalido"
+	ZonaFaixaInvalida     Chave = "entrega.zona_faixa_invalida"
+	ZonaIDInvalido        Chave = "entrega.zona_id_invalido"
+	ZonaRemovida          Chave = "entrega.zona_removida"
+	ZonaErroBuscar        Chave = "entrega.zona_erro_buscar"
+	ZonaErroCriar         Chave = "entrega.zona_erro_criar"
+	ZonaErroAtualizar     Chave = "entrega.zona_erro_atualizar"
+	ZonaErroRemover       Chave = "entrega.zona_erro_remover"
+
+	// Promoções
+	PromocaoNaoEncontrada        Chave = "promocao.nao_encontrada"
+	PromocaoJaExiste             Chave = "promocao.ja_existe"
+	PromocaoTipoInvalido         Chave = "promocao.tipo_invalido"
+	PromocaoTipoInvalidoCampo    Chave = "promocao.tipo_invalido_campo"
+	PromocaoCamposObrigatorios   Chave = "promocao.campos_obrigatorios"
+	PromocaoHorarioInvalido      Chave = "promocao.horario_invalido"
+	PromocaoHorarioInvalidoCampo Chave = "promocao.horario_invalido_campo"
+	PromocaoValidadeInvalida     Chave = "promocao.validade_invalida"
+	PromocaoIDInvalido           Chave = "promocao.id_invalido"
+	PromocaoRemovida             Chave = "promocao.removida"
+	PromocaoErroBuscar           Chave = "promocao.erro_buscar"
+	PromocaoErroCriar            Chave = "promocao.erro_criar"
+	PromocaoErroAtualizar        Chave = "promocao.erro_atualizar"
+	PromocaoErroRemover          Chave = "promocao.erro_remover"
+	PromocaoErroAplicar          Chave = "promocao.erro_aplicar"
+	CupomNaoEncontrado           Chave = "promocao.cupom_nao_encontrado"
+	CupomForaDaValidade          Chave = "promocao.cupom_fora_da_validade"
+	CupomNaoSeAplica             Chave = "promocao.cupom_nao_se_aplica"
+	CupomEsgotado                Chave = "promocao.cupom_esgotado"
+)
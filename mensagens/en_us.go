@@ -0,0 +1,195 @@
+package mensagens
+
+// enUS atende os franqueados que usam a API em inglês
+var enUS = map[Chave]string{
+	// Validação de requisições
+	DadosInvalidos:          "Invalid data",
+	CampoObrigatorio:        "required field",
+	MinimoItens:             "must have at least %s item(s)",
+	MinimoCaracteres:        "must have at least %s characters",
+	ValorMinimo:             "must be at least %s",
+	TamanhoExato:            "must have exactly %s characters",
+	MaiorQue:                "must be greater than %s",
+	TelefoneInvalidoCampo:   "must be a mobile (area code + 9 digits) or landline (area code + 8 digits) number, digits only, with a valid area code",
+	CEPInvalidoCampo:        "must have 8 digits, like 01310-100",
+	UFInvalidaCampo:         "must be a Brazilian state code, like SP",
+	ValorInvalido:           "invalid value",
+	TipoValorInvalido:       "invalid value type",
+	JSONMalformado:          "malformed JSON",
+	CorpoVazio:              "empty request body",
+	ValorMonetarioInvalido:  "invalid monetary value",
+	NumeroInteiro:           "must be an integer",
+	InteiroPositivo:         "must be a positive integer",
+	CursorInvalido:          "invalid cursor",
+	CursorOutraOrdenacao:    "the cursor was generated for a different sort order",
+	CursorInvalidoOrdenacao: "Cursor is not valid for this sort order",
+	IDInvalido:              "Invalid ID",
+
+	// Itens
+	ItemCodigoObrigatorio:       "Item code is required",
+	ItemCodigoInvalido:          "Invalid code",
+	ItemNaoEncontrado:           "Item not found",
+	ItemTipoInvalido:            "Invalid item type. Use 'BEBIDA' or 'INGREDIENTE'",
+	ItemTipoInvalidoCampo:       "invalid item type",
+	ItemJaExiste:                "Item already exists",
+	ItemEmPedidosAbertos:        "An item that is in open orders cannot be updated",
+	ItemEmUso:                   "An item used in active burger recipes or in open orders cannot be deleted",
+	ItemRemovido:                "Item deleted successfully",
+	ItemErroVerificarExistencia: "Error checking whether the item exists",
+	ItemErroCriar:               "Error creating item",
+	ItemErroAtualizar:           "Error updating item",
+	ItemErroDeletar:             "Error deleting item",
+	ItemRemovidoNaoEncontrado:   "No deleted item with this code",
+	ItemErroRestaurar:           "Error restoring item",
+	ItensErroBuscar:             "Error fetching items",
+	BebidasErroBuscar:           "Error fetching drinks",
+	IngredientesErroBuscar:      "Error fetching ingredients",
+	ErroVerificarPedidos:        "Error checking orders",
+
+	// Hambúrgueres
+	HamburguerNaoEncontrado:                 "Burger not found",
+	HamburguerJaExiste:                      "A burger with this ID already exists",
+	HamburguerJaExisteOuIngredienteRemovido: "A burger with this ID already exists or an ingredient was deleted during the operation",
+	HamburguerEmPedidosAbertos:              "A burger that is in open orders cannot be updated",
+	HamburguerEmUso:                         "A burger that is in open orders cannot be deleted",
+	HamburguerRemovidoNaoEncontrado:         "No deleted burger with this ID",
+	HamburguerReceitaComItemRemovido:        "The recipe uses deleted ingredients; restore them before the burger",
+	IngredienteRemovidoOperacao:             "Ingredient deleted during the operation",
+	IngredienteNaoEncontradoCodigo:          "Ingredient not found: %v",
+	IngredienteNaoEncontrado:                "ingredient not found",
+	ItemNaoEIngredienteCodigo:               "Item %v is not an ingredient",
+	ItemNaoEIngrediente:                     "the item is not an ingredient",
+	HamburguerErroBuscar:                    "Error fetching burger",
+	HamburguersErroBuscar:                   "Error fetching burgers",
+	HamburguerErroVerificarExistente:        "Error checking for an existing burger",
+	HamburguerErroVerificar:                 "Error checking burger",
+	HamburguerErroCriar:                     "Error creating burger",
+	HamburguerErroSalvar:                    "Error saving burger",
+	HamburguerErroDeletar:                   "Error deleting burger",
+	HamburguerErroRestaurar:                 "Error restoring burger",
+
+	// Pedidos
+	PedidoNaoEncontrado:             "Order not found",
+	PedidoDeletado:                  "Order deleted successfully",
+	StatusInvalido:                  "Invalid status: %s",
+	StatusInvalidoCampo:             "invalid status",
+	OrdenacaoInvalida:               "Invalid sort order: %s",
+	OrdenacaoInvalidaCampo:          "invalid sort order",
+	DirecaoInvalida:                 "Invalid sort direction: %s",
+	DirecaoInvalidaCampo:            "invalid sort direction",
+	TransicaoNaoPermitida:           "status transition not allowed",
+	MudancaStatusNaoPermitida:       "Cannot change the status from %s to %s",
+	CancelarPelaRota:                "To cancel an order use POST /pedidos/{id}/cancelar",
+	MotivoCancelamentoInvalido:      "Invalid cancellation reason: %s",
+	TipoPedidoInvalido:              "Invalid order type: %s. Use DELIVERY, PICKUP or DINE_IN",
+	TipoPedidoInvalidoCampo:         "invalid order type",
+	PedidoMesaObrigatoria:           "Provide the table number for DINE_IN orders",
+	PedidoMesaForaDoLocal:           "The table number only applies to DINE_IN orders",
+	PedidoSemEntrega:                "%s orders have no delivery address",
+	TipoAlteracaoNaoPermitida:       "The order type can only be changed while the order is STARTED; the order is %s",
+	CancelamentoNaoPermitido:        "An order with status %s cannot be cancelled",
+	AlteracaoNaoPermitida:           "An order with status %s cannot be changed",
+	SemReembolsoPendente:            "The order has no pending refund",
+	PedidoLinhaInvalida:             "The order has 1 invalid line",
+	PedidoLinhasInvalidas:           "The order has %d invalid lines",
+	ItemForaDaReceita:               "Item %d is not part of the %s recipe",
+	ItemAdicionadoERemovido:         "Item %d cannot be both added and removed on the same line",
+	ItemNaoPodeSerExtra:             "Item %s cannot be added as an extra",
+	BebidaNaoEncontrada:             "Drink not found",
+	ItemNaoEBebida:                  "Item %s is not a drink",
+	BebidaRepetida:                  "The drink is already on line bebidas[%d]; put the total quantity on a single line",
+	HamburguerIndisponivel:          "Burger %s is currently unavailable",
+	HamburguerSemIngrediente:        "Burger %s is unavailable because %s is out of stock",
+	ItemIndisponivel:                "Item %s is currently unavailable",
+	HamburguerRemovidoDoCardapio:    "Burger or ingredient removed from the menu while ordering",
+	BebidaRepetidaOuRemovida:        "Drink repeated or removed from the menu while ordering",
+	PedidosErroBuscar:               "Error fetching orders",
+	PedidoErroBuscarHistorico:       "Error fetching order history",
+	PedidoErroRegistrarHistorico:    "Error recording order history",
+	PedidoErroCriar:                 "Error creating order",
+	PedidoErroCarregar:              "Error loading order",
+	PedidoErroAtualizar:             "Error updating order",
+	PedidoErroAtualizarHamburgueres: "Error updating burgers",
+	PedidoErroAtualizarBebidas:      "Error updating drinks",
+	PedidoErroAdicionarHamburguer:   "Error adding burger to the order",
+	PedidoErroAdicionarBebida:       "Error adding drink to the order",
+	PedidoErroAtualizarStatus:       "Error updating order status",
+	PedidoErroCancelar:              "Error cancelling order",
+	PedidoErroRegistrarReembolso:    "Error recording refund",
+	PedidoErroDeletar:               "Error deleting order",
+	PedidoRemovidoNaoEncontrado:     "No deleted order with this ID",
+	PedidoErroRestaurar:             "Error restoring order",
+	PedidoErroProcessar:             "Error processing order",
+
+	// Estoque
+	EstoqueNaoControlado:         "The item has no tracked stock",
+	EstoqueUnidadeInvalida:       "Invalid stock unit. Use 'UN', 'G' or 'ML'",
+	EstoqueUnidadeInvalidaCampo:  "invalid unit",
+	EstoqueRemovido:              "The item's stock is no longer tracked",
+	EstoqueInsuficienteItem:      "Insufficient stock of 1 item",
+	EstoqueInsuficienteItens:     "Insufficient stock of %d items",
+	EstoqueFalta:                 "%s: %d %s needed, %d %s available",
+	EstoqueErroBuscar:            "Error fetching stock",
+	EstoqueErroSalvar:            "Error saving stock",
+	EstoqueErroRemover:           "Error removing stock tracking",
+	EstoqueTipoAjusteInvalido:    "Invalid adjustment type: %s. Use 'PERDA' or 'AJUSTE'",
+	EstoqueTipoInvalidoCampo:     "invalid movement type",
+	EstoqueTipoMovimentoInvalido: "Invalid movement type: %s",
+	EstoquePerdaPositiva:         "The quantity of a loss must be positive",
+	EstoqueErroRelatorio:         "Error generating stock report",
+	EstoqueErroMovimentar:        "Error updating stock levels",
+
+	// Clientes
+	ClienteNaoEncontrado:       "Customer not found",
+	ClienteJaExiste:            "A customer with this phone number already exists",
+	ClienteNovoSemDados:        "Unknown phone number: provide name and address on the first order",
+	ClienteNovoSemNome:         "Unknown phone number: provide the name on the first order",
+	ClienteSemEndereco:         "The customer has no saved address; provide the delivery address",
+	EnderecoNaoEncontrado:      "Address %d not found in the customer's address book",
+	ClienteTelefoneInvalido:    "Invalid phone number",
+	ClienteEnderecoIDInvalido:  "Invalid address ID",
+	ClienteEnderecoRemovido:    "Address deleted successfully",
+	ClienteErroBuscar:          "Error fetching customer",
+	ClienteErroCriar:           "Error creating customer",
+	ClienteErroAtualizar:       "Error updating customer",
+	CEPNaoEncontrado:           "ZIP code %s not found",
+	CEPOutraUF:                 "ZIP code %s belongs to %s/%s",
+	EnderecoIncompleto:         "Incomplete address: provide ZIP code, street, number, neighborhood, city and state",
+	ClienteErroRemoverEndereco: "Error deleting address",
+
+	// Entrega
+	EntregaEnderecoSemCEP: "The address has no ZIP code; provide the full address to calculate delivery",
+	EntregaForaDaArea:     "We do not deliver to %s, %s/%s",
+	EntregaPedidoMinimo:   "The minimum order for delivery in zone %s is R$ %s",
+	ZonaNaoEncontrada:     "Delivery zone not found",
+	ZonaJaExiste:          "A delivery zone with this name already exists",
+	ZonaCriterioInvalido:  "Provide either the ZIP code range (cep_inicio and cep_fim) or the neighborhood with city and state, not both",
+	ZonaFaixaInvalida:     "cep_inicio must be less than or equal to cep_fim",
+	ZonaIDInvalido:        "Invalid delivery zone ID",
+	ZonaRemovida:          "Delivery zone deleted successfully",
+	ZonaErroBuscar:        "Error fetching delivery zones",
+	ZonaErroCriar:         "Error creating delivery zone",
+	ZonaErroAtualizar:     "Error updating delivery zone",
+	ZonaErroRemover:       "Error deleting delivery zone",
+
+	// Promoções
+	PromocaoNaoEncontrada:        "Promotion not found",
+	PromocaoJaExiste:             "A promotion with this name or coupon already exists",
+	PromocaoTipoInvalido:         "Invalid promotion type: %s. Use PERCENTUAL, VALOR_FIXO, LEVE_GANHE or COMBO",
+	PromocaoTipoInvalidoCampo:    "invalid promotion type",
+	PromocaoCamposObrigatorios:   "Required fields are missing for %s promotions",
+	PromocaoHorarioInvalido:      "Provide different hora_inicio and hora_fim values in HH:MM format",
+	PromocaoHorarioInvalidoCampo: "must be in HH:MM format",
+	PromocaoValidadeInvalida:     "valido_ate must be after valido_de",
+	PromocaoIDInvalido:           "Invalid promotion ID",
+	PromocaoRemovida:             "Promotion deleted successfully",
+	PromocaoErroBuscar:           "Error fetching promotions",
+	PromocaoErroCriar:            "Error creating promotion",
+	PromocaoErroAtualizar:        "Error updating promotion",
+	PromocaoErroRemover:          "Error deleting promotion",
+	PromocaoErroAplicar:          "Error applying discounts to the order",
+	CupomNaoEncontrado:           "Coupon %s not found",
+	CupomForaDaValidade:          "Coupon %s is not valid at this time",
+	CupomNaoSeAplica:             "Coupon %s does not apply to this order",
+	CupomEsgotado:                "Coupon %s has reached its usage limit",
+}
@@ -0,0 +1,105 @@
+// Package mensagens é o catálogo das mensagens exibidas pela API, em pt-BR e en-US,
+// com a escolha do idioma a partir do cabeçalho Accept-Language
+package mensagens
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+type Idioma string
+
+const (
+	PtBR Idioma = "pt-BR"
+	EnUS Idioma = "en-US"
+
+	// Padrao é usado quando o cliente não pede nenhum idioma suportado
+	Padrao = PtBR
+)
+
+// Idiomas são os idiomas com catálogo completo
+var Idiomas = []Idioma{PtBR, EnUS}
+
+// Chave identifica uma mensagem do catálogo
+type Chave string
+
+var catalogos = map[Idioma]map[Chave]string{
+	PtBR: ptBR,
+	EnUS: enUS,
+}
+
+// Traduzir monta a mensagem no idioma pedido, usando o catálogo padrão quando a chave
+// não existir nele
+func Traduzir(idioma Idioma, chave Chave, args ...any) string {
+	modelo, ok := catalogos[idioma][chave]
+	if !ok {
+		modelo, ok = catalogos[Padrao][chave]
+	}
+	if !ok {
+		return string(chave)
+	}
+	if len(args) == 0 {
+		return modelo
+	}
+	return fmt.Sprintf(modelo, args...)
+}
+
+// Mensagem é uma mensagem do catálogo com seus argumentos, para ser traduzida apenas
+// quando o idioma de quem vai lê-la for conhecido
+type Mensagem struct {
+	Chave Chave
+	Args  []any
+}
+
+func Nova(chave Chave, args ...any) Mensagem {
+	return Mensagem{Chave: chave, Args: args}
+}
+
+func (m Mensagem) Traduzir(idioma Idioma) string {
+	return Traduzir(idioma, m.Chave, m.Args...)
+}
+
+// Negociar escolhe o idioma a partir do Accept-Language, respeitando os pesos q e
+// aceitando só o idioma principal (en, pt) como equivalente à variante suportada
+func Negociar(acceptLanguage string) Idioma {
+	type preferencia struct {
+		tag  string
+		peso float64
+	}
+
+	var preferencias []preferencia
+	for _, parte := range strings.Split(acceptLanguage, ",") {
+		tag, parametros, _ := strings.Cut(strings.TrimSpace(parte), ";")
+		peso := 1.0
+		if valor, ok := strings.CutPrefix(strings.TrimSpace(parametros), "q="); ok {
+			if q, err := strconv.ParseFloat(valor, 64); err == nil {
+				peso = q
+			}
+		}
+		if tag != "" && peso > 0 {
+			preferencias = append(preferencias, preferencia{tag: strings.ToLower(tag), peso: peso})
+		}
+	}
+	slices.SortStableFunc(preferencias, func(a, b preferencia) int { return cmp.Compare(b.peso, a.peso) })
+
+	for _, p := range preferencias {
+		if p.tag == "*" {
+			return Padrao
+		}
+		principal, _, _ := strings.Cut(p.tag, "-")
+		for _, idioma := range Idiomas {
+			if p.tag == strings.ToLower(string(idioma)) {
+				return idioma
+			}
+		}
+		for _, idioma := range Idiomas {
+			if principal == strings.ToLower(strings.SplitN(string(idioma), "-", 2)[0]) {
+				return idioma
+			}
+		}
+	}
+	return Padrao
+}
@@ -0,0 +1,106 @@
+package mensagens
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"regexp"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+func TestNegociar(t *testing.T) {
+	casos := map[string]Idioma{
+		"":                              PtBR,
+		"pt-BR":                         PtBR,
+		"en-US":                         EnUS,
+		"EN-us":                         EnUS,
+		"en":                            EnUS,
+		"en-GB":                         EnUS,
+		"pt":                            PtBR,
+		"pt-PT":                         PtBR,
+		"fr":                            PtBR,
+		"*":                             PtBR,
+		"fr-CA, fr;q=0.9, en;q=0.5":     EnUS,
+		"pt-BR;q=0.4, en-US;q=0.8":      EnUS,
+		"  en-US ; q=0.7 , pt-BR;q=0.6": EnUS,
+		"en;q=0":                        PtBR,
+		"en;q=0, pt":                    PtBR,
+		"en, pt":                        EnUS, // pesos iguais mantêm a ordem do cabeçalho
+		"pt, en":                        PtBR,
+		"es, *;q=0.9, en;q=0.5":         PtBR, // o curinga vem antes do inglês
+		"es, en;q=0.9, *;q=0.5":         EnUS,
+		"en;q=abc":                      EnUS, // peso inválido conta como 1
+		",,;q=1, en":                    EnUS,
+	}
+
+	for cabecalho, esperado := range casos {
+		if idioma := Negociar(cabecalho); idioma != esperado {
+			t.Errorf("Negociar(%q) = %s, esperado %s", cabecalho, idioma, esperado)
+		}
+	}
+}
+
+// chavesDeclaradas lê as constantes do tipo Chave em chaves.go
+func chavesDeclaradas(t *testing.T) []Chave {
+	t.Helper()
+
+	arquivo, err := parser.ParseFile(token.NewFileSet(), "chaves.go", nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var chaves []Chave
+	ast.Inspect(arquivo, func(no ast.Node) bool {
+		spec, ok := no.(*ast.ValueSpec)
+		if !ok || spec.Type == nil || spec.Type.(*ast.Ident).Name != "Chave" {
+			return true
+		}
+		for _, valor := range spec.Values {
+			texto, err := strconv.Unquote(valor.(*ast.BasicLit).Value)
+			if err != nil {
+				t.Fatal(err)
+			}
+			chaves = append(chaves, Chave(texto))
+		}
+		return true
+	})
+	return chaves
+}
+
+var verbos = regexp.MustCompile(`%[-+# 0-9.]*[a-zA-Z%]`)
+
+func TestCatalogosTemAsMesmasChaves(t *testing.T) {
+	declaradas := chavesDeclaradas(t)
+	if len(declaradas) == 0 {
+		t.Fatal("nenhuma chave encontrada em chaves.go")
+	}
+
+	for _, idioma := range Idiomas {
+		catalogo := catalogos[idioma]
+		if len(catalogo) != len(declaradas) {
+			t.Errorf("%s: %d mensagens para %d chaves declaradas", idioma, len(catalogo), len(declaradas))
+		}
+		for _, chave := range declaradas {
+			if _, ok := catalogo[chave]; !ok {
+				t.Errorf("%s: sem mensagem para a chave %q", idioma, chave)
+			}
+		}
+		for chave := range catalogo {
+			if !slices.Contains(declaradas, chave) {
+				t.Errorf("%s: mensagem para a chave %q, que não está declarada", idioma, chave)
+			}
+		}
+	}
+
+	// As traduções recebem os mesmos argumentos, na mesma ordem
+	for chave, modelo := range catalogos[Padrao] {
+		for _, idioma := range Idiomas {
+			traducao, ok := catalogos[idioma][chave]
+			if ok && !slices.Equal(verbos.FindAllString(modelo, -1), verbos.FindAllString(traducao, -1)) {
+				t.Errorf("%s: %q tem verbos diferentes de %s: %q", idioma, traducao, Padrao, modelo)
+			}
+		}
+	}
+}
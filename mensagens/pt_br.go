@@ -0,0 +1,195 @@
+package mensagens
+
+// ptBR é o catálogo padrão; toda chave precisa existir aqui
+var ptBR = map[Chave]string{
+	// Validação de requisições
+	DadosInvalidos:          "Dados inválidos",
+	CampoObrigatorio:        "campo obrigatório",
+	MinimoItens:             "deve ter pelo menos %s item(ns)",
+	MinimoCaracteres:        "deve ter pelo menos %s caracteres",
+	ValorMinimo:             "deve ser no mínimo %s",
+	TamanhoExato:            "deve ter exatamente %s caracteres",
+	MaiorQue:                "deve ser maior que %s",
+	TelefoneInvalidoCampo:   "deve ser um celular (DDD + 9 dígitos) ou fixo (DDD + 8 dígitos), só com números e DDD válido",
+	CEPInvalidoCampo:        "deve ter 8 dígitos, como 01310-100",
+	UFInvalidaCampo:         "deve ser a sigla de uma UF, como SP",
+	ValorInvalido:           "valor inválido",
+	TipoValorInvalido:       "tipo de valor inválido",
+	JSONMalformado:          "JSON malformado",
+	CorpoVazio:              "corpo da requisição vazio",
+	ValorMonetarioInvalido:  "valor monetário inválido",
+	NumeroInteiro:           "deve ser um número inteiro",
+	InteiroPositivo:         "deve ser um número inteiro positivo",
+	CursorInvalido:          "cursor inválido",
+	CursorOutraOrdenacao:    "o cursor foi gerado para outra ordenação",
+	CursorInvalidoOrdenacao: "Cursor inválido para esta ordenação",
+	IDInvalido:              "ID inválido",
+
+	// Itens
+	ItemCodigoObrigatorio:       "Código do item é obrigatório",
+	ItemCodigoInvalido:          "Código inválido",
+	ItemNaoEncontrado:           "Item não encontrado",
+	ItemTipoInvalido:            "Tipo de item inválido. Use 'BEBIDA' ou 'INGREDIENTE'",
+	ItemTipoInvalidoCampo:       "tipo de item inválido",
+	ItemJaExiste:                "Item já existe",
+	ItemEmPedidosAbertos:        "Não é possível atualizar um item que está em pedidos em aberto",
+	ItemEmUso:                   "Não é possível deletar um item usado na receita de hambúrgueres ativos ou em pedidos em aberto",
+	ItemRemovido:                "Item removido com sucesso",
+	ItemErroVerificarExistencia: "Erro ao verificar existência do item",
+	ItemErroCriar:               "Erro ao criar item",
+	ItemErroAtualizar:           "Erro ao atualizar item",
+	ItemErroDeletar:             "Erro ao deletar item",
+	ItemRemovidoNaoEncontrado:   "Nenhum item removido com este código",
+	ItemErroRestaurar:           "Erro ao restaurar item",
+	ItensErroBuscar:             "Erro ao buscar itens",
+	BebidasErroBuscar:           "Erro ao buscar bebidas",
+	IngredientesErroBuscar:      "Erro ao buscar ingredientes",
+	ErroVerificarPedidos:        "Erro ao verificar pedidos",
+
+	// Hambúrgueres
+	HamburguerNaoEncontrado:                 "Hambúrguer não encontrado",
+	HamburguerJaExiste:                      "Já existe um hambúrguer com este ID",
+	HamburguerJaExisteOuIngredienteRemovido: "Já existe um hambúrguer com este ID ou um ingrediente foi removido durante a operação",
+	HamburguerEmPedidosAbertos:              "Não é possível atualizar um hambúrguer que está em pedidos em aberto",
+	HamburguerEmUso:                         "Não é possível deletar um hambúrguer que está em pedidos em aberto",
+	HamburguerRemovidoNaoEncontrado:         "Nenhum hambúrguer removido com este ID",
+	HamburguerReceitaComItemRemovido:        "A receita usa ingredientes removidos; restaure-os antes do hambúrguer",
+	IngredienteRemovidoOperacao:             "Ingrediente removido durante a operação",
+	IngredienteNaoEncontradoCodigo:          "Ingrediente não encontrado: %v",
+	IngredienteNaoEncontrado:                "ingrediente não encontrado",
+	ItemNaoEIngredienteCodigo:               "O item %v não é um ingrediente",
+	ItemNaoEIngrediente:                     "o item não é um ingrediente",
+	HamburguerErroBuscar:                    "Erro ao buscar hambúrguer",
+	HamburguersErroBuscar:                   "Erro ao buscar hambúrgueres",
+	HamburguerErroVerificarExistente:        "Erro ao verificar hambúrguer existente",
+	HamburguerErroVerificar:                 "Erro ao verificar hambúrguer",
+	HamburguerErroCriar:                     "Erro ao criar hambúrguer",
+	HamburguerErroSalvar:                    "Erro ao salvar hambúrguer",
+	HamburguerErroDeletar:                   "Erro ao deletar hambúrguer",
+	HamburguerErroRestaurar:                 "Erro ao restaurar hambúrguer",
+
+	// Pedidos
+	PedidoNaoEncontrado:             "Pedido não encontrado",
+	PedidoDeletado:                  "Pedido deletado com sucesso",
+	StatusInvalido:                  "Status inválido: %s",
+	StatusInvalidoCampo:             "status inválido",
+	OrdenacaoInvalida:               "Ordenação inválida: %s",
+	OrdenacaoInvalidaCampo:          "ordenação inválida",
+	DirecaoInvalida:                 "Direção inválida: %s",
+	DirecaoInvalidaCampo:            "direção inválida",
+	TransicaoNaoPermitida:           "transição de status não permitida",
+	MudancaStatusNaoPermitida:       "Não é possível mudar o status de %s para %s",
+	CancelarPelaRota:                "Para cancelar um pedido use POST /pedidos/{id}/cancelar",
+	MotivoCancelamentoInvalido:      "Motivo de cancelamento inválido: %s",
+	TipoPedidoInvalido:              "Tipo de pedido inválido: %s. Use DELIVERY, PICKUP ou DINE_IN",
+	TipoPedidoInvalidoCampo:         "tipo de pedido inválido",
+	PedidoMesaObrigatoria:           "Informe a mesa nos pedidos DINE_IN",
+	PedidoMesaForaDoLocal:           "A mesa só é informada nos pedidos DINE_IN",
+	PedidoSemEntrega:                "Pedidos %s não têm endereço de entrega",
+	TipoAlteracaoNaoPermitida:       "O tipo do pedido só pode ser alterado enquanto ele está em STARTED; o pedido está em %s",
+	CancelamentoNaoPermitido:        "Não é possível cancelar um pedido com status %s",
+	AlteracaoNaoPermitida:           "Não é possível alterar um pedido com status %s",
+	SemReembolsoPendente:            "Pedido não possui reembolso pendente",
+	PedidoLinhaInvalida:             "O pedido possui 1 linha inválida",
+	PedidoLinhasInvalidas:           "O pedido possui %d linhas inválidas",
+	ItemForaDaReceita:               "O item %d não faz parte da receita de %s",
+	ItemAdicionadoERemovido:         "O item %d não pode ser adicionado e removido na mesma linha",
+	ItemNaoPodeSerExtra:             "O item %s não pode ser adicionado como extra",
+	BebidaNaoEncontrada:             "Bebida não encontrada",
+	ItemNaoEBebida:                  "O item %s não é uma bebida",
+	BebidaRepetida:                  "A bebida já está na linha bebidas[%d]; informe a quantidade total em uma única linha",
+	HamburguerIndisponivel:          "O hambúrguer %s está indisponível no momento",
+	HamburguerSemIngrediente:        "O hambúrguer %s está indisponível por falta de %s",
+	ItemIndisponivel:                "O item %s está indisponível no momento",
+	HamburguerRemovidoDoCardapio:    "Hambúrguer ou ingrediente removido do cardápio durante o pedido",
+	BebidaRepetidaOuRemovida:        "Bebida repetida ou removida do cardápio durante o pedido",
+	PedidosErroBuscar:               "Erro ao buscar pedidos",
+	PedidoErroBuscarHistorico:       "Erro ao buscar histórico do pedido",
+	PedidoErroRegistrarHistorico:    "Erro ao registrar histórico do pedido",
+	PedidoErroCriar:                 "Erro ao criar pedido",
+	PedidoErroCarregar:              "Erro ao carregar pedido",
+	PedidoErroAtualizar:             "Erro ao atualizar pedido",
+	PedidoErroAtualizarHamburgueres: "Erro ao atualizar hambúrgueres",
+	PedidoErroAtualizarBebidas:      "Erro ao atualizar bebidas",
+	PedidoErroAdicionarHamburguer:   "Erro ao adicionar hambúrguer ao pedido",
+	PedidoErroAdicionarBebida:       "Erro ao adicionar bebida ao pedido",
+	PedidoErroAtualizarStatus:       "Erro ao atualizar status do pedido",
+	PedidoErroCancelar:              "Erro ao cancelar pedido",
+	PedidoErroRegistrarReembolso:    "Erro ao registrar reembolso",
+	PedidoErroDeletar:               "Erro ao deletar pedido",
+	PedidoRemovidoNaoEncontrado:     "Nenhum pedido removido com este ID",
+	PedidoErroRestaurar:             "Erro ao restaurar pedido",
+	PedidoErroProcessar:             "Erro ao processar pedido",
+
+	// Estoque
+	EstoqueNaoControlado:         "O item não tem estoque controlado",
+	EstoqueUnidadeInvalida:       "Unidade de estoque inválida. Use 'UN', 'G' ou 'ML'",
+	EstoqueUnidadeInvalidaCampo:  "unidade inválida",
+	EstoqueRemovido:              "O estoque do item deixou de ser controlado",
+	EstoqueInsuficienteItem:      "Estoque insuficiente de 1 item",
+	EstoqueInsuficienteItens:     "Estoque insuficiente de %d itens",
+	EstoqueFalta:                 "%s: necessário %d %s, disponível %d %s",
+	EstoqueErroBuscar:            "Erro ao buscar estoque",
+	EstoqueErroSalvar:            "Erro ao salvar estoque",
+	EstoqueErroRemover:           "Erro ao remover controle de estoque",
+	EstoqueTipoAjusteInvalido:    "Tipo de ajuste inválido: %s. Use 'PERDA' ou 'AJUSTE'",
+	EstoqueTipoInvalidoCampo:     "tipo de movimento inválido",
+	EstoqueTipoMovimentoInvalido: "Tipo de movimento inválido: %s",
+	EstoquePerdaPositiva:         "A quantidade de uma perda deve ser positiva",
+	EstoqueErroRelatorio:         "Erro ao gerar relatório de estoque",
+	EstoqueErroMovimentar:        "Erro ao movimentar estoque",
+
+	// Clientes
+	ClienteNaoEncontrado:       "Cliente não encontrado",
+	ClienteJaExiste:            "Já existe um cliente com este telefone",
+	ClienteNovoSemDados:        "Telefone sem cadastro: informe nome e endereço no primeiro pedido",
+	ClienteNovoSemNome:         "Telefone sem cadastro: informe o nome no primeiro pedido",
+	ClienteSemEndereco:         "O cliente não tem endereço salvo; informe o endereço de entrega",
+	EnderecoNaoEncontrado:      "Endereço %d não encontrado no cadastro do cliente",
+	ClienteTelefoneInvalido:    "Telefone inválido",
+	ClienteEnderecoIDInvalido:  "ID de endereço inválido",
+	ClienteEnderecoRemovido:    "Endereço removido com sucesso",
+	ClienteErroBuscar:          "Erro ao buscar cliente",
+	ClienteErroCriar:           "Erro ao cadastrar cliente",
+	ClienteErroAtualizar:       "Erro ao atualizar cliente",
+	CEPNaoEncontrado:           "CEP %s não encontrado",
+	CEPOutraUF:                 "O CEP %s é de %s/%s",
+	EnderecoIncompleto:         "Endereço incompleto: informe CEP, logradouro, número, bairro, cidade e UF",
+	ClienteErroRemoverEndereco: "Erro ao remover endereço",
+
+	// Entrega
+	EntregaEnderecoSemCEP: "O endereço não tem CEP; informe o endereço completo para calcular a entrega",
+	EntregaForaDaArea:     "Não entregamos em %s, %s/%s",
+	EntregaPedidoMinimo:   "O pedido mínimo para entrega na zona %s é R$ %s",
+	ZonaNaoEncontrada:     "Zona de entrega não encontrada",
+	ZonaJaExiste:          "Já existe uma zona de entrega com este nome",
+	ZonaCriterioInvalido:  "Informe a faixa de CEPs (cep_inicio e cep_fim) ou o bairro com cidade e UF, mas não os dois",
+	ZonaFaixaInvalida:     "cep_inicio deve ser menor ou igual a cep_fim",
+	ZonaIDInvalido:        "ID de zona de entrega inválido",
+	ZonaRemovida:          "Zona de entrega removida com sucesso",
+	ZonaErroBuscar:        "Erro ao buscar zonas de entrega",
+	ZonaErroCriar:         "Erro ao criar zona de entrega",
+	ZonaErroAtualizar:     "Erro ao atualizar zona de entrega",
+	ZonaErroRemover:       "Erro ao remover zona de entrega",
+
+	// Promoções
+	PromocaoNaoEncontrada:        "Promoção não encontrada",
+	PromocaoJaExiste:             "Já existe uma promoção com este nome ou cupom",
+	PromocaoTipoInvalido:         "Tipo de promoção inválido: %s. Use PERCENTUAL, VALOR_FIXO, LEVE_GANHE ou COMBO",
+	PromocaoTipoInvalidoCampo:    "tipo de promoção inválido",
+	PromocaoCamposObrigatorios:   "Faltam campos obrigatórios para promoções %s",
+	PromocaoHorarioInvalido:      "Informe hora_inicio e hora_fim diferentes, no formato HH:MM",
+	PromocaoHorarioInvalidoCampo: "deve estar no formato HH:MM",
+	PromocaoValidadeInvalida:     "valido_ate deve ser posterior a valido_de",
+	PromocaoIDInvalido:           "ID de promoção inválido",
+	PromocaoRemovida:             "Promoção removida com sucesso",
+	PromocaoErroBuscar:           "Erro ao buscar promoções",
+	PromocaoErroCriar:            "Erro ao criar promoção",
+	PromocaoErroAtualizar:        "Erro ao atualizar promoção",
+	PromocaoErroRemover:          "Erro ao remover promoção",
+	PromocaoErroAplicar:          "Erro ao aplicar os descontos ao pedido",
+	CupomNaoEncontrado:           "Cupom %s não encontrado",
+	CupomForaDaValidade:          "O cupom %s não está valendo neste horário",
+	CupomNaoSeAplica:             "O cupom %s não se aplica a este pedido",
+	CupomEsgotado:                "O cupom %s atingiu o limite de usos",
+}
@@ -0,0 +1,21 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"lanchonete/mensagens"
+)
+
+// ChaveIdioma é a chave do idioma negociado no contexto do gin
+const ChaveIdioma = "idioma"
+
+// Idioma negocia o idioma das mensagens pelo Accept-Language e informa a escolha em Content-Language
+func Idioma() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		idioma := mensagens.Negociar(c.GetHeader("Accept-Language"))
+
+		c.Set(ChaveIdioma, idioma)
+		c.Header("Content-Language", string(idioma))
+		c.Header("Vary", "Accept-Language")
+		c.Next()
+	}
+}
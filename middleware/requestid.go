@@ -0,0 +1,41 @@
+// Package middleware reúne os middlewares HTTP aplicados a todas as rotas
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const (
+	HeaderRequestID = "X-Request-ID"
+	ChaveRequestID  = "request_id" // chave no contexto do gin
+)
+
+// RequestID identifica cada requisição, reaproveitando o X-Request-ID enviado pelo
+// cliente ou pelo proxy, e devolve o mesmo valor no cabeçalho da resposta
+func RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader(HeaderRequestID)
+		if !idValido(id) {
+			id = uuid.NewString()
+		}
+
+		c.Set(ChaveRequestID, id)
+		c.Header(HeaderRequestID, id)
+		c.Next()
+	}
+}
+
+// idValido aceita apenas IDs curtos e imprimíveis, para que o valor recebido possa
+// ser devolvido em cabeçalhos e logs sem risco
+func idValido(id string) bool {
+	if id == "" || len(id) > 128 {
+		return false
+	}
+	for _, r := range id {
+		if r <= ' ' || r > '~' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,50 @@
+package models
+
+import "time"
+
+// Cliente é identificado pelo telefone, que também liga o cliente aos seus pedidos
+type Cliente struct {
+	Telefone  string            `gorm:"primaryKey" json:"telefone"`
+	Nome      string            `gorm:"not null" json:"nome"`
+	Enderecos []ClienteEndereco `gorm:"foreignKey:ClienteTelefone;references:Telefone" json:"enderecos"`
+	CriadoEm  time.Time         `gorm:"not null;default:CURRENT_TIMESTAMP" json:"criado_em"`
+}
+
+// ClienteEndereco é um endereço de entrega salvo no cadastro do cliente. O pedido
+// guarda uma cópia do endereço, então alterar o cadastro não muda pedidos antigos.
+type ClienteEndereco struct {
+	ID              uint   `gorm:"primaryKey" json:"id"`
+	ClienteTelefone string `gorm:"not null;index" json:"-"`
+	Apelido         string `json:"apelido,omitempty" example:"Casa"`
+	Endereco        string `gorm:"not null" json:"endereco"` // endereço em uma linha; os cadastros antigos só têm o texto
+	EnderecoEntrega
+	Principal bool `gorm:"not null;default:false" json:"principal"` // usado quando o pedido não informa o endereço
+}
+
+// EnderecoPrincipal retorna o endereço principal do cliente, se houver algum salvo
+func (c Cliente) EnderecoPrincipal() (ClienteEndereco, bool) {
+	for _, endereco := range c.Enderecos {
+		if endereco.Principal {
+			return endereco, true
+		}
+	}
+	return ClienteEndereco{}, false
+}
+
+// ClienteRequest cadastra um cliente com, opcionalmente, seus endereços
+type ClienteRequest struct {
+	Telefone  string                   `json:"telefone" binding:"required,telefone" example:"11987654321"`
+	Nome      string                   `json:"nome" binding:"required"`
+	Enderecos []ClienteEnderecoRequest `json:"enderecos" binding:"dive"`
+}
+
+// ClienteUpdateRequest altera o nome do cliente; os endereços têm rotas próprias
+type ClienteUpdateRequest struct {
+	Nome string `json:"nome" binding:"required"`
+}
+
+type ClienteEnderecoRequest struct {
+	Apelido   string          `json:"apelido"`
+	Endereco  EnderecoRequest `json:"endereco"`
+	Principal bool            `json:"principal"`
+}
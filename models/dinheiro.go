@@ -0,0 +1,134 @@
+package models
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Dinheiro representa um valor em reais guardado em centavos, para que somas
+// e multiplicações de preços não acumulem erros de arredondamento. No JSON é
+// serializado como número com duas casas (25.90) e no banco como numeric(12,2).
+type Dinheiro int64
+
+var ErrDinheiroInvalido = errors.New("valor monetário inválido")
+
+// Centavos cria um valor a partir da quantidade de centavos
+func Centavos(centavos int64) Dinheiro {
+	return Dinheiro(centavos)
+}
+
+// ParseDinheiro converte um valor decimal como "25.9" ou "25.90" sem passar por float64
+func ParseDinheiro(valor string) (Dinheiro, error) {
+	valor = strings.TrimSpace(valor)
+	negativo := strings.HasPrefix(valor, "-")
+	valor = strings.TrimPrefix(valor, "-")
+
+	inteiro, fracao, temFracao := strings.Cut(valor, ".")
+	if inteiro == "" || (temFracao && fracao == "") || len(fracao) > 2 {
+		return 0, ErrDinheiroInvalido
+	}
+	for len(fracao) < 2 {
+		fracao += "0"
+	}
+
+	if strings.ContainsAny(inteiro+fracao, "+-") {
+		return 0, ErrDinheiroInvalido
+	}
+
+	reais, err := strconv.ParseInt(inteiro, 10, 64)
+	if err != nil {
+		return 0, ErrDinheiroInvalido
+	}
+	centavos, err := strconv.ParseInt(fracao, 10, 64)
+	if err != nil {
+		return 0, ErrDinheiroInvalido
+	}
+
+	total := Dinheiro(reais*100 + centavos)
+	if negativo {
+		total = -total
+	}
+	return total, nil
+}
+
+// Multiplicar retorna o valor multiplicado por uma quantidade
+func (d Dinheiro) Multiplicar(quantidade int) Dinheiro {
+	return d * Dinheiro(quantidade)
+}
+
+// Porcentagem retorna o percentual do valor, arredondado para baixo no centavo
+func (d Dinheiro) Porcentagem(percentual int) Dinheiro {
+	return d * Dinheiro(percentual) / 100
+}
+
+func (d Dinheiro) String() string {
+	sinal := ""
+	if d < 0 {
+		sinal = "-"
+		d = -d
+	}
+	return fmt.Sprintf("%s%d.%02d", sinal, d/100, d%100)
+}
+
+func (d Dinheiro) MarshalJSON() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalJSON aceita tanto números (25.90) quanto textos ("25.90")
+func (d *Dinheiro) UnmarshalJSON(dados []byte) error {
+	texto := strings.Trim(string(dados), `"`)
+	if texto == "null" {
+		return nil
+	}
+	valor, err := ParseDinheiro(texto)
+	if err != nil {
+		return err
+	}
+	*d = valor
+	return nil
+}
+
+func (d Dinheiro) Value() (driver.Value, error) {
+	return d.String(), nil
+}
+
+func (d *Dinheiro) Scan(valor any) error {
+	switch v := valor.(type) {
+	case nil:
+		*d = 0
+		return nil
+	case string:
+		return d.scanTexto(v)
+	case []byte:
+		return d.scanTexto(string(v))
+	case int64:
+		*d = Dinheiro(v * 100)
+		return nil
+	case float64:
+		return d.scanTexto(strconv.FormatFloat(v, 'f', 2, 64))
+	}
+	return fmt.Errorf("%w: tipo %T", ErrDinheiroInvalido, valor)
+}
+
+func (d *Dinheiro) scanTexto(texto string) error {
+	// O Postgres pode devolver zeros à direita além da escala da coluna
+	if inteiro, fracao, ok := strings.Cut(texto, "."); ok && len(fracao) > 2 {
+		texto = inteiro
+		if fracao = strings.TrimRight(fracao, "0"); fracao != "" {
+			texto += "." + fracao
+		}
+	}
+	valor, err := ParseDinheiro(texto)
+	if err != nil {
+		return err
+	}
+	*d = valor
+	return nil
+}
+
+func (Dinheiro) GormDataType() string {
+	return "numeric(12,2)"
+}
@@ -0,0 +1,132 @@
+package models
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestParseDinheiro(t *testing.T) {
+	validos := map[string]Dinheiro{
+		"25.90":  Centavos(2590),
+		"25.9":   Centavos(2590),
+		"25":     Centavos(2500),
+		"0.05":   Centavos(5),
+		" 1.50 ": Centavos(150),
+		"-0.50":  Centavos(-50),
+		"-12.3":  Centavos(-1230),
+	}
+	for texto, esperado := range validos {
+		valor, err := ParseDinheiro(texto)
+		if err != nil || valor != esperado {
+			t.Errorf("ParseDinheiro(%q) = %s, %v; esperado %s", texto, valor, err, esperado)
+		}
+	}
+
+	for _, texto := range []string{"", "-", ".50", "25.", "25.999", "1.234", "+1", "--1", "1.-5", "1e3", "25,90", "abc"} {
+		if valor, err := ParseDinheiro(texto); !errors.Is(err, ErrDinheiroInvalido) {
+			t.Errorf("ParseDinheiro(%q) = %s, %v; esperado ErrDinheiroInvalido", texto, valor, err)
+		}
+	}
+}
+
+func TestDinheiroPorcentagem(t *testing.T) {
+	casos := []struct {
+		valor      Dinheiro
+		percentual int
+		esperado   Dinheiro
+	}{
+		{Centavos(2590), 15, Centavos(388)}, // 3.885 arredondado para baixo
+		{Centavos(999), 10, Centavos(99)},   // 0.999 arredondado para baixo
+		{Centavos(2590), 10, Centavos(259)},
+		{Centavos(2590), 100, Centavos(2590)},
+		{Centavos(2590), 0, 0},
+		{Centavos(1), 50, 0},
+	}
+	for _, caso := range casos {
+		if obtido := caso.valor.Porcentagem(caso.percentual); obtido != caso.esperado {
+			t.Errorf("%s.Porcentagem(%d) = %s, esperado %s", caso.valor, caso.percentual, obtido, caso.esperado)
+		}
+	}
+}
+
+func TestDinheiroJSON(t *testing.T) {
+	var corpo struct {
+		Preco Dinheiro `json:"preco"`
+	}
+
+	entradas := map[string]Dinheiro{
+		`{"preco": 25.90}`:   Centavos(2590),
+		`{"preco": 25.9}`:    Centavos(2590),
+		`{"preco": "25.90"}`: Centavos(2590),
+		`{"preco": 7}`:       Centavos(700),
+		`{"preco": -1.5}`:    Centavos(-150),
+		`{"preco": "-1.50"}`: Centavos(-150),
+	}
+	for entrada, esperado := range entradas {
+		corpo.Preco = 0
+		if err := json.Unmarshal([]byte(entrada), &corpo); err != nil || corpo.Preco != esperado {
+			t.Errorf("Unmarshal(%s) = %s, %v; esperado %s", entrada, corpo.Preco, err, esperado)
+		}
+	}
+
+	// null mantém o valor que já estava no campo
+	corpo.Preco = Centavos(100)
+	if err := json.Unmarshal([]byte(`{"preco": null}`), &corpo); err != nil || corpo.Preco != Centavos(100) {
+		t.Errorf("Unmarshal(null) = %s, %v; esperado 1.00", corpo.Preco, err)
+	}
+
+	for _, entrada := range []string{`{"preco": 25.999}`, `{"preco": "25.999"}`, `{"preco": 1e2}`, `{"preco": "abc"}`, `{"preco": true}`} {
+		if err := json.Unmarshal([]byte(entrada), &corpo); !errors.Is(err, ErrDinheiroInvalido) {
+			t.Errorf("Unmarshal(%s): erro %v, esperado ErrDinheiroInvalido", entrada, err)
+		}
+	}
+
+	saidas := map[Dinheiro]string{
+		Centavos(2590): `{"preco":25.90}`,
+		Centavos(5):    `{"preco":0.05}`,
+		Centavos(-5):   `{"preco":-0.05}`,
+		0:              `{"preco":0.00}`,
+	}
+	for valor, esperado := range saidas {
+		corpo.Preco = valor
+		dados, err := json.Marshal(corpo)
+		if err != nil || string(dados) != esperado {
+			t.Errorf("Marshal(%d centavos) = %s, %v; esperado %s", int64(valor), dados, err, esperado)
+		}
+	}
+}
+
+func TestDinheiroBanco(t *testing.T) {
+	valor, err := Centavos(-1234).Value()
+	if err != nil || valor != "-12.34" {
+		t.Errorf("Value() = %v, %v; esperado -12.34", valor, err)
+	}
+
+	lidos := []struct {
+		coluna   any
+		esperado Dinheiro
+	}{
+		{"25.90", Centavos(2590)},
+		{[]byte("25.90"), Centavos(2590)},
+		{"25.9000", Centavos(2590)}, // zeros além da escala da coluna
+		{"25.0000", Centavos(2500)},
+		{"-0.50", Centavos(-50)},
+		{int64(7), Centavos(700)},
+		{float64(25.9), Centavos(2590)},
+		{nil, 0},
+	}
+	for _, lido := range lidos {
+		var d Dinheiro = 1
+		if err := d.Scan(lido.coluna); err != nil || d != lido.esperado {
+			t.Errorf("Scan(%#v) = %s, %v; esperado %s", lido.coluna, d, err, lido.esperado)
+		}
+	}
+
+	for _, coluna := range []any{"25.999", "abc", true} {
+		var d Dinheiro
+		if err := d.Scan(coluna); !errors.Is(err, ErrDinheiroInvalido) {
+			t.Errorf("Scan(%#v): erro %v, esperado ErrDinheiroInvalido", coluna, err)
+		}
+	}
+}
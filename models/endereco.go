@@ -0,0 +1,101 @@
+package models
+
+import (
+	"slices"
+	"strings"
+)
+
+// EnderecoEntrega é o endereço estruturado usado pelo entregador. O texto completo,
+// montado por Texto, continua gravado em Endereco para os pedidos e cadastros antigos,
+// que só têm o texto livre.
+type EnderecoEntrega struct {
+	CEP         string `gorm:"column:cep;not null;default:''" json:"cep,omitempty" example:"01310100"`
+	Logradouro  string `gorm:"not null;default:''" json:"logradouro,omitempty" example:"Avenida Paulista"`
+	Numero      string `gorm:"not null;default:''" json:"numero,omitempty" example:"1000"`
+	Complemento string `gorm:"not null;default:''" json:"complemento,omitempty" example:"Apto 12"`
+	Bairro      string `gorm:"not null;default:''" json:"bairro,omitempty" example:"Bela Vista"`
+	Cidade      string `gorm:"not null;default:''" json:"cidade,omitempty" example:"São Paulo"`
+	UF          string `gorm:"column:uf;not null;default:''" json:"uf,omitempty" example:"SP"`
+}
+
+// Texto monta o endereço em uma linha, como "Avenida Paulista, 1000 - Apto 12 - Bela Vista, São Paulo/SP, CEP 01310-100"
+func (e EnderecoEntrega) Texto() string {
+	var texto strings.Builder
+	texto.WriteString(e.Logradouro + ", " + e.Numero)
+	if e.Complemento != "" {
+		texto.WriteString(" - " + e.Complemento)
+	}
+	texto.WriteString(" - " + e.Bairro + ", " + e.Cidade + "/" + e.UF)
+	if len(e.CEP) == 8 {
+		texto.WriteString(", CEP " + e.CEP[:5] + "-" + e.CEP[5:])
+	}
+	return texto.String()
+}
+
+// Completo indica se o endereço tem todos os campos obrigatórios para a entrega
+func (e EnderecoEntrega) Completo() bool {
+	return e.CEP != "" && e.Logradouro != "" && e.Numero != "" && e.Bairro != "" && e.Cidade != "" && e.UF != ""
+}
+
+// MesmoLocal compara CEP, número e complemento, que identificam o endereço mesmo com
+// grafias diferentes do logradouro
+func (e EnderecoEntrega) MesmoLocal(outro EnderecoEntrega) bool {
+	return e.CEP == outro.CEP && strings.EqualFold(e.Numero, outro.Numero) && strings.EqualFold(e.Complemento, outro.Complemento)
+}
+
+// EnderecoRequest é o endereço de entrega informado pelo cliente. Com um CEP conhecido,
+// logradouro, bairro, cidade e UF são completados pela consulta de CEP.
+type EnderecoRequest struct {
+	CEP         string `json:"cep" binding:"required,cep" example:"01310-100"`
+	Logradouro  string `json:"logradouro"`
+	Numero      string `json:"numero" binding:"required"`
+	Complemento string `json:"complemento"`
+	Bairro      string `json:"bairro"`
+	Cidade      string `json:"cidade"`
+	UF          string `json:"uf" binding:"omitempty,uf"`
+}
+
+// Endereco converte o pedido em endereço, com o CEP só com dígitos e a UF em maiúsculas
+func (r EnderecoRequest) Endereco() EnderecoEntrega {
+	cep, _ := NormalizarCEP(r.CEP)
+	return EnderecoEntrega{
+		CEP:         cep,
+		Logradouro:  strings.TrimSpace(r.Logradouro),
+		Numero:      strings.TrimSpace(r.Numero),
+		Complemento: strings.TrimSpace(r.Complemento),
+		Bairro:      strings.TrimSpace(r.Bairro),
+		Cidade:      strings.TrimSpace(r.Cidade),
+		UF:          strings.ToUpper(strings.TrimSpace(r.UF)),
+	}
+}
+
+// NormalizarCEP aceita o CEP com ou sem hífen (01310-100 ou 01310100) e retorna só os 8 dígitos
+func NormalizarCEP(cep string) (string, bool) {
+	cep = strings.TrimSpace(cep)
+	if len(cep) == 9 && cep[5] == '-' {
+		cep = cep[:5] + cep[6:]
+	}
+	if len(cep) != 8 || !apenasDigitos(cep) || cep == "00000000" {
+		return "", false
+	}
+	return cep, true
+}
+
+// UFs são as siglas das unidades da federação
+var UFs = []string{
+	"AC", "AL", "AM", "AP", "BA", "CE", "DF", "ES", "GO", "MA", "MG", "MS", "MT", "PA",
+	"PB", "PE", "PI", "PR", "RJ", "RN", "RO", "RR", "RS", "SC", "SE", "SP", "TO",
+}
+
+func UFValida(uf string) bool {
+	return slices.Contains(UFs, strings.ToUpper(uf))
+}
+
+func apenasDigitos(texto string) bool {
+	for _, r := range texto {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return texto != ""
+}
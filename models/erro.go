@@ -0,0 +1,34 @@
+package models
+
+// CodigoErro identifica o tipo de erro de forma estável, para o cliente decidir o que
+// fazer sem depender do texto da mensagem
+type CodigoErro string
+
+const (
+	ErroDadosInvalidos      CodigoErro = "DADOS_INVALIDOS"      // corpo, parâmetro ou linha do pedido recusados
+	ErroNaoEncontrado       CodigoErro = "NAO_ENCONTRADO"       // o recurso da rota não existe
+	ErroJaExiste            CodigoErro = "JA_EXISTE"            // já existe um recurso com o mesmo ID
+	ErroEmUso               CodigoErro = "EM_USO"               // o recurso está em receitas ou pedidos
+	ErroTransicaoInvalida   CodigoErro = "TRANSICAO_INVALIDA"   // o fluxo de status do pedido não permite a mudança
+	ErroConflito            CodigoErro = "CONFLITO"             // a operação não combina com o estado atual
+	ErroEstoqueInsuficiente CodigoErro = "ESTOQUE_INSUFICIENTE" // o saldo não cobre os itens do pedido
+	ErroForaDaArea          CodigoErro = "FORA_DA_AREA"         // nenhuma zona de entrega atende o endereço
+	ErroCupomInvalido       CodigoErro = "CUPOM_INVALIDO"       // o cupom não existe, não vale agora ou não se aplica ao pedido
+	ErroInterno             CodigoErro = "ERRO_INTERNO"
+)
+
+// ErroResponse é o corpo de todas as respostas de erro da API
+type ErroResponse struct {
+	Codigo    CodigoErro    `json:"code" example:"DADOS_INVALIDOS"`
+	Mensagem  string        `json:"message" example:"Dados inválidos"`
+	Detalhes  []ErroDetalhe `json:"details,omitempty"`
+	RequestID string        `json:"request_id" example:"3f0c9a52-8d0e-4c1b-9a57-2a1f0f6c1e7d"`
+}
+
+// ErroDetalhe aponta um campo recusado e, quando houver, o valor enviado e os valores aceitos
+type ErroDetalhe struct {
+	Campo      string   `json:"field" example:"hamburgueres[0].quantidade"`
+	Mensagem   string   `json:"message" example:"deve ser no mínimo 1"`
+	Valor      string   `json:"value,omitempty"`
+	Permitidos []string `json:"allowed,omitempty"`
+}
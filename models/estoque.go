@@ -0,0 +1,150 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UnidadeEstoque é a unidade em que o estoque de um item e as quantidades das
+// receitas são contadas; as quantidades são sempre inteiras
+type UnidadeEstoque string
+
+const (
+	UnidadeUnidade   UnidadeEstoque = "UN"
+	UnidadeGrama     UnidadeEstoque = "G"
+	UnidadeMililitro UnidadeEstoque = "ML"
+)
+
+// UnidadesEstoque são as unidades aceitas no cadastro do estoque
+var UnidadesEstoque = []UnidadeEstoque{UnidadeUnidade, UnidadeGrama, UnidadeMililitro}
+
+// Valida indica se a unidade é uma das unidades conhecidas
+func (u UnidadeEstoque) Valida() bool {
+	switch u {
+	case UnidadeUnidade, UnidadeGrama, UnidadeMililitro:
+		return true
+	}
+	return false
+}
+
+// Estoque é o saldo de um item controlado pelo estoque. Itens sem registro aqui
+// não têm controle e nunca são baixados.
+type Estoque struct {
+	ItemID         uint           `gorm:"primaryKey;autoIncrement:false" json:"item_id"`
+	Quantidade     int            `gorm:"not null;default:0" json:"quantidade"`
+	Unidade        UnidadeEstoque `gorm:"not null;default:'UN'" json:"unidade"`
+	PontoReposicao int            `gorm:"not null;default:0" json:"ponto_reposicao"` // a partir deste saldo o item entra nos alertas
+	AtualizadoEm   time.Time      `gorm:"not null;default:CURRENT_TIMESTAMP" json:"atualizado_em"`
+	Item           Item           `gorm:"foreignKey:ItemID" json:"-"`
+}
+
+func (Estoque) TableName() string {
+	return "estoques"
+}
+
+// AbaixoDoMinimo indica se o saldo chegou ao ponto de reposição
+func (e Estoque) AbaixoDoMinimo() bool {
+	return e.Quantidade <= e.PontoReposicao
+}
+
+type EstoqueResponse struct {
+	ItemID         uint           `json:"item_id"`
+	Descricao      string         `json:"descricao"`
+	Quantidade     int            `json:"quantidade"`
+	Unidade        UnidadeEstoque `json:"unidade"`
+	PontoReposicao int            `json:"ponto_reposicao"`
+	AbaixoDoMinimo bool           `json:"abaixo_do_minimo"`
+	AtualizadoEm   time.Time      `json:"atualizado_em"`
+}
+
+// EstoqueRequest define os parâmetros de estoque de um item e, opcionalmente, o saldo
+// contado; a diferença para o saldo atual é registrada como um AJUSTE
+type EstoqueRequest struct {
+	Quantidade     *int           `json:"quantidade" binding:"omitempty,min=0"`
+	Unidade        UnidadeEstoque `json:"unidade" binding:"required"`
+	PontoReposicao int            `json:"ponto_reposicao" binding:"min=0"`
+	Observacao     string         `json:"observacao"`
+	Responsavel    string         `json:"responsavel"`
+}
+
+// TipoMovimento é o motivo de uma entrada ou saída de estoque
+type TipoMovimento string
+
+const (
+	MovimentoRecebimento TipoMovimento = "RECEBIMENTO" // entrada de mercadoria do fornecedor
+	MovimentoConsumo     TipoMovimento = "CONSUMO"     // baixa pela venda de um pedido
+	MovimentoEstorno     TipoMovimento = "ESTORNO"     // devolução pelo cancelamento ou alteração de um pedido
+	MovimentoPerda       TipoMovimento = "PERDA"       // desperdício, vencimento ou quebra
+	MovimentoAjuste      TipoMovimento = "AJUSTE"      // correção manual ou contagem
+)
+
+// TiposMovimento são os tipos aceitos no filtro do registro de movimentos
+var TiposMovimento = []TipoMovimento{MovimentoRecebimento, MovimentoConsumo, MovimentoEstorno, MovimentoPerda, MovimentoAjuste}
+
+// TiposAjuste são os tipos que podem ser lançados manualmente em /estoque/{codigo}/ajustes
+var TiposAjuste = []TipoMovimento{MovimentoPerda, MovimentoAjuste}
+
+// MovimentoEstoque é uma linha do registro de movimentos, que só recebe inclusões:
+// o saldo atual é a soma de todos os movimentos do item
+type MovimentoEstoque struct {
+	ID          uint          `gorm:"primaryKey" json:"id"`
+	ItemID      uint          `gorm:"not null;index:idx_movimentos_estoque_item_data,priority:1" json:"item_id"`
+	Tipo        TipoMovimento `gorm:"not null" json:"tipo"`
+	Quantidade  int           `gorm:"not null" json:"quantidade"` // positiva nas entradas e negativa nas saídas
+	Saldo       int           `gorm:"not null" json:"saldo"`      // saldo do item logo após o movimento
+	PedidoID    *uuid.UUID    `gorm:"type:uuid;index" json:"pedido_id,omitempty"`
+	Fornecedor  string        `json:"fornecedor,omitempty"`
+	Documento   string        `json:"documento,omitempty"` // nota fiscal ou romaneio do recebimento
+	Observacao  string        `json:"observacao,omitempty"`
+	Responsavel string        `json:"responsavel,omitempty"`
+	Data        time.Time     `gorm:"not null;index:idx_movimentos_estoque_item_data,priority:2" json:"data"`
+}
+
+func (MovimentoEstoque) TableName() string {
+	return "movimentos_estoque"
+}
+
+// RecebimentoRequest registra a entrada de mercadoria entregue por um fornecedor
+type RecebimentoRequest struct {
+	Quantidade  int    `json:"quantidade" binding:"required,gt=0"`
+	Fornecedor  string `json:"fornecedor" binding:"required"`
+	Documento   string `json:"documento"`
+	Observacao  string `json:"observacao"`
+	Responsavel string `json:"responsavel" binding:"required"`
+}
+
+// AjusteEstoqueRequest registra uma perda, sempre com quantidade positiva, ou um
+// ajuste manual, com quantidade negativa para as saídas
+type AjusteEstoqueRequest struct {
+	Tipo        TipoMovimento `json:"tipo" binding:"required"`
+	Quantidade  int           `json:"quantidade" binding:"required"`
+	Observacao  string        `json:"observacao" binding:"required"`
+	Responsavel string        `json:"responsavel" binding:"required"`
+}
+
+// MovimentoFiltro reúne os filtros aceitos na listagem de movimentos de um item
+type MovimentoFiltro struct {
+	Tipo       string     `form:"tipo"`
+	DataInicio *time.Time `form:"data_inicio" time_format:"2006-01-02"`
+	DataFim    *time.Time `form:"data_fim" time_format:"2006-01-02"`
+}
+
+// RelatorioEstoqueFiltro escolhe o dia do relatório de estoque
+type RelatorioEstoqueFiltro struct {
+	Data *time.Time `form:"data" time_format:"2006-01-02"`
+}
+
+// SaldoEstoque é o saldo de um item em um instante, somado a partir dos movimentos
+type SaldoEstoque struct {
+	ItemID     uint           `json:"item_id"`
+	Descricao  string         `json:"descricao"`
+	Unidade    UnidadeEstoque `json:"unidade,omitempty"` // vazia se o item deixou de ter estoque controlado
+	Quantidade int            `json:"quantidade"`
+}
+
+// RelatorioEstoqueResponse é a posição do estoque ao fim do dia informado
+type RelatorioEstoqueResponse struct {
+	Data   string         `json:"data" example:"2024-05-31"`
+	Saldos []SaldoEstoque `json:"saldos"`
+}
@@ -1,5 +1,7 @@
 package models
 
+import "gorm.io/gorm"
+
 type HamburguerIngrediente struct {
 	HamburguerID uint `gorm:"primaryKey"`
 	ItemID       uint `gorm:"primaryKey"`
@@ -10,16 +12,35 @@ type HamburguerIngrediente struct {
 type Hamburguer struct {
 	ID          uint    `gorm:"primaryKey; autoIncrement:false" json:"id"`
 	Descricao   string  `gorm:"not null" json:"descricao" binding:"required"`
-	Preco       float64 `gorm:"not null" json:"preco" binding:"required,gt=0"`
+	Preco       Dinheiro `gorm:"type:numeric(12,2);not null" json:"preco" binding:"required,gt=0"`
 	Ingredientes []Item  `gorm:"many2many:hamburguer_ingredientes;foreignKey:ID;joinForeignKey:hamburguer_id;References:ID;joinReferences:item_id" json:"-"`
 	HamburguerIngredientes []HamburguerIngrediente `gorm:"foreignKey:HamburguerID" json:"ingredientes"`
+	Disponivel  bool     `gorm:"not null;default:true" json:"disponivel"` // marcado manualmente; veja DisponivelParaVenda
+	DeletedAt   gorm.DeletedAt `gorm:"index" json:"removido_em,omitzero" swaggertype:"string" format:"date-time"` // a receita é mantida na remoção lógica
+
+	// Calculados por VerificarDisponibilidade quando a receita é carregada com os itens
+	DisponivelParaVenda *bool  `gorm:"-" json:"disponivel_para_venda,omitempty"`
+	IngredientesEmFalta []uint `gorm:"-" json:"ingredientes_em_falta,omitempty"`
+}
+
+// VerificarDisponibilidade calcula se o hambúrguer pode ser vendido: ele precisa estar
+// disponível e ter todos os ingredientes da receita disponíveis
+func (h *Hamburguer) VerificarDisponibilidade() {
+	h.IngredientesEmFalta = nil
+	for _, ingrediente := range h.HamburguerIngredientes {
+		if !ingrediente.Item.Disponivel {
+			h.IngredientesEmFalta = append(h.IngredientesEmFalta, ingrediente.ItemID)
+		}
+	}
+	paraVenda := h.Disponivel && len(h.IngredientesEmFalta) == 0
+	h.DisponivelParaVenda = &paraVenda
 }
 
 // HamburguerRequest é o modelo para criar um novo hambúrguer
 type HamburguerRequest struct {
 	ID            uint    `json:"id" binding:"required"`
 	Descricao     string  `json:"descricao" binding:"required"`
-	Preco         float64 `json:"preco" binding:"required,gt=0"`
+	Preco         Dinheiro `json:"preco" binding:"required,gt=0"`
 	Ingredientes  []IngredienteRequest `json:"ingredientes" binding:"required,min=1"`
 }
 
@@ -31,6 +52,6 @@ type IngredienteRequest struct {
 // HamburguerUpdateRequest é o modelo para atualizar um hambúrguer existente
 type HamburguerUpdateRequest struct {
 	Descricao    string  `json:"descricao" binding:"required"`
-	Preco        float64 `json:"preco" binding:"required,gt=0"`
+	Preco        Dinheiro `json:"preco" binding:"required,gt=0"`
 	Ingredientes []IngredienteRequest `json:"ingredientes" binding:"required,min=1"`
 }
\ No newline at end of file
@@ -1,5 +1,11 @@
 package models
 
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
 type TipoItem string
 
 const (
@@ -11,8 +17,10 @@ type Item struct {
 	ID        uint    `gorm:"primaryKey;autoIncrement:false" json:"id"`
 	Tipo      TipoItem `gorm:"not null" json:"tipo"`
 	Descricao string  `gorm:"not null" json:"descricao"`
-	Preco     float64 `gorm:"not null" json:"preco"`
+	Preco     Dinheiro `gorm:"type:numeric(12,2);not null" json:"preco"`
 	Extra     bool    `json:"extra"` // true para "Com açúcar" em bebidas ou "Adicional" em ingredientes
+	Disponivel bool   `gorm:"not null;default:true" json:"disponivel"` // false quando o item acabou
+	DeletedAt gorm.DeletedAt `gorm:"index" json:"removido_em,omitzero" swaggertype:"string" format:"date-time"` // preenchido pela remoção lógica
 }
 
 func (Item) TableName() string {
@@ -23,20 +31,27 @@ type ItemResponse struct {
 	ID        uint    `json:"id"`
 	Tipo      string  `json:"tipo"`
 	Descricao string  `json:"descricao"`
-	Preco     float64 `json:"preco"`
+	Preco     Dinheiro `json:"preco"`
 	Extra     bool    `json:"extra"`
+	Disponivel bool   `json:"disponivel"`
+	RemovidoEm *time.Time `json:"removido_em,omitempty"`
 }
 
 type ItemRequest struct {
 	ID        uint    `json:"id" binding:"required"`
 	Tipo      string  `json:"tipo" binding:"required"`
 	Descricao string  `json:"descricao" binding:"required"`
-	Preco     float64 `json:"preco" binding:"required"`
+	Preco     Dinheiro `json:"preco" binding:"required"`
 	Extra     bool    `json:"extra" binding:"required"`
 }
 
 type ItemUpdateRequest struct {
 	Descricao string  `json:"descricao" binding:"required"`
-	Preco     float64 `json:"preco" binding:"required"`
+	Preco     Dinheiro `json:"preco" binding:"required"`
 	Extra     *bool   `json:"extra" binding:"required"`
+}
+
+// DisponibilidadeRequest marca um item ou hambúrguer como disponível ou em falta
+type DisponibilidadeRequest struct {
+	Disponivel *bool `json:"disponivel" binding:"required"`
 }
\ No newline at end of file
@@ -0,0 +1,7 @@
+package models
+
+// Pagina é o envelope das listagens paginadas por cursor
+type Pagina[T any] struct {
+	Dados      []T    `json:"dados"`
+	NextCursor string `json:"next_cursor,omitempty"` // ausente na última página
+}
@@ -3,32 +3,197 @@ package models
 import (
 	"time"
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
+// TipoPedido define como o pedido chega ao cliente, quais dados ele exige e por
+// quais status ele passa
+type TipoPedido string
+
+const (
+	TipoDelivery TipoPedido = "DELIVERY" // entrega no endereço do cliente, com taxa da zona
+	TipoPickup   TipoPedido = "PICKUP"   // retirada no balcão
+	TipoDineIn   TipoPedido = "DINE_IN"  // consumo no local, com o número da mesa
+)
+
+// TiposPedido são todos os tipos de pedido aceitos
+var TiposPedido = []TipoPedido{TipoDelivery, TipoPickup, TipoDineIn}
+
+// Valido indica se o tipo é um dos tipos de pedido conhecidos
+func (t TipoPedido) Valido() bool {
+	_, ok := transicoesStatus[t]
+	return ok
+}
+
+// ComEntrega indica se o pedido é entregue no endereço do cliente
+func (t TipoPedido) ComEntrega() bool {
+	return t == TipoDelivery
+}
+
 type StatusPedido string
 
 const (
-	StatusStarted   StatusPedido = "STARTED"
-	StatusDelivery  StatusPedido = "DELIVERY"
-	StatusFinalized StatusPedido = "FINALIZED"
+	StatusStarted        StatusPedido = "STARTED"
+	StatusDelivery       StatusPedido = "DELIVERY"
+	StatusReadyForPickup StatusPedido = "READY_FOR_PICKUP"
+	StatusServed         StatusPedido = "SERVED"
+	StatusFinalized      StatusPedido = "FINALIZED"
+	StatusCancelled      StatusPedido = "CANCELLED"
+)
+
+// StatusEncerrados são os status de pedidos que não estão mais em aberto
+var StatusEncerrados = []StatusPedido{StatusFinalized, StatusCancelled}
+
+// StatusValidos são todos os status das máquinas de estados dos tipos de pedido
+var StatusValidos = []StatusPedido{StatusStarted, StatusDelivery, StatusReadyForPickup, StatusServed, StatusFinalized, StatusCancelled}
+
+type MotivoCancelamento string
+
+const (
+	MotivoClienteDesistiu     MotivoCancelamento = "CLIENTE_DESISTIU"
+	MotivoItemIndisponivel    MotivoCancelamento = "ITEM_INDISPONIVEL"
+	MotivoEnderecoNaoAtendido MotivoCancelamento = "ENDERECO_NAO_ATENDIDO"
+	MotivoPagamentoRecusado   MotivoCancelamento = "PAGAMENTO_RECUSADO"
+	MotivoAtrasoEntrega       MotivoCancelamento = "ATRASO_ENTREGA"
+	MotivoOutro               MotivoCancelamento = "OUTRO"
+)
+
+// Valido indica se o motivo de cancelamento é um dos códigos conhecidos
+func (m MotivoCancelamento) Valido() bool {
+	switch m {
+	case MotivoClienteDesistiu, MotivoItemIndisponivel, MotivoEnderecoNaoAtendido,
+		MotivoPagamentoRecusado, MotivoAtrasoEntrega, MotivoOutro:
+		return true
+	}
+	return false
+}
+
+// transicoesStatus define, para cada tipo de pedido, para quais status ele pode seguir
+// a partir do status atual. A etapa depois do preparo muda com o tipo: o pedido sai
+// para entrega, fica pronto para retirada ou é servido na mesa.
+var transicoesStatus = map[TipoPedido]map[StatusPedido][]StatusPedido{
+	TipoDelivery: {
+		StatusStarted:   {StatusDelivery, StatusCancelled},
+		StatusDelivery:  {StatusFinalized, StatusCancelled},
+		StatusFinalized: {},
+		StatusCancelled: {},
+	},
+	TipoPickup: {
+		StatusStarted:        {StatusReadyForPickup, StatusCancelled},
+		StatusReadyForPickup: {StatusFinalized, StatusCancelled},
+		StatusFinalized:      {},
+		StatusCancelled:      {},
+	},
+	TipoDineIn: {
+		StatusStarted:   {StatusServed, StatusCancelled},
+		StatusServed:    {StatusFinalized, StatusCancelled},
+		StatusFinalized: {},
+		StatusCancelled: {},
+	},
+}
+
+// Valido indica se o status faz parte da máquina de estados de algum tipo de pedido
+func (s StatusPedido) Valido() bool {
+	for _, valido := range StatusValidos {
+		if s == valido {
+			return true
+		}
+	}
+	return false
+}
+
+// ProximosStatus retorna os status para os quais um pedido do tipo pode seguir a partir do atual
+func (t TipoPedido) ProximosStatus(atual StatusPedido) []StatusPedido {
+	return append([]StatusPedido{}, transicoesStatus[t][atual]...)
+}
+
+// PodeSeguir indica se, nos pedidos do tipo, a transição do status atual para o novo é permitida
+func (t TipoPedido) PodeSeguir(atual, novo StatusPedido) bool {
+	for _, proximo := range transicoesStatus[t][atual] {
+		if proximo == novo {
+			return true
+		}
+	}
+	return false
+}
+
+type AcaoPersonalizacao string
+
+const (
+	AcaoAdicionar AcaoPersonalizacao = "ADICIONAR"
+	AcaoRemover   AcaoPersonalizacao = "REMOVER"
 )
 
+// PedidoHamburguer é uma linha do pedido; o mesmo hambúrguer pode aparecer em
+// várias linhas quando cada uma tem uma personalização diferente
 type PedidoHamburguer struct {
-	PedidoID     uuid.UUID  `gorm:"type:uuid;primaryKey"`
-	HamburguerID uint       `gorm:"primaryKey"`
-	Quantidade   int        `gorm:"not null;default:1"`
-	Hamburguer   Hamburguer `gorm:"foreignKey:HamburguerID"`
+	ID              uint                             `gorm:"primaryKey" json:"id"`
+	PedidoID        uuid.UUID                        `gorm:"type:uuid;not null;index"`
+	HamburguerID    uint                             `gorm:"not null"`
+	Quantidade      int                              `gorm:"not null;default:1"`
+	Descricao       string                           `gorm:"not null;default:''" json:"descricao"`                          // descrição no momento da compra
+	PrecoUnitario   Dinheiro                         `gorm:"type:numeric(12,2);not null;default:0" json:"preco_unitario"` // preço no momento da compra
+	Hamburguer      Hamburguer                       `gorm:"foreignKey:HamburguerID"`
+	Personalizacoes []PedidoHamburguerPersonalizacao `gorm:"foreignKey:PedidoHamburguerID" json:"personalizacoes"`
 }
 
 func (PedidoHamburguer) TableName() string {
 	return "pedido_hamburgueres"
 }
 
+// Subtotal é o valor da linha calculado com os preços gravados na compra, incluindo os adicionais
+func (l PedidoHamburguer) Subtotal() Dinheiro {
+	unitario := l.PrecoUnitario
+	for _, personalizacao := range l.Personalizacoes {
+		if personalizacao.Acao == AcaoAdicionar {
+			unitario += personalizacao.PrecoUnitario.Multiplicar(personalizacao.Quantidade)
+		}
+	}
+	return unitario.Multiplicar(l.Quantidade)
+}
+
+// PedidoHamburguerPersonalizacao é um ingrediente adicionado ou removido de uma linha do pedido
+type PedidoHamburguerPersonalizacao struct {
+	ID                 uint               `gorm:"primaryKey" json:"id"`
+	PedidoHamburguerID uint               `gorm:"not null;index" json:"-"`
+	ItemID             uint               `gorm:"not null" json:"item_id"`
+	Acao               AcaoPersonalizacao `gorm:"not null" json:"acao"`
+	Quantidade         int                `gorm:"not null;default:1" json:"quantidade"`
+	Descricao          string             `gorm:"not null;default:''" json:"descricao"`
+	PrecoUnitario      Dinheiro           `gorm:"type:numeric(12,2);not null;default:0" json:"preco_unitario"` // cobrado por unidade quando adicionado
+	Item               Item               `gorm:"foreignKey:ItemID" json:"item"`
+}
+
+func (PedidoHamburguerPersonalizacao) TableName() string {
+	return "pedido_hamburguer_personalizacoes"
+}
+
 type PedidoBebida struct {
-	PedidoID   uuid.UUID `gorm:"type:uuid;primaryKey"`
-	ItemID     uint      `gorm:"primaryKey"`
-	Quantidade int       `gorm:"not null;default:1"`
-	Bebida     Item      `gorm:"foreignKey:ItemID"`
+	PedidoID      uuid.UUID `gorm:"type:uuid;primaryKey"`
+	ItemID        uint      `gorm:"primaryKey"`
+	Quantidade    int       `gorm:"not null;default:1"`
+	Descricao     string    `gorm:"not null;default:''" json:"descricao"`                          // descrição no momento da compra
+	PrecoUnitario Dinheiro  `gorm:"type:numeric(12,2);not null;default:0" json:"preco_unitario"` // preço no momento da compra
+	Bebida        Item      `gorm:"foreignKey:ItemID"`
+}
+
+// Subtotal é o valor da linha calculado com o preço gravado na compra
+func (l PedidoBebida) Subtotal() Dinheiro {
+	return l.PrecoUnitario.Multiplicar(l.Quantidade)
+}
+
+// PedidoStatusHistorico registra cada mudança de status de um pedido
+type PedidoStatusHistorico struct {
+	ID             uint         `gorm:"primaryKey" json:"id"`
+	PedidoID       uuid.UUID    `gorm:"type:uuid;not null;index" json:"pedido_id"`
+	StatusAnterior StatusPedido `json:"status_anterior,omitempty"`
+	StatusNovo     StatusPedido `gorm:"not null" json:"status_novo"`
+	Ator           string       `gorm:"not null" json:"ator"`
+	Data           time.Time    `gorm:"not null;default:CURRENT_TIMESTAMP" json:"data"`
+}
+
+func (PedidoStatusHistorico) TableName() string {
+	return "pedido_status_historico"
 }
 
 type Pedido struct {
@@ -36,15 +201,40 @@ type Pedido struct {
 	Data         time.Time     `gorm:"not null;default:CURRENT_TIMESTAMP" json:"data"`
 	Descricao    string        `gorm:"not null" json:"descricao" binding:"required"`
 	Status       StatusPedido  `gorm:"not null;default:'STARTED'" json:"status"`
+	Tipo         TipoPedido    `gorm:"not null;default:'DELIVERY'" json:"tipo"`
+	Mesa         int           `gorm:"not null;default:0" json:"mesa,omitempty"` // só nos pedidos DINE_IN
 	Nome         string        `gorm:"not null" json:"nome" binding:"required"`
-	Endereco     string        `gorm:"not null" json:"endereco" binding:"required"`
-	Telefone     string        `gorm:"not null" json:"telefone" binding:"required,len=11"`
-	Hamburgueres []Hamburguer  `gorm:"many2many:pedido_hamburgueres;foreignKey:ID;joinForeignKey:pedido_id;References:ID;joinReferences:hamburguer_id" json:"-"`
+	Endereco     string        `gorm:"not null" json:"endereco"` // endereço de entrega em uma linha; vazio quando o pedido não é entregue
+	EnderecoEntrega
+	Telefone     string        `gorm:"not null;index" json:"telefone" binding:"required,telefone"` // cliente do pedido; nome e endereço são cópias
 	Bebidas      []Item        `gorm:"many2many:pedido_bebidas;foreignKey:ID;joinForeignKey:pedido_id;References:ID;joinReferences:item_id" json:"-"`
 	PedidoHamburgueres []PedidoHamburguer `gorm:"foreignKey:PedidoID" json:"hamburgueres"`
 	PedidoBebidas      []PedidoBebida     `gorm:"foreignKey:PedidoID" json:"bebidas"`
 	Observacoes  string        `json:"observacoes"`
-	ValorTotal   float64       `gorm:"not null" json:"valor_total"`
+	ValorTotal   Dinheiro      `gorm:"type:numeric(12,2);not null" json:"valor_total"` // linhas mais a taxa de entrega, menos os descontos
+	TaxaEntrega  Dinheiro      `gorm:"type:numeric(12,2);not null;default:0" json:"taxa_entrega"`
+	Desconto     Dinheiro      `gorm:"type:numeric(12,2);not null;default:0" json:"desconto"` // soma de Descontos
+	Descontos    []PedidoDesconto `gorm:"foreignKey:PedidoID" json:"descontos"`
+	ZonaEntregaID       *uint              `json:"zona_entrega_id,omitempty"`
+	PrazoEntregaMinutos int                `gorm:"not null;default:0" json:"prazo_entrega_minutos,omitempty"`
+	CanceladoPor        string             `json:"cancelado_por,omitempty"`
+	CanceladoEm         *time.Time         `json:"cancelado_em,omitempty"`
+	MotivoCancelamento  MotivoCancelamento `json:"motivo_cancelamento,omitempty"`
+	ReembolsoNecessario bool               `gorm:"not null;default:false" json:"reembolso_necessario"`
+	ValorReembolso      Dinheiro           `gorm:"type:numeric(12,2);not null;default:0" json:"valor_reembolso"`
+	ReembolsadoEm       *time.Time         `json:"reembolsado_em,omitempty"`
+	Historico           []PedidoStatusHistorico `gorm:"foreignKey:PedidoID" json:"historico,omitempty"`
+	DeletedAt           gorm.DeletedAt     `gorm:"index" json:"removido_em,omitzero" swaggertype:"string" format:"date-time"` // linhas e histórico são mantidos na remoção lógica
+}
+
+// Cupom retorna o cupom aplicado ao pedido, ou vazio se nenhum foi usado
+func (p Pedido) Cupom() string {
+	for _, desconto := range p.Descontos {
+		if desconto.Cupom != "" {
+			return desconto.Cupom
+		}
+	}
+	return ""
 }
 
 type PedidoResponse struct {
@@ -52,23 +242,57 @@ type PedidoResponse struct {
 	Data         time.Time          `json:"data"`
 	Descricao    string            `json:"descricao"`
 	Status       StatusPedido       `json:"status"`
+	Tipo         TipoPedido         `json:"tipo"`
+	Mesa         int                `json:"mesa,omitempty"`
 	Nome         string            `json:"nome"`
 	Endereco     string            `json:"endereco"`
+	EnderecoEntrega
 	Telefone     string            `json:"telefone"`
 	Hamburgueres []PedidoHamburguer `json:"hamburgueres"`
 	Bebidas      []PedidoBebida     `json:"bebidas"`
 	Observacoes  string            `json:"observacoes"`
-	ValorTotal   float64           `json:"valor_total"`
+	ValorTotal   Dinheiro          `json:"valor_total"`
+	TaxaEntrega  Dinheiro          `json:"taxa_entrega"`
+	Desconto     Dinheiro          `json:"desconto"`
+	Descontos    []PedidoDesconto  `json:"descontos"`
+	ZonaEntregaID       *uint              `json:"zona_entrega_id,omitempty"`
+	PrazoEntregaMinutos int                `json:"prazo_entrega_minutos,omitempty"`
+	CanceladoPor        string             `json:"cancelado_por,omitempty"`
+	CanceladoEm         *time.Time         `json:"cancelado_em,omitempty"`
+	MotivoCancelamento  MotivoCancelamento `json:"motivo_cancelamento,omitempty"`
+	ReembolsoNecessario bool               `json:"reembolso_necessario"`
+	ValorReembolso      Dinheiro           `json:"valor_reembolso"`
+	ReembolsadoEm       *time.Time         `json:"reembolsado_em,omitempty"`
+	Historico           []PedidoStatusHistorico `json:"historico,omitempty"`
+	RemovidoEm          *time.Time         `json:"removido_em,omitempty"`
 }
 
+// PedidoHistoricoResponse é uma etapa da linha do tempo de status do pedido
+type PedidoHistoricoResponse struct {
+	StatusAnterior  StatusPedido `json:"status_anterior,omitempty"`
+	StatusNovo      StatusPedido `json:"status_novo"`
+	Ator            string       `json:"ator"`
+	Data            time.Time    `json:"data"`
+	DuracaoSegundos *int64       `json:"duracao_segundos,omitempty"` // tempo que o pedido permaneceu em StatusNovo
+}
+
+// PedidoRequest identifica o cliente pelo telefone. Nome e endereço são obrigatórios só
+// no primeiro pedido; depois, o endereço pode ser um dos salvos (endereco_id) ou um
+// novo, que é adicionado ao cadastro, e sem nenhum dos dois vale o principal. Pedidos
+// PICKUP e DINE_IN não têm endereço, e os DINE_IN exigem a mesa. As promoções
+// automáticas são aplicadas sempre; as de cupom, só com o código em cupom.
 type PedidoRequest struct {
 	Descricao      string         `json:"descricao" binding:"required"`
-	Nome           string         `json:"nome" binding:"required"`
-	Endereco       string         `json:"endereco" binding:"required"`
-	Telefone       string         `json:"telefone" binding:"required,len=11"`
-	Hamburgueres   []PedidoItemRequest `json:"hamburgueres" binding:"required,min=1"`
+	Tipo           TipoPedido     `json:"tipo" example:"DELIVERY"` // DELIVERY quando omitido
+	Mesa           int            `json:"mesa" binding:"omitempty,min=1"`
+	Nome           string         `json:"nome"`
+	Endereco       *EnderecoRequest `json:"endereco"`
+	EnderecoID     uint           `json:"endereco_id"`
+	Telefone       string         `json:"telefone" binding:"required,telefone" example:"11987654321"`
+	Hamburgueres   []PedidoHamburguerRequest `json:"hamburgueres" binding:"required,min=1,dive"`
 	Bebidas        []PedidoItemRequest `json:"bebidas"`
 	Observacoes    string         `json:"observacoes"`
+	Cupom          string         `json:"cupom" example:"BEMVINDO10"`
 }
 
 type PedidoItemRequest struct {
@@ -76,13 +300,113 @@ type PedidoItemRequest struct {
 	Quantidade int  `json:"quantidade" binding:"required,min=1"`
 }
 
+// PedidoHamburguerRequest é uma linha de hambúrguer do pedido com sua personalização
+type PedidoHamburguerRequest struct {
+	ID         uint                 `json:"id" binding:"required"`
+	Quantidade int                  `json:"quantidade" binding:"required,min=1"`
+	Adicionar  []IngredienteRequest `json:"adicionar" binding:"dive"` // ingredientes extras por unidade
+	Remover    []uint               `json:"remover"`                  // IDs de ingredientes da receita a retirar
+}
+
 type PedidoUpdateRequest struct {
 	Descricao      string             `json:"descricao"`
-	Status         StatusPedido       `json:"status"`
+	Tipo           TipoPedido         `json:"tipo"` // só pode mudar enquanto o pedido está em STARTED
+	Mesa           int                `json:"mesa" binding:"omitempty,min=1"`
 	Nome           string             `json:"nome"`
-	Endereco       string             `json:"endereco"`
-	Telefone       string             `json:"telefone"`
-	Hamburgueres   []PedidoItemRequest `json:"hamburgueres"`
+	Endereco       *EnderecoRequest   `json:"endereco"`
+	EnderecoID     uint               `json:"endereco_id"`
+	Telefone       string             `json:"telefone" binding:"omitempty,telefone"`
+	Hamburgueres   []PedidoHamburguerRequest `json:"hamburgueres" binding:"dive"`
 	Bebidas        []PedidoItemRequest `json:"bebidas"`
 	Observacoes    string             `json:"observacoes"`
+	Cupom          string             `json:"cupom"` // troca o cupom aplicado e recalcula os descontos
+}
+
+// PedidoCotacaoResponse é o pedido precificado linha a linha, sem ter sido gravado
+type PedidoCotacaoResponse struct {
+	Hamburgueres []CotacaoHamburguer `json:"hamburgueres"`
+	Bebidas      []CotacaoBebida     `json:"bebidas"`
+	Subtotal     Dinheiro            `json:"subtotal"`
+	TaxaEntrega  Dinheiro            `json:"taxa_entrega"`
+	Desconto     Dinheiro            `json:"desconto"`
+	Descontos    []CotacaoDesconto   `json:"descontos"`
+	ValorTotal   Dinheiro            `json:"valor_total"`
+	Entrega      *CotacaoEntrega     `json:"entrega,omitempty"` // ausente quando a cotação não informa o endereço
+}
+
+// CotacaoDesconto é uma promoção que se aplica ao pedido cotado, com o valor abatido
+type CotacaoDesconto struct {
+	PromocaoID uint     `json:"promocao_id"`
+	Descricao  string   `json:"descricao"`
+	Cupom      string   `json:"cupom,omitempty"`
+	Valor      Dinheiro `json:"valor"`
+}
+
+// CotacaoEntrega é a zona que atende o endereço da cotação, com a taxa e o prazo
+type CotacaoEntrega struct {
+	ZonaID       uint     `json:"zona_id"`
+	Zona         string   `json:"zona"`
+	Taxa         Dinheiro `json:"taxa"`
+	PedidoMinimo Dinheiro `json:"pedido_minimo"`
+	PrazoMinutos int      `json:"prazo_minutos"`
+}
+
+// CotacaoHamburguer é uma linha de hambúrguer cotada; o subtotal já inclui os adicionais
+type CotacaoHamburguer struct {
+	HamburguerID  uint               `json:"hamburguer_id"`
+	Descricao     string             `json:"descricao"`
+	Quantidade    int                `json:"quantidade"`
+	PrecoUnitario Dinheiro           `json:"preco_unitario"`
+	Adicionais    []CotacaoAdicional `json:"adicionais"`
+	Removidos     []CotacaoRemovido  `json:"removidos"`
+	Subtotal      Dinheiro           `json:"subtotal"`
+}
+
+// CotacaoAdicional é um ingrediente extra cobrado em cada unidade da linha
+type CotacaoAdicional struct {
+	ItemID        uint     `json:"item_id"`
+	Descricao     string   `json:"descricao"`
+	Quantidade    int      `json:"quantidade"`
+	PrecoUnitario Dinheiro `json:"preco_unitario"`
+}
+
+// CotacaoRemovido é um ingrediente retirado da receita, sem alteração no preço
+type CotacaoRemovido struct {
+	ItemID    uint   `json:"item_id"`
+	Descricao string `json:"descricao"`
+}
+
+// CotacaoBebida é uma linha de bebida cotada
+type CotacaoBebida struct {
+	ItemID        uint     `json:"item_id"`
+	Descricao     string   `json:"descricao"`
+	Quantidade    int      `json:"quantidade"`
+	PrecoUnitario Dinheiro `json:"preco_unitario"`
+	Subtotal      Dinheiro `json:"subtotal"`
+}
+
+// PedidoStatusRequest é o modelo para mudar o status de um pedido
+type PedidoStatusRequest struct {
+	Status StatusPedido `json:"status" binding:"required"`
+	Ator   string       `json:"ator" binding:"required"`
+}
+
+// PedidoCancelamentoRequest é o modelo para cancelar um pedido
+type PedidoCancelamentoRequest struct {
+	CanceladoPor string             `json:"cancelado_por" binding:"required"`
+	Motivo       MotivoCancelamento `json:"motivo" binding:"required"`
+	Reembolsar   bool               `json:"reembolsar"`
+}
+
+// PedidoFiltro reúne os filtros e a ordenação aceitos na listagem de pedidos
+type PedidoFiltro struct {
+	Status     []string   `form:"status"`
+	Tipo       string     `form:"tipo"`
+	Abertos    bool       `form:"abertos"`
+	DataInicio *time.Time `form:"data_inicio" time_format:"2006-01-02"`
+	DataFim    *time.Time `form:"data_fim" time_format:"2006-01-02"`
+	Telefone   string     `form:"telefone"`
+	Nome       string     `form:"nome"`
+	Ordem      string     `form:"ordem"`
+	Direcao    string     `form:"direcao"`
 }
@@ -0,0 +1,59 @@
+package models
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestTransicoesDeStatusPorTipo(t *testing.T) {
+	// Tudo o que não está na tabela é recusado, inclusive ficar no mesmo status
+	permitidas := map[TipoPedido]map[StatusPedido][]StatusPedido{
+		TipoDelivery: {
+			StatusStarted:  {StatusDelivery, StatusCancelled},
+			StatusDelivery: {StatusFinalized, StatusCancelled},
+		},
+		TipoPickup: {
+			StatusStarted:        {StatusReadyForPickup, StatusCancelled},
+			StatusReadyForPickup: {StatusFinalized, StatusCancelled},
+		},
+		TipoDineIn: {
+			StatusStarted: {StatusServed, StatusCancelled},
+			StatusServed:  {StatusFinalized, StatusCancelled},
+		},
+	}
+
+	for _, tipo := range TiposPedido {
+		for _, atual := range StatusValidos {
+			esperados := permitidas[tipo][atual]
+			if proximos := tipo.ProximosStatus(atual); !slices.Equal(proximos, esperados) {
+				t.Errorf("%s.ProximosStatus(%s) = %v, esperado %v", tipo, atual, proximos, esperados)
+			}
+
+			for _, novo := range StatusValidos {
+				esperado := slices.Contains(esperados, novo)
+				if pode := tipo.PodeSeguir(atual, novo); pode != esperado {
+					t.Errorf("%s.PodeSeguir(%s, %s) = %v, esperado %v", tipo, atual, novo, pode, esperado)
+				}
+			}
+		}
+	}
+}
+
+func TestTransicoesDeStatusDesconhecidos(t *testing.T) {
+	if proximos := TipoPedido("DRIVE_THRU").ProximosStatus(StatusStarted); len(proximos) != 0 {
+		t.Errorf("tipo desconhecido: ProximosStatus = %v, esperado nenhum", proximos)
+	}
+	if TipoPedido("DRIVE_THRU").PodeSeguir(StatusStarted, StatusCancelled) {
+		t.Error("tipo desconhecido: PodeSeguir(STARTED, CANCELLED) = true")
+	}
+	if TipoPickup.PodeSeguir("PAUSED", StatusFinalized) || TipoPickup.PodeSeguir(StatusStarted, "PAUSED") {
+		t.Error("status desconhecido aceito na transição")
+	}
+
+	// O chamador recebe uma cópia e não altera a máquina de estados
+	proximos := TipoDelivery.ProximosStatus(StatusStarted)
+	proximos[0] = StatusFinalized
+	if TipoDelivery.PodeSeguir(StatusStarted, StatusFinalized) {
+		t.Error("alterar o retorno de ProximosStatus mudou as transições")
+	}
+}
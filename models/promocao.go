@@ -0,0 +1,205 @@
+package models
+
+import (
+	"cmp"
+	"slices"
+	"time"
+	_ "time/tzdata" // o fuso da lanchonete não depende do zoneinfo do servidor
+
+	"github.com/google/uuid"
+)
+
+// TipoPromocao define como a promoção calcula o desconto sobre as linhas do pedido
+type TipoPromocao string
+
+const (
+	PromocaoPercentual TipoPromocao = "PERCENTUAL" // percentual sobre o subtotal
+	PromocaoValorFixo  TipoPromocao = "VALOR_FIXO" // valor abatido do subtotal
+	PromocaoLeveGanhe  TipoPromocao = "LEVE_GANHE" // uma bebida grátis a cada Quantidade do hambúrguer
+	PromocaoCombo      TipoPromocao = "COMBO"      // hambúrguer e bebida juntos pelo preço do combo
+)
+
+// FusoHorario é o fuso da lanchonete, em que valem as janelas de happy hour,
+// qualquer que seja o fuso do servidor
+var FusoHorario = carregarFuso("America/Sao_Paulo")
+
+func carregarFuso(nome string) *time.Location {
+	fuso, err := time.LoadLocation(nome)
+	if err != nil {
+		panic(err)
+	}
+	return fuso
+}
+
+// TiposPromocao são todos os tipos de promoção aceitos
+var TiposPromocao = []TipoPromocao{PromocaoPercentual, PromocaoValorFixo, PromocaoLeveGanhe, PromocaoCombo}
+
+// Valido indica se o tipo é um dos tipos de promoção conhecidos
+func (t TipoPromocao) Valido() bool {
+	return slices.Contains(TiposPromocao, t)
+}
+
+// Promocao é uma regra de desconto. Com cupom, só vale nos pedidos que informam o
+// código; sem cupom, é aplicada automaticamente a todo pedido que cumpre a regra.
+type Promocao struct {
+	ID               uint         `gorm:"primaryKey" json:"id"`
+	Nome             string       `gorm:"not null;uniqueIndex" json:"nome" example:"Happy hour"`
+	Tipo             TipoPromocao `gorm:"not null" json:"tipo" example:"PERCENTUAL"`
+	Cupom            string       `gorm:"not null;default:''" json:"cupom,omitempty" example:"BEMVINDO10"`
+	Percentual       int          `gorm:"not null;default:0" json:"percentual,omitempty"`
+	Valor            Dinheiro     `gorm:"type:numeric(12,2);not null;default:0" json:"valor"` // desconto do VALOR_FIXO ou preço do COMBO
+	HamburguerID     *uint        `json:"hamburguer_id,omitempty"`
+	BebidaID         *uint        `json:"bebida_id,omitempty"`                            // bebida do COMBO ou brinde do LEVE_GANHE
+	Quantidade       int          `gorm:"not null;default:0" json:"quantidade,omitempty"` // hambúrgueres por brinde no LEVE_GANHE
+	PedidoMinimo     Dinheiro     `gorm:"type:numeric(12,2);not null;default:0" json:"pedido_minimo"`
+	ValidoDe         *time.Time   `json:"valido_de,omitempty"`
+	ValidoAte        *time.Time   `json:"valido_ate,omitempty"`
+	HoraInicio       string       `gorm:"not null;default:''" json:"hora_inicio,omitempty" example:"17:00"` // janela diária do happy hour
+	HoraFim          string       `gorm:"not null;default:''" json:"hora_fim,omitempty" example:"19:00"`
+	LimiteUsos       int          `gorm:"not null;default:0" json:"limite_usos"`        // pedidos que podem usar a promoção; 0 é sem limite
+	LimitePorCliente int          `gorm:"not null;default:0" json:"limite_por_cliente"` // pedidos por telefone; 0 é sem limite
+	Ativa            bool         `gorm:"not null;default:true" json:"ativa"`
+	AtualizadoEm     time.Time    `gorm:"not null;default:CURRENT_TIMESTAMP" json:"atualizado_em"`
+}
+
+func (Promocao) TableName() string {
+	return "promocoes"
+}
+
+// Automatica indica se a promoção vale sem cupom
+func (p Promocao) Automatica() bool {
+	return p.Cupom == ""
+}
+
+// Limitada indica se a promoção tem limite de usos, no total ou por cliente
+func (p Promocao) Limitada() bool {
+	return p.LimiteUsos > 0 || p.LimitePorCliente > 0
+}
+
+// VigenteEm indica se a promoção está ativa no instante: dentro das datas de validade e,
+// com happy hour, dentro da janela de horário no FusoHorario, que pode passar da meia-noite
+func (p Promocao) VigenteEm(instante time.Time) bool {
+	if !p.Ativa {
+		return false
+	}
+	if p.ValidoDe != nil && instante.Before(*p.ValidoDe) {
+		return false
+	}
+	if p.ValidoAte != nil && instante.After(*p.ValidoAte) {
+		return false
+	}
+	if p.HoraInicio == "" {
+		return true
+	}
+
+	hora := instante.In(FusoHorario).Format("15:04")
+	if p.HoraInicio < p.HoraFim {
+		return hora >= p.HoraInicio && hora < p.HoraFim
+	}
+	return hora >= p.HoraInicio || hora < p.HoraFim
+}
+
+// Desconto calcula quanto a promoção abate das linhas do pedido, com os preços gravados
+// nelas; é zero quando o pedido não cumpre a regra ou não alcança o pedido mínimo
+func (p Promocao) Desconto(hamburgueres []PedidoHamburguer, bebidas []PedidoBebida) Dinheiro {
+	var subtotal Dinheiro
+	for _, linha := range hamburgueres {
+		subtotal += linha.Subtotal()
+	}
+	for _, linha := range bebidas {
+		subtotal += linha.Subtotal()
+	}
+	if subtotal == 0 || subtotal < p.PedidoMinimo {
+		return 0
+	}
+
+	switch p.Tipo {
+	case PromocaoPercentual:
+		return subtotal.Porcentagem(p.Percentual)
+	case PromocaoValorFixo:
+		return min(p.Valor, subtotal)
+	case PromocaoLeveGanhe:
+		if p.HamburguerID == nil || p.Quantidade <= 0 {
+			return 0
+		}
+		quantidade, _ := unidadesHamburguer(hamburgueres, *p.HamburguerID)
+		return bebidasGratis(bebidas, p.BebidaID, quantidade/p.Quantidade)
+	case PromocaoCombo:
+		if p.HamburguerID == nil || p.BebidaID == nil {
+			return 0
+		}
+		quantidade, preco := unidadesHamburguer(hamburgueres, *p.HamburguerID)
+		for _, linha := range bebidas {
+			if linha.ItemID == *p.BebidaID {
+				economia := preco + linha.PrecoUnitario - p.Valor
+				return max(economia, 0).Multiplicar(min(quantidade, linha.Quantidade))
+			}
+		}
+	}
+	return 0
+}
+
+// unidadesHamburguer soma as unidades do hambúrguer em todas as linhas, com personalizações
+// diferentes ou não, e retorna o preço unitário da receita, sem os adicionais
+func unidadesHamburguer(linhas []PedidoHamburguer, hamburguerID uint) (int, Dinheiro) {
+	quantidade, preco := 0, Dinheiro(0)
+	for _, linha := range linhas {
+		if linha.HamburguerID == hamburguerID {
+			quantidade += linha.Quantidade
+			preco = linha.PrecoUnitario
+		}
+	}
+	return quantidade, preco
+}
+
+// bebidasGratis soma o preço de até brindes unidades de bebida, das mais baratas para as
+// mais caras; com bebidaID, só essa bebida é brinde
+func bebidasGratis(linhas []PedidoBebida, bebidaID *uint, brindes int) Dinheiro {
+	elegiveis := slices.DeleteFunc(slices.Clone(linhas), func(linha PedidoBebida) bool {
+		return bebidaID != nil && linha.ItemID != *bebidaID
+	})
+	slices.SortFunc(elegiveis, func(a, b PedidoBebida) int { return cmp.Compare(a.PrecoUnitario, b.PrecoUnitario) })
+
+	var total Dinheiro
+	for _, linha := range elegiveis {
+		unidades := min(linha.Quantidade, brindes)
+		total += linha.PrecoUnitario.Multiplicar(unidades)
+		brindes -= unidades
+	}
+	return total
+}
+
+// PedidoDesconto é uma promoção aplicada ao pedido, com o nome e o valor abatido no momento da compra
+type PedidoDesconto struct {
+	ID         uint      `gorm:"primaryKey" json:"id"`
+	PedidoID   uuid.UUID `gorm:"type:uuid;not null;index" json:"-"`
+	PromocaoID *uint     `json:"promocao_id,omitempty"` // nulo depois que a promoção é apagada
+	Descricao  string    `gorm:"not null" json:"descricao"`
+	Cupom      string    `gorm:"not null;default:''" json:"cupom,omitempty"`
+	Valor      Dinheiro  `gorm:"type:numeric(12,2);not null" json:"valor"`
+}
+
+func (PedidoDesconto) TableName() string {
+	return "pedido_descontos"
+}
+
+// PromocaoRequest é o modelo para criar ou substituir uma promoção. Os campos que não
+// se aplicam ao tipo são ignorados.
+type PromocaoRequest struct {
+	Nome             string       `json:"nome" binding:"required"`
+	Tipo             TipoPromocao `json:"tipo" binding:"required" example:"PERCENTUAL"`
+	Cupom            string       `json:"cupom" binding:"omitempty,alphanum,max=30" example:"BEMVINDO10"` // vazio para aplicar a todos os pedidos
+	Percentual       int          `json:"percentual" binding:"omitempty,min=1,max=100"`
+	Valor            Dinheiro     `json:"valor" binding:"min=0"`
+	HamburguerID     *uint        `json:"hamburguer_id"`
+	BebidaID         *uint        `json:"bebida_id"`
+	Quantidade       int          `json:"quantidade" binding:"omitempty,min=1"`
+	PedidoMinimo     Dinheiro     `json:"pedido_minimo" binding:"min=0"`
+	ValidoDe         *time.Time   `json:"valido_de"`
+	ValidoAte        *time.Time   `json:"valido_ate"`
+	HoraInicio       string       `json:"hora_inicio" example:"17:00"`
+	HoraFim          string       `json:"hora_fim" example:"19:00"`
+	LimiteUsos       int          `json:"limite_usos" binding:"min=0"`
+	LimitePorCliente int          `json:"limite_por_cliente" binding:"min=0"`
+	Ativa            *bool        `json:"ativa"` // padrão true
+}
@@ -0,0 +1,107 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+// horario monta o instante no fuso da lanchonete
+func horario(hora, minuto int) time.Time {
+	return time.Date(2026, time.March, 10, hora, minuto, 0, 0, FusoHorario)
+}
+
+func TestPromocaoVigenteEm(t *testing.T) {
+	inicio := horario(0, 0)
+	fim := horario(23, 59).AddDate(0, 0, 5)
+	happyHour := Promocao{Ativa: true, HoraInicio: "17:00", HoraFim: "19:00"}
+	madrugada := Promocao{Ativa: true, HoraInicio: "22:00", HoraFim: "02:00"}
+
+	casos := []struct {
+		nome     string
+		promocao Promocao
+		instante time.Time
+		vigente  bool
+	}{
+		{"sem janela", Promocao{Ativa: true}, horario(3, 0), true},
+		{"inativa", Promocao{}, horario(12, 0), false},
+		{"antes da validade", Promocao{Ativa: true, ValidoDe: &inicio}, inicio.Add(-time.Second), false},
+		{"início da validade", Promocao{Ativa: true, ValidoDe: &inicio}, inicio, true},
+		{"fim da validade", Promocao{Ativa: true, ValidoAte: &fim}, fim, true},
+		{"depois da validade", Promocao{Ativa: true, ValidoAte: &fim}, fim.Add(time.Second), false},
+
+		{"antes do happy hour", happyHour, horario(16, 59), false},
+		{"início do happy hour", happyHour, horario(17, 0), true},
+		{"último minuto do happy hour", happyHour, horario(18, 59), true},
+		{"fim do happy hour", happyHour, horario(19, 0), false},
+
+		{"antes da janela noturna", madrugada, horario(21, 59), false},
+		{"início da janela noturna", madrugada, horario(22, 0), true},
+		{"antes da meia-noite", madrugada, horario(23, 59), true},
+		{"meia-noite", madrugada, horario(0, 0), true},
+		{"último minuto da janela noturna", madrugada, horario(1, 59), true},
+		{"fim da janela noturna", madrugada, horario(2, 0), false},
+		{"meio-dia fora da janela noturna", madrugada, horario(12, 0), false},
+
+		// A janela vale no horário da lanchonete, qualquer que seja o fuso do instante
+		{"17:30 em Brasília informado em UTC", happyHour, time.Date(2026, time.March, 10, 20, 30, 0, 0, time.UTC), true},
+		{"17:30 em UTC", happyHour, time.Date(2026, time.March, 10, 17, 30, 0, 0, time.UTC), false},
+	}
+
+	for _, caso := range casos {
+		t.Run(caso.nome, func(t *testing.T) {
+			if vigente := caso.promocao.VigenteEm(caso.instante); vigente != caso.vigente {
+				t.Errorf("VigenteEm(%s) = %v, esperado %v", caso.instante, vigente, caso.vigente)
+			}
+		})
+	}
+}
+
+func TestPromocaoDesconto(t *testing.T) {
+	hamburguerID, refrigeranteID, sucoID := uint(1), uint(10), uint(11)
+	hamburgueres := func(quantidade int) []PedidoHamburguer {
+		return []PedidoHamburguer{{HamburguerID: hamburguerID, Quantidade: quantidade, PrecoUnitario: Centavos(2590)}}
+	}
+	bebidas := func(refrigerantes, sucos int) []PedidoBebida {
+		return []PedidoBebida{
+			{ItemID: refrigeranteID, Quantidade: refrigerantes, PrecoUnitario: Centavos(600)},
+			{ItemID: sucoID, Quantidade: sucos, PrecoUnitario: Centavos(900)},
+		}
+	}
+
+	casos := []struct {
+		nome         string
+		promocao     Promocao
+		hamburgueres []PedidoHamburguer
+		bebidas      []PedidoBebida
+		desconto     Dinheiro
+	}{
+		{"pedido vazio", Promocao{Tipo: PromocaoValorFixo, Valor: Centavos(500)}, nil, nil, 0},
+		{"percentual arredondado para baixo", Promocao{Tipo: PromocaoPercentual, Percentual: 15}, hamburgueres(1), nil, Centavos(388)},
+		{"valor fixo", Promocao{Tipo: PromocaoValorFixo, Valor: Centavos(500)}, hamburgueres(1), nil, Centavos(500)},
+		{"valor fixo limitado ao subtotal", Promocao{Tipo: PromocaoValorFixo, Valor: Centavos(5000)}, hamburgueres(1), nil, Centavos(2590)},
+
+		// O pedido mínimo é comparado com o subtotal das linhas, de 3190 aqui
+		{"abaixo do pedido mínimo", Promocao{Tipo: PromocaoValorFixo, Valor: Centavos(500), PedidoMinimo: Centavos(3191)}, hamburgueres(1), bebidas(1, 0), 0},
+		{"no pedido mínimo", Promocao{Tipo: PromocaoValorFixo, Valor: Centavos(500), PedidoMinimo: Centavos(3190)}, hamburgueres(1), bebidas(1, 0), Centavos(500)},
+
+		{"leve 3 ganhe a bebida mais barata", Promocao{Tipo: PromocaoLeveGanhe, HamburguerID: &hamburguerID, Quantidade: 3}, hamburgueres(3), bebidas(1, 1), Centavos(600)},
+		{"leve 3 com 7 hambúrgueres", Promocao{Tipo: PromocaoLeveGanhe, HamburguerID: &hamburguerID, Quantidade: 3}, hamburgueres(7), bebidas(1, 2), Centavos(1500)},
+		{"leve 3 sem hambúrgueres suficientes", Promocao{Tipo: PromocaoLeveGanhe, HamburguerID: &hamburguerID, Quantidade: 3}, hamburgueres(2), bebidas(1, 1), 0},
+		{"leve 3 ganhe um suco", Promocao{Tipo: PromocaoLeveGanhe, HamburguerID: &hamburguerID, BebidaID: &sucoID, Quantidade: 3}, hamburgueres(3), bebidas(1, 1), Centavos(900)},
+		{"leve 3 ganhe um suco sem suco no pedido", Promocao{Tipo: PromocaoLeveGanhe, HamburguerID: &hamburguerID, BebidaID: &sucoID, Quantidade: 3}, hamburgueres(3), bebidas(2, 0), 0},
+		{"leve 3 sem hambúrguer configurado", Promocao{Tipo: PromocaoLeveGanhe, Quantidade: 3}, hamburgueres(3), bebidas(1, 0), 0},
+
+		{"combo", Promocao{Tipo: PromocaoCombo, HamburguerID: &hamburguerID, BebidaID: &refrigeranteID, Valor: Centavos(2900)}, hamburgueres(1), bebidas(1, 0), Centavos(290)},
+		{"combo limitado às bebidas", Promocao{Tipo: PromocaoCombo, HamburguerID: &hamburguerID, BebidaID: &refrigeranteID, Valor: Centavos(2900)}, hamburgueres(3), bebidas(2, 0), Centavos(580)},
+		{"combo sem a bebida", Promocao{Tipo: PromocaoCombo, HamburguerID: &hamburguerID, BebidaID: &refrigeranteID, Valor: Centavos(2900)}, hamburgueres(1), bebidas(0, 1)[1:], 0},
+		{"combo mais caro que as linhas", Promocao{Tipo: PromocaoCombo, HamburguerID: &hamburguerID, BebidaID: &refrigeranteID, Valor: Centavos(4000)}, hamburgueres(1), bebidas(1, 0), 0},
+	}
+
+	for _, caso := range casos {
+		t.Run(caso.nome, func(t *testing.T) {
+			if desconto := caso.promocao.Desconto(caso.hamburgueres, caso.bebidas); desconto != caso.desconto {
+				t.Errorf("Desconto = %s, esperado %s", desconto, caso.desconto)
+			}
+		})
+	}
+}
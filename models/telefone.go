@@ -0,0 +1,29 @@
+package models
+
+// dddsValidos são os códigos de área em uso no Brasil
+var dddsValidos = map[string]bool{
+	"11": true, "12": true, "13": true, "14": true, "15": true, "16": true, "17": true, "18": true, "19": true,
+	"21": true, "22": true, "24": true, "27": true, "28": true,
+	"31": true, "32": true, "33": true, "34": true, "35": true, "37": true, "38": true,
+	"41": true, "42": true, "43": true, "44": true, "45": true, "46": true, "47": true, "48": true, "49": true,
+	"51": true, "53": true, "54": true, "55": true,
+	"61": true, "62": true, "63": true, "64": true, "65": true, "66": true, "67": true, "68": true, "69": true,
+	"71": true, "73": true, "74": true, "75": true, "77": true, "79": true,
+	"81": true, "82": true, "83": true, "84": true, "85": true, "86": true, "87": true, "88": true, "89": true,
+	"91": true, "92": true, "93": true, "94": true, "95": true, "96": true, "97": true, "98": true, "99": true,
+}
+
+// TelefoneValido aceita, só com dígitos e com um DDD válido, celulares com o nono
+// dígito (DDD + 9XXXX-XXXX) e fixos (DDD + [2-5]XXX-XXXX)
+func TelefoneValido(telefone string) bool {
+	if !apenasDigitos(telefone) || !dddsValidos[telefone[:min(2, len(telefone))]] {
+		return false
+	}
+	switch len(telefone) {
+	case 11:
+		return telefone[2] == '9'
+	case 10:
+		return telefone[2] >= '2' && telefone[2] <= '5'
+	}
+	return false
+}
@@ -0,0 +1,55 @@
+package models
+
+import "testing"
+
+func TestTelefoneValido(t *testing.T) {
+	casos := []struct {
+		telefone string
+		valido   bool
+	}{
+		// Celulares: DDD e 9 dígitos começando por 9
+		{"11987654321", true},
+		{"21987654321", true},
+		{"99912345678", true},
+		{"11887654321", false}, // 11 dígitos sem o nono dígito
+		{"1198765432", false},  // celular sem um dígito vira fixo começando por 9
+
+		// Fixos: DDD e 8 dígitos começando por 2 a 5
+		{"1132345678", true},
+		{"1122345678", true},
+		{"1152345678", true},
+		{"1112345678", false},
+		{"1162345678", false},
+		{"1192345678", false},
+
+		// DDDs que não existem
+		{"10987654321", false},
+		{"20987654321", false},
+		{"23987654321", false},
+		{"00987654321", false},
+		{"0132345678", false},
+
+		// Tamanhos fora de 10 e 11 dígitos
+		{"", false},
+		{"11", false},
+		{"119876543", false},
+		{"119876543210", false},
+		{"5511987654321", false},
+
+		// Não há normalização: o telefone formatado é recusado, e não convertido
+		{"(11) 98765-4321", false},
+		{"11 98765-4321", false},
+		{"11-98765-4321", false},
+		{"+5511987654321", false},
+		{" 11987654321", false},
+		{"11987654321 ", false},
+		{"1198765432a", false},
+		{"１1987654321", false}, // dígito de largura total
+	}
+
+	for _, caso := range casos {
+		if valido := TelefoneValido(caso.telefone); valido != caso.valido {
+			t.Errorf("TelefoneValido(%q) = %v, esperado %v", caso.telefone, valido, caso.valido)
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package models
+
+import (
+	"strings"
+	"time"
+)
+
+// ZonaEntrega é uma área atendida pela entrega, delimitada por uma faixa de CEPs ou
+// por um bairro, com a taxa cobrada, o valor mínimo do pedido e o prazo de entrega
+type ZonaEntrega struct {
+	ID           uint      `gorm:"primaryKey" json:"id"`
+	Nome         string    `gorm:"not null;uniqueIndex" json:"nome" example:"Centro"`
+	CEPInicio    string    `gorm:"column:cep_inicio;not null;default:''" json:"cep_inicio,omitempty" example:"01000000"`
+	CEPFim       string    `gorm:"column:cep_fim;not null;default:''" json:"cep_fim,omitempty" example:"01599999"`
+	Bairro       string    `gorm:"not null;default:''" json:"bairro,omitempty"`
+	Cidade       string    `gorm:"not null;default:''" json:"cidade,omitempty"`
+	UF           string    `gorm:"column:uf;not null;default:''" json:"uf,omitempty"`
+	Taxa         Dinheiro  `gorm:"type:numeric(12,2);not null;default:0" json:"taxa"`
+	PedidoMinimo Dinheiro  `gorm:"type:numeric(12,2);not null;default:0" json:"pedido_minimo"` // sobre o subtotal, antes dos descontos
+	PrazoMinutos int       `gorm:"not null" json:"prazo_minutos"`
+	Ativa        bool      `gorm:"not null;default:true" json:"ativa"`
+	AtualizadoEm time.Time `gorm:"not null;default:CURRENT_TIMESTAMP" json:"atualizado_em"`
+}
+
+func (ZonaEntrega) TableName() string {
+	return "zonas_entrega"
+}
+
+// PorBairro indica se a zona é delimitada por bairro, e não por faixa de CEPs
+func (z ZonaEntrega) PorBairro() bool {
+	return z.Bairro != ""
+}
+
+// Atende indica se o endereço está dentro da zona. Bairro e cidade são comparados
+// sem diferenciar maiúsculas.
+func (z ZonaEntrega) Atende(endereco EnderecoEntrega) bool {
+	if z.PorBairro() {
+		return strings.EqualFold(z.Bairro, endereco.Bairro) &&
+			strings.EqualFold(z.Cidade, endereco.Cidade) &&
+			strings.EqualFold(z.UF, endereco.UF)
+	}
+	// Os CEPs têm sempre 8 dígitos, então a ordem do texto é a ordem numérica
+	return endereco.CEP != "" && z.CEPInicio <= endereco.CEP && endereco.CEP <= z.CEPFim
+}
+
+// EscolherZona retorna a zona ativa que atende o endereço. Uma zona por bairro vale
+// mais que uma faixa de CEPs e, entre faixas, vale a mais estreita; assim uma zona
+// pode abrir exceções dentro de outra maior.
+func EscolherZona(zonas []ZonaEntrega, endereco EnderecoEntrega) (ZonaEntrega, bool) {
+	var escolhida ZonaEntrega
+	encontrou := false
+	for _, zona := range zonas {
+		if !zona.Ativa || !zona.Atende(endereco) {
+			continue
+		}
+		if !encontrou || zona.maisEspecifica(escolhida) {
+			escolhida, encontrou = zona, true
+		}
+	}
+	return escolhida, encontrou
+}
+
+func (z ZonaEntrega) maisEspecifica(outra ZonaEntrega) bool {
+	if z.PorBairro() != outra.PorBairro() {
+		return z.PorBairro()
+	}
+	if z.PorBairro() {
+		return z.ID < outra.ID
+	}
+	return z.amplitude() < outra.amplitude()
+}
+
+// amplitude é a quantidade de CEPs da faixa
+func (z ZonaEntrega) amplitude() int {
+	return cepNumerico(z.CEPFim) - cepNumerico(z.CEPInicio)
+}
+
+func cepNumerico(cep string) int {
+	numero := 0
+	for _, r := range cep {
+		numero = numero*10 + int(r-'0')
+	}
+	return numero
+}
+
+// ZonaEntregaRequest cria ou substitui uma zona de entrega. A zona é delimitada pela
+// faixa cep_inicio–cep_fim ou pelo bairro, com cidade e UF, mas não pelos dois.
+type ZonaEntregaRequest struct {
+	Nome         string   `json:"nome" binding:"required"`
+	CEPInicio    string   `json:"cep_inicio" binding:"omitempty,cep" example:"01000-000"`
+	CEPFim       string   `json:"cep_fim" binding:"omitempty,cep" example:"01599-999"`
+	Bairro       string   `json:"bairro"`
+	Cidade       string   `json:"cidade"`
+	UF           string   `json:"uf" binding:"omitempty,uf"`
+	Taxa         Dinheiro `json:"taxa" binding:"min=0"`
+	PedidoMinimo Dinheiro `json:"pedido_minimo" binding:"min=0"`
+	PrazoMinutos int      `json:"prazo_minutos" binding:"required,gt=0"`
+	Ativa        *bool    `json:"ativa"`
+}
@@ -0,0 +1,45 @@
+package models
+
+import "testing"
+
+func TestEscolherZona(t *testing.T) {
+	centro := ZonaEntrega{ID: 1, Nome: "Centro", CEPInicio: "01000000", CEPFim: "01599999", Ativa: true}
+	paulista := ZonaEntrega{ID: 2, Nome: "Paulista", CEPInicio: "01310000", CEPFim: "01319999", Ativa: true}
+	belaVista := ZonaEntrega{ID: 3, Nome: "Bela Vista", Bairro: "Bela Vista", Cidade: "São Paulo", UF: "SP", Ativa: true}
+	belaVistaRepetida := ZonaEntrega{ID: 4, Nome: "Bela Vista 2", Bairro: "bela vista", Cidade: "são paulo", UF: "sp", Ativa: true}
+	inativa := ZonaEntrega{ID: 5, Nome: "Fechada", CEPInicio: "01310100", CEPFim: "01310100"}
+
+	endereco := func(cep, bairro string) EnderecoEntrega {
+		return EnderecoEntrega{CEP: cep, Bairro: bairro, Cidade: "São Paulo", UF: "SP"}
+	}
+
+	casos := []struct {
+		nome     string
+		zonas    []ZonaEntrega
+		endereco EnderecoEntrega
+		esperada uint // 0 quando nenhuma zona atende
+	}{
+		{"dentro da faixa", []ZonaEntrega{centro}, endereco("01310100", "Bela Vista"), 1},
+		{"início da faixa", []ZonaEntrega{centro}, endereco("01000000", ""), 1},
+		{"fim da faixa", []ZonaEntrega{centro}, endereco("01599999", ""), 1},
+		{"depois da faixa", []ZonaEntrega{centro}, endereco("01600000", ""), 0},
+		{"sem CEP", []ZonaEntrega{centro}, endereco("", "Sé"), 0},
+		{"pelo bairro, sem diferenciar maiúsculas", []ZonaEntrega{belaVistaRepetida}, endereco("04000000", "BELA VISTA"), 4},
+		{"bairro de outra cidade", []ZonaEntrega{belaVista}, EnderecoEntrega{CEP: "13000000", Bairro: "Bela Vista", Cidade: "Campinas", UF: "SP"}, 0},
+		{"faixa mais estreita vale mais", []ZonaEntrega{centro, paulista}, endereco("01310100", ""), 2},
+		{"faixa mais estreita vale mais em qualquer ordem", []ZonaEntrega{paulista, centro}, endereco("01310100", ""), 2},
+		{"bairro vale mais que a faixa", []ZonaEntrega{paulista, belaVista, centro}, endereco("01310100", "Bela Vista"), 3},
+		{"entre bairros vale o menor ID", []ZonaEntrega{belaVistaRepetida, belaVista}, endereco("01310100", "Bela Vista"), 3},
+		{"zona inativa é ignorada", []ZonaEntrega{centro, inativa}, endereco("01310100", ""), 1},
+		{"fora de todas as zonas", []ZonaEntrega{centro, paulista, belaVista}, endereco("20000000", "Copacabana"), 0},
+	}
+
+	for _, caso := range casos {
+		t.Run(caso.nome, func(t *testing.T) {
+			zona, ok := EscolherZona(caso.zonas, caso.endereco)
+			if ok != (caso.esperada != 0) || zona.ID != caso.esperada {
+				t.Errorf("EscolherZona = zona %d (%v), esperado zona %d", zona.ID, ok, caso.esperada)
+			}
+		})
+	}
+}
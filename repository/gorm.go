@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+// gormStore implementa Store sobre o GORM; dentro de uma transação db é o *gorm.DB transacional
+type gormStore struct {
+	db *gorm.DB
+}
+
+// NewGormStore cria o Store sobre a conexão informada. A conexão deve ser aberta com
+// TranslateError para que violações de integridade sejam reconhecidas.
+func NewGormStore(db *gorm.DB) Store {
+	return gormStore{db: db}
+}
+
+func (s gormStore) Itens() ItemRepository {
+	return gormItens{db: s.db}
+}
+
+func (s gormStore) Hamburguers() HamburguerRepository {
+	return gormHamburguers{db: s.db}
+}
+
+func (s gormStore) Pedidos() PedidoRepository {
+	return gormPedidos{db: s.db}
+}
+
+func (s gormStore) Estoque() EstoqueRepository {
+	return gormEstoque{db: s.db}
+}
+
+func (s gormStore) Clientes() ClienteRepository {
+	return gormClientes{db: s.db}
+}
+
+func (s gormStore) ZonasEntrega() ZonaEntregaRepository {
+	return gormZonasEntrega{db: s.db}
+}
+
+func (s gormStore) Promocoes() PromocaoRepository {
+	return gormPromocoes{db: s.db}
+}
+
+func (s gormStore) Transacao(fn func(tx Store) error) error {
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		return fn(gormStore{db: tx})
+	})
+}
+
+// traduzirErro converte os erros do GORM nos erros do pacote
+func traduzirErro(err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return ErrNaoEncontrado
+	case errors.Is(err, gorm.ErrForeignKeyViolated), errors.Is(err, gorm.ErrDuplicatedKey):
+		return fmt.Errorf("%w: %v", ErrConflito, err)
+	}
+	return err
+}
+
+// apenasRemovidos troca, na consulta, os registros ativos pelos removidos logicamente
+func apenasRemovidos(db *gorm.DB) *gorm.DB {
+	return db.Unscoped().Where("deleted_at IS NOT NULL")
+}
+
+// incluirRemovidos carrega uma associação mesmo que o registro referenciado tenha
+// sido removido, como o hambúrguer de um pedido antigo
+func incluirRemovidos(db *gorm.DB) *gorm.DB {
+	return db.Unscoped()
+}
+
+// removido traduz o resultado de uma remoção ou restauração, retornando
+// ErrNaoEncontrado se nenhuma linha foi alterada
+func removido(resultado *gorm.DB) error {
+	if resultado.Error != nil {
+		return traduzirErro(resultado.Error)
+	}
+	if resultado.RowsAffected == 0 {
+		return ErrNaoEncontrado
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"lanchonete/models"
+)
+
+type gormClientes struct {
+	db *gorm.DB
+}
+
+func (r gormClientes) Buscar(telefone string) (models.Cliente, error) {
+	var cliente models.Cliente
+	err := r.db.Preload("Enderecos", func(db *gorm.DB) *gorm.DB { return db.Order("id") }).
+		First(&cliente, "telefone = ?", telefone).Error
+	return cliente, traduzirErro(err)
+}
+
+func (r gormClientes) Criar(cliente *models.Cliente) error {
+	return traduzirErro(r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit(clause.Associations).Create(cliente).Error; err != nil {
+			return err
+		}
+		definirPrincipal(cliente.Enderecos)
+		for i := range cliente.Enderecos {
+			endereco := &cliente.Enderecos[i]
+			endereco.ClienteTelefone = cliente.Telefone
+			if err := tx.Create(endereco).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+}
+
+func (r gormClientes) Salvar(cliente *models.Cliente) error {
+	resultado := r.db.Model(&models.Cliente{}).Where("telefone = ?", cliente.Telefone).Update("nome", cliente.Nome)
+	return removido(resultado)
+}
+
+func (r gormClientes) AdicionarEndereco(endereco *models.ClienteEndereco) error {
+	return traduzirErro(r.db.Transaction(func(tx *gorm.DB) error {
+		var salvos int64
+		if err := tx.Model(&models.ClienteEndereco{}).Where("cliente_telefone = ?", endereco.ClienteTelefone).Count(&salvos).Error; err != nil {
+			return err
+		}
+		if salvos == 0 {
+			endereco.Principal = true
+		}
+		if endereco.Principal {
+			if err := tx.Model(&models.ClienteEndereco{}).
+				Where("cliente_telefone = ? AND principal", endereco.ClienteTelefone).
+				Update("principal", false).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Create(endereco).Error
+	}))
+}
+
+func (r gormClientes) RemoverEndereco(telefone string, id uint) error {
+	return traduzirErro(r.db.Transaction(func(tx *gorm.DB) error {
+		var endereco models.ClienteEndereco
+		if err := tx.Clauses(clause.Returning{}).
+			Where("id = ? AND cliente_telefone = ?", id, telefone).
+			Delete(&endereco).Error; err != nil {
+			return err
+		}
+		if endereco.ID == 0 {
+			return ErrNaoEncontrado
+		}
+		if !endereco.Principal {
+			return nil
+		}
+
+		// O mais antigo dos endereços restantes passa a ser o principal
+		var proximo models.ClienteEndereco
+		err := tx.Where("cliente_telefone = ?", telefone).Order("id").Limit(1).Find(&proximo).Error
+		if err != nil || proximo.ID == 0 {
+			return err
+		}
+		return tx.Model(&proximo).Update("principal", true).Error
+	}))
+}
+
+// definirPrincipal deixa exatamente um endereço principal: o último marcado ou,
+// sem nenhum marcado, o primeiro
+func definirPrincipal(enderecos []models.ClienteEndereco) {
+	principal := -1
+	for i := range enderecos {
+		if enderecos[i].Principal {
+			principal = i
+		}
+		enderecos[i].Principal = false
+	}
+	if len(enderecos) == 0 {
+		return
+	}
+	enderecos[max(principal, 0)].Principal = true
+}
@@ -0,0 +1,136 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"lanchonete/models"
+)
+
+type gormEstoque struct {
+	db *gorm.DB
+}
+
+func (r gormEstoque) Listar(filtro FiltroEstoque) ([]models.Estoque, error) {
+	query := r.db.Model(&models.Estoque{})
+	if filtro.AbaixoDoMinimo {
+		query = query.Where("quantidade <= ponto_reposicao")
+	}
+	if filtro.AposID > 0 {
+		query = query.Where("item_id > ?", filtro.AposID)
+	}
+	if filtro.Limite > 0 {
+		query = query.Limit(filtro.Limite)
+	}
+
+	var estoques []models.Estoque
+	err := query.Preload("Item", incluirRemovidos).Order("item_id").Find(&estoques).Error
+	return estoques, traduzirErro(err)
+}
+
+func (r gormEstoque) Buscar(itemID uint) (models.Estoque, error) {
+	var estoque models.Estoque
+	err := r.db.Preload("Item", incluirRemovidos).First(&estoque, "item_id = ?", itemID).Error
+	return estoque, traduzirErro(err)
+}
+
+func (r gormEstoque) Configurar(estoque *models.Estoque) error {
+	estoque.AtualizadoEm = time.Now()
+	novo := *estoque
+	novo.Quantidade = 0
+	return traduzirErro(r.db.Omit(clause.Associations).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "item_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"unidade", "ponto_reposicao", "atualizado_em"}),
+		}).
+		Create(&novo).Error)
+}
+
+func (r gormEstoque) Remover(itemID uint) error {
+	return removido(r.db.Delete(&models.Estoque{}, "item_id = ?", itemID))
+}
+
+// Movimentar confere o saldo no próprio UPDATE, para que saídas simultâneas não deixem
+// o saldo negativo; a linha fica bloqueada até o fim da transação, então o saldo lido
+// em seguida é o resultante deste movimento
+func (r gormEstoque) Movimentar(movimento *models.MovimentoEstoque) error {
+	return traduzirErro(r.db.Transaction(func(tx *gorm.DB) error {
+		if movimento.Data.IsZero() {
+			movimento.Data = time.Now()
+		}
+
+		resultado := tx.Model(&models.Estoque{}).
+			Where("item_id = ? AND quantidade + ? >= 0", movimento.ItemID, movimento.Quantidade).
+			Updates(map[string]any{
+				"quantidade":    gorm.Expr("quantidade + ?", movimento.Quantidade),
+				"atualizado_em": movimento.Data,
+			})
+		if resultado.Error != nil {
+			return resultado.Error
+		}
+
+		if resultado.RowsAffected == 0 {
+			// Nenhuma linha alterada: o item não tem estoque controlado ou o saldo não basta
+			var count int64
+			if err := tx.Model(&models.Estoque{}).Where("item_id = ?", movimento.ItemID).Count(&count).Error; err != nil {
+				return err
+			}
+			if count == 0 {
+				return ErrNaoEncontrado
+			}
+			return ErrConflito
+		}
+
+		var estoque models.Estoque
+		if err := tx.First(&estoque, "item_id = ?", movimento.ItemID).Error; err != nil {
+			return err
+		}
+		movimento.Saldo = estoque.Quantidade
+		return tx.Create(movimento).Error
+	}))
+}
+
+func (r gormEstoque) Movimentos(filtro FiltroMovimentos) ([]models.MovimentoEstoque, error) {
+	query := r.db.Model(&models.MovimentoEstoque{})
+	if filtro.ItemID > 0 {
+		query = query.Where("item_id = ?", filtro.ItemID)
+	}
+	if filtro.Tipo != "" {
+		query = query.Where("tipo = ?", filtro.Tipo)
+	}
+	if filtro.PedidoID != uuid.Nil {
+		query = query.Where("pedido_id = ?", filtro.PedidoID)
+	}
+	if filtro.DataInicio != nil {
+		query = query.Where("data >= ?", *filtro.DataInicio)
+	}
+	if filtro.DataFim != nil {
+		query = query.Where("data < ?", *filtro.DataFim)
+	}
+	if filtro.AposID > 0 {
+		query = query.Where("id > ?", filtro.AposID)
+	}
+	if filtro.Limite > 0 {
+		query = query.Limit(filtro.Limite)
+	}
+
+	var movimentos []models.MovimentoEstoque
+	err := query.Order("id").Find(&movimentos).Error
+	return movimentos, traduzirErro(err)
+}
+
+// SaldosEm inclui os itens removidos ou que deixaram de ter estoque controlado, desde que tenham movimentos no período
+func (r gormEstoque) SaldosEm(instante time.Time) ([]models.SaldoEstoque, error) {
+	var saldos []models.SaldoEstoque
+	err := r.db.Table("movimentos_estoque").
+		Select("movimentos_estoque.item_id, items.descricao, COALESCE(estoques.unidade, '') AS unidade, SUM(movimentos_estoque.quantidade) AS quantidade").
+		Joins("JOIN items ON items.id = movimentos_estoque.item_id").
+		Joins("LEFT JOIN estoques ON estoques.item_id = movimentos_estoque.item_id").
+		Where("movimentos_estoque.data < ?", instante).
+		Group("movimentos_estoque.item_id, items.descricao, estoques.unidade").
+		Order("movimentos_estoque.item_id").
+		Scan(&saldos).Error
+	return saldos, traduzirErro(err)
+}
@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"lanchonete/models"
+)
+
+type gormHamburguers struct {
+	db *gorm.DB
+}
+
+func (r gormHamburguers) Listar(filtro FiltroHamburguers) ([]models.Hamburguer, error) {
+	query := r.db.Model(&models.Hamburguer{})
+	if filtro.Nome != "" {
+		query = query.Where("descricao ILIKE ?", "%"+filtro.Nome+"%")
+	}
+	if filtro.Removidos {
+		query = apenasRemovidos(query)
+	}
+	if filtro.AposID > 0 {
+		query = query.Where("id > ?", filtro.AposID)
+	}
+	if filtro.Limite > 0 {
+		query = query.Limit(filtro.Limite)
+	}
+
+	var hamburguers []models.Hamburguer
+	err := query.Preload("HamburguerIngredientes.Item", incluirRemovidos).Order("id").Find(&hamburguers).Error
+	for i := range hamburguers {
+		hamburguers[i].VerificarDisponibilidade()
+	}
+	return hamburguers, traduzirErro(err)
+}
+
+func (r gormHamburguers) Buscar(id uint) (models.Hamburguer, error) {
+	var hamburguer models.Hamburguer
+	err := r.db.Preload("HamburguerIngredientes.Item", incluirRemovidos).First(&hamburguer, id).Error
+	hamburguer.VerificarDisponibilidade()
+	return hamburguer, traduzirErro(err)
+}
+
+func (r gormHamburguers) Criar(hamburguer *models.Hamburguer) error {
+	return traduzirErro(r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit(clause.Associations).Create(hamburguer).Error; err != nil {
+			return err
+		}
+		return criarReceita(tx, hamburguer)
+	}))
+}
+
+func (r gormHamburguers) Salvar(hamburguer *models.Hamburguer) error {
+	return traduzirErro(r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit(clause.Associations).Save(hamburguer).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("hamburguer_id = ?", hamburguer.ID).Delete(&models.HamburguerIngrediente{}).Error; err != nil {
+			return err
+		}
+		return criarReceita(tx, hamburguer)
+	}))
+}
+
+// criarReceita grava os ingredientes do hambúrguer sem tocar nos itens referenciados
+func criarReceita(tx *gorm.DB, hamburguer *models.Hamburguer) error {
+	for i := range hamburguer.HamburguerIngredientes {
+		ingrediente := &hamburguer.HamburguerIngredientes[i]
+		ingrediente.HamburguerID = hamburguer.ID
+		if err := tx.Omit(clause.Associations).Create(ingrediente).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r gormHamburguers) Remover(id uint) error {
+	return removido(r.db.Delete(&models.Hamburguer{}, id))
+}
+
+func (r gormHamburguers) Restaurar(id uint) error {
+	return traduzirErro(r.db.Transaction(func(tx *gorm.DB) error {
+		err := removido(tx.Unscoped().Model(&models.Hamburguer{}).
+			Where("id = ? AND deleted_at IS NOT NULL", id).
+			Update("deleted_at", nil))
+		if err != nil {
+			return err
+		}
+
+		var count int64
+		if err := tx.Table("hamburguer_ingredientes").
+			Joins("JOIN items ON items.id = hamburguer_ingredientes.item_id").
+			Where("hamburguer_ingredientes.hamburguer_id = ? AND items.deleted_at IS NOT NULL", id).
+			Count(&count).Error; err != nil {
+			return err
+		}
+		if count > 0 {
+			return ErrConflito
+		}
+		return nil
+	}))
+}
+
+func (r gormHamburguers) DefinirDisponibilidade(id uint, disponivel bool) error {
+	return removido(r.db.Model(&models.Hamburguer{}).Where("id = ?", id).Update("disponivel", disponivel))
+}
+
+// Expurgar mantém os hambúrgueres vendidos em pedidos; a receita é apagada em cascata
+func (r gormHamburguers) Expurgar(antes time.Time) (int64, error) {
+	resultado := r.db.Unscoped().
+		Where("deleted_at < ?", antes).
+		Where("NOT EXISTS (SELECT 1 FROM pedido_hamburgueres WHERE pedido_hamburgueres.hamburguer_id = hamburguers.id)").
+		Where("NOT EXISTS (SELECT 1 FROM promocoes WHERE promocoes.hamburguer_id = hamburguers.id)").
+		Delete(&models.Hamburguer{})
+	return resultado.RowsAffected, traduzirErro(resultado.Error)
+}
+
+func (r gormHamburguers) EmPedidosAbertos(id uint) (bool, error) {
+	var count int64
+	err := r.db.Table("pedido_hamburgueres").
+		Joins("JOIN pedidos ON pedidos.id = pedido_hamburgueres.pedido_id").
+		Where("pedido_hamburgueres.hamburguer_id = ? AND pedidos.status NOT IN ? AND pedidos.deleted_at IS NULL", id, models.StatusEncerrados).
+		Count(&count).Error
+	return count > 0, err
+}
@@ -0,0 +1,115 @@
+package repository
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+	"lanchonete/models"
+)
+
+type gormItens struct {
+	db *gorm.DB
+}
+
+func (r gormItens) Listar(filtro FiltroItens) ([]models.Item, error) {
+	query := r.db.Model(&models.Item{})
+	if filtro.Tipo != "" {
+		query = query.Where("tipo = ?", filtro.Tipo)
+	}
+	if filtro.Removidos {
+		query = apenasRemovidos(query)
+	}
+	if filtro.AposID > 0 {
+		query = query.Where("id > ?", filtro.AposID)
+	}
+	if filtro.Limite > 0 {
+		query = query.Limit(filtro.Limite)
+	}
+
+	var itens []models.Item
+	err := query.Order("id").Find(&itens).Error
+	return itens, traduzirErro(err)
+}
+
+func (r gormItens) Buscar(id uint) (models.Item, error) {
+	var item models.Item
+	err := r.db.First(&item, id).Error
+	return item, traduzirErro(err)
+}
+
+func (r gormItens) Criar(item *models.Item) error {
+	return traduzirErro(r.db.Create(item).Error)
+}
+
+func (r gormItens) Salvar(item *models.Item) error {
+	return traduzirErro(r.db.Save(item).Error)
+}
+
+func (r gormItens) Remover(id uint) error {
+	return traduzirErro(r.db.Transaction(func(tx *gorm.DB) error {
+		// A remoção lógica não passa pela chave estrangeira da receita, então a verificação é feita aqui
+		var count int64
+		if err := tx.Table("hamburguer_ingredientes").
+			Joins("JOIN hamburguers ON hamburguers.id = hamburguer_ingredientes.hamburguer_id").
+			Where("hamburguer_ingredientes.item_id = ? AND hamburguers.deleted_at IS NULL", id).
+			Count(&count).Error; err != nil {
+			return err
+		}
+		if count > 0 {
+			return ErrConflito
+		}
+		return removido(tx.Delete(&models.Item{}, id))
+	}))
+}
+
+func (r gormItens) Restaurar(id uint) error {
+	return removido(r.db.Unscoped().Model(&models.Item{}).
+		Where("id = ? AND deleted_at IS NOT NULL", id).
+		Update("deleted_at", nil))
+}
+
+func (r gormItens) DefinirDisponibilidade(id uint, disponivel bool) error {
+	return removido(r.db.Model(&models.Item{}).Where("id = ?", id).Update("disponivel", disponivel))
+}
+
+// Expurgar mantém os itens ainda referenciados, que as chaves estrangeiras impediriam de apagar
+func (r gormItens) Expurgar(antes time.Time) (int64, error) {
+	resultado := r.db.Unscoped().
+		Where("deleted_at < ?", antes).
+		Where("NOT EXISTS (SELECT 1 FROM hamburguer_ingredientes WHERE hamburguer_ingredientes.item_id = items.id)").
+		Where("NOT EXISTS (SELECT 1 FROM pedido_bebidas WHERE pedido_bebidas.item_id = items.id)").
+		Where("NOT EXISTS (SELECT 1 FROM pedido_hamburguer_personalizacoes WHERE pedido_hamburguer_personalizacoes.item_id = items.id)").
+		Where("NOT EXISTS (SELECT 1 FROM movimentos_estoque WHERE movimentos_estoque.item_id = items.id)").
+		Where("NOT EXISTS (SELECT 1 FROM promocoes WHERE promocoes.bebida_id = items.id)").
+		Delete(&models.Item{})
+	return resultado.RowsAffected, traduzirErro(resultado.Error)
+}
+
+func (r gormItens) EmPedidosAbertos(id uint) (bool, error) {
+	var count int64
+
+	// Bebidas vendidas nos pedidos
+	if err := r.db.Table("pedido_bebidas").
+		Joins("JOIN pedidos ON pedidos.id = pedido_bebidas.pedido_id").
+		Where("pedido_bebidas.item_id = ? AND pedidos.status NOT IN ? AND pedidos.deleted_at IS NULL", id, models.StatusEncerrados).
+		Count(&count).Error; err != nil || count > 0 {
+		return count > 0, err
+	}
+
+	// Ingredientes da receita dos hambúrgueres vendidos
+	if err := r.db.Table("hamburguer_ingredientes").
+		Joins("JOIN pedido_hamburgueres ON pedido_hamburgueres.hamburguer_id = hamburguer_ingredientes.hamburguer_id").
+		Joins("JOIN pedidos ON pedidos.id = pedido_hamburgueres.pedido_id").
+		Where("hamburguer_ingredientes.item_id = ? AND pedidos.status NOT IN ? AND pedidos.deleted_at IS NULL", id, models.StatusEncerrados).
+		Count(&count).Error; err != nil || count > 0 {
+		return count > 0, err
+	}
+
+	// Ingredientes adicionados como extra nas linhas dos pedidos
+	err := r.db.Table("pedido_hamburguer_personalizacoes").
+		Joins("JOIN pedido_hamburgueres ON pedido_hamburgueres.id = pedido_hamburguer_personalizacoes.pedido_hamburguer_id").
+		Joins("JOIN pedidos ON pedidos.id = pedido_hamburgueres.pedido_id").
+		Where("pedido_hamburguer_personalizacoes.item_id = ? AND pedidos.status NOT IN ? AND pedidos.deleted_at IS NULL", id, models.StatusEncerrados).
+		Count(&count).Error
+	return count > 0, err
+}
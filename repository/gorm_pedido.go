@@ -0,0 +1,161 @@
+package repository
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"lanchonete/models"
+)
+
+type gormPedidos struct {
+	db *gorm.DB
+}
+
+// carregarLinhas inclui as linhas do pedido, com suas personalizações, na consulta.
+// Os produtos são carregados mesmo que já tenham sido removidos do cardápio.
+func carregarLinhas(db *gorm.DB) *gorm.DB {
+	return db.Preload("PedidoHamburgueres.Hamburguer", incluirRemovidos).
+		Preload("PedidoHamburgueres.Personalizacoes.Item", incluirRemovidos).
+		Preload("PedidoBebidas.Bebida", incluirRemovidos).
+		Preload("Descontos", func(db *gorm.DB) *gorm.DB { return db.Order("id") })
+}
+
+func (r gormPedidos) Listar(filtro FiltroPedidos) ([]models.Pedido, error) {
+	ordem := filtro.Ordem
+	if ordem == "" {
+		ordem = "data"
+	}
+	if !slices.Contains(OrdensPedido, ordem) {
+		return nil, fmt.Errorf("ordenação de pedidos inválida: %s", ordem)
+	}
+
+	query := r.db.Model(&models.Pedido{})
+	if filtro.Removidos {
+		query = apenasRemovidos(query)
+	}
+	if len(filtro.Status) > 0 {
+		query = query.Where("status IN ?", filtro.Status)
+	}
+	if filtro.Tipo != "" {
+		query = query.Where("tipo = ?", filtro.Tipo)
+	}
+	if filtro.Abertos {
+		query = query.Where("status NOT IN ?", models.StatusEncerrados)
+	}
+	if filtro.DataInicio != nil {
+		query = query.Where("data >= ?", *filtro.DataInicio)
+	}
+	if filtro.DataFim != nil {
+		query = query.Where("data < ?", *filtro.DataFim)
+	}
+	if filtro.Telefone != "" {
+		query = query.Where("telefone = ?", filtro.Telefone)
+	}
+	if filtro.Nome != "" {
+		query = query.Where("nome ILIKE ?", "%"+filtro.Nome+"%")
+	}
+
+	direcao, comparador := "ASC", ">"
+	if filtro.Desc {
+		direcao, comparador = "DESC", "<"
+	}
+	if filtro.Apos != nil {
+		query = query.Where("("+ordem+", id) "+comparador+" (?, ?)", filtro.Apos.Valor, filtro.Apos.ID)
+	}
+	if filtro.Limite > 0 {
+		query = query.Limit(filtro.Limite)
+	}
+
+	var pedidos []models.Pedido
+	err := query.Order(ordem + " " + direcao).Order("id " + direcao).
+		Scopes(carregarLinhas).
+		Find(&pedidos).Error
+	return pedidos, traduzirErro(err)
+}
+
+func (r gormPedidos) Buscar(id uuid.UUID) (models.Pedido, error) {
+	var pedido models.Pedido
+	err := carregarLinhas(r.db).First(&pedido, "id = ?", id).Error
+	return pedido, traduzirErro(err)
+}
+
+func (r gormPedidos) BuscarParaAtualizar(id uuid.UUID) (models.Pedido, error) {
+	var pedido models.Pedido
+	err := r.db.Clauses(clause.Locking{Strength: "UPDATE"}).First(&pedido, "id = ?", id).Error
+	return pedido, traduzirErro(err)
+}
+
+func (r gormPedidos) Historico(id uuid.UUID) ([]models.PedidoStatusHistorico, error) {
+	var historico []models.PedidoStatusHistorico
+	err := r.db.Where("pedido_id = ?", id).Order("data, id").Find(&historico).Error
+	return historico, traduzirErro(err)
+}
+
+func (r gormPedidos) RegistrarHistorico(etapa *models.PedidoStatusHistorico) error {
+	return traduzirErro(r.db.Create(etapa).Error)
+}
+
+func (r gormPedidos) Criar(pedido *models.Pedido) error {
+	return traduzirErro(r.db.Omit(clause.Associations).Create(pedido).Error)
+}
+
+func (r gormPedidos) Salvar(pedido *models.Pedido) error {
+	return traduzirErro(r.db.Omit(clause.Associations).Save(pedido).Error)
+}
+
+func (r gormPedidos) AdicionarHamburguer(linha *models.PedidoHamburguer) error {
+	return traduzirErro(r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit(clause.Associations).Create(linha).Error; err != nil {
+			return err
+		}
+		for i := range linha.Personalizacoes {
+			personalizacao := &linha.Personalizacoes[i]
+			personalizacao.PedidoHamburguerID = linha.ID
+			if err := tx.Omit(clause.Associations).Create(personalizacao).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+}
+
+func (r gormPedidos) AdicionarBebida(linha *models.PedidoBebida) error {
+	return traduzirErro(r.db.Omit(clause.Associations).Create(linha).Error)
+}
+
+// RemoverHamburgueres apaga as linhas de hambúrguer; as personalizações são apagadas em cascata
+func (r gormPedidos) RemoverHamburgueres(pedidoID uuid.UUID) error {
+	return traduzirErro(r.db.Where("pedido_id = ?", pedidoID).Delete(&models.PedidoHamburguer{}).Error)
+}
+
+func (r gormPedidos) RemoverBebidas(pedidoID uuid.UUID) error {
+	return traduzirErro(r.db.Where("pedido_id = ?", pedidoID).Delete(&models.PedidoBebida{}).Error)
+}
+
+func (r gormPedidos) AdicionarDesconto(desconto *models.PedidoDesconto) error {
+	return traduzirErro(r.db.Create(desconto).Error)
+}
+
+func (r gormPedidos) RemoverDescontos(pedidoID uuid.UUID) error {
+	return traduzirErro(r.db.Where("pedido_id = ?", pedidoID).Delete(&models.PedidoDesconto{}).Error)
+}
+
+func (r gormPedidos) Remover(id uuid.UUID) error {
+	return removido(r.db.Delete(&models.Pedido{}, "id = ?", id))
+}
+
+func (r gormPedidos) Restaurar(id uuid.UUID) error {
+	return removido(r.db.Unscoped().Model(&models.Pedido{}).
+		Where("id = ? AND deleted_at IS NOT NULL", id).
+		Update("deleted_at", nil))
+}
+
+// Expurgar apaga os pedidos; linhas, personalizações, descontos e histórico são apagados em cascata
+func (r gormPedidos) Expurgar(antes time.Time) (int64, error) {
+	resultado := r.db.Unscoped().Where("deleted_at < ?", antes).Delete(&models.Pedido{})
+	return resultado.RowsAffected, traduzirErro(resultado.Error)
+}
@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"lanchonete/models"
+)
+
+type gormPromocoes struct {
+	db *gorm.DB
+}
+
+func (r gormPromocoes) Listar(filtro FiltroPromocoes) ([]models.Promocao, error) {
+	query := r.db.Model(&models.Promocao{})
+	if filtro.Ativas {
+		query = query.Where("ativa")
+	}
+	if filtro.AposID > 0 {
+		query = query.Where("id > ?", filtro.AposID)
+	}
+	if filtro.Limite > 0 {
+		query = query.Limit(filtro.Limite)
+	}
+
+	var promocoes []models.Promocao
+	err := query.Order("id").Find(&promocoes).Error
+	return promocoes, traduzirErro(err)
+}
+
+func (r gormPromocoes) Buscar(id uint) (models.Promocao, error) {
+	var promocao models.Promocao
+	err := r.db.First(&promocao, id).Error
+	return promocao, traduzirErro(err)
+}
+
+func (r gormPromocoes) BuscarCupom(cupom string) (models.Promocao, error) {
+	var promocao models.Promocao
+	err := r.db.Where("cupom = ? AND cupom <> ''", cupom).First(&promocao).Error
+	return promocao, traduzirErro(err)
+}
+
+func (r gormPromocoes) BuscarParaAtualizar(id uint) (models.Promocao, error) {
+	var promocao models.Promocao
+	err := r.db.Clauses(clause.Locking{Strength: "UPDATE"}).First(&promocao, id).Error
+	return promocao, traduzirErro(err)
+}
+
+func (r gormPromocoes) Criar(promocao *models.Promocao) error {
+	promocao.AtualizadoEm = time.Now()
+	return traduzirErro(r.db.Create(promocao).Error)
+}
+
+func (r gormPromocoes) Salvar(promocao *models.Promocao) error {
+	promocao.AtualizadoEm = time.Now()
+	return traduzirErro(r.db.Save(promocao).Error)
+}
+
+func (r gormPromocoes) Remover(id uint) error {
+	return removido(r.db.Delete(&models.Promocao{}, id))
+}
+
+func (r gormPromocoes) Usos(id uint, telefone string, exceto uuid.UUID) (total, doCliente int64, err error) {
+	// usos monta a consulta a cada contagem, já que Count altera a consulta recebida
+	usos := func() *gorm.DB {
+		return r.db.Model(&models.PedidoDesconto{}).
+			Joins("JOIN pedidos ON pedidos.id = pedido_descontos.pedido_id").
+			Where("pedido_descontos.promocao_id = ?", id).
+			Where("pedidos.status <> ? AND pedidos.deleted_at IS NULL AND pedidos.id <> ?", models.StatusCancelled, exceto)
+	}
+
+	if err := usos().Distinct("pedidos.id").Count(&total).Error; err != nil {
+		return 0, 0, traduzirErro(err)
+	}
+	if err := usos().Where("pedidos.telefone = ?", telefone).Distinct("pedidos.id").Count(&doCliente).Error; err != nil {
+		return 0, 0, traduzirErro(err)
+	}
+	return total, doCliente, nil
+}
@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+	"lanchonete/models"
+)
+
+type gormZonasEntrega struct {
+	db *gorm.DB
+}
+
+func (r gormZonasEntrega) Listar(filtro FiltroZonas) ([]models.ZonaEntrega, error) {
+	query := r.db.Model(&models.ZonaEntrega{})
+	if filtro.Ativas {
+		query = query.Where("ativa")
+	}
+	if filtro.AposID > 0 {
+		query = query.Where("id > ?", filtro.AposID)
+	}
+	if filtro.Limite > 0 {
+		query = query.Limit(filtro.Limite)
+	}
+
+	var zonas []models.ZonaEntrega
+	err := query.Order("id").Find(&zonas).Error
+	return zonas, traduzirErro(err)
+}
+
+func (r gormZonasEntrega) Buscar(id uint) (models.ZonaEntrega, error) {
+	var zona models.ZonaEntrega
+	err := r.db.First(&zona, id).Error
+	return zona, traduzirErro(err)
+}
+
+func (r gormZonasEntrega) Criar(zona *models.ZonaEntrega) error {
+	zona.AtualizadoEm = time.Now()
+	return traduzirErro(r.db.Create(zona).Error)
+}
+
+func (r gormZonasEntrega) Salvar(zona *models.ZonaEntrega) error {
+	zona.AtualizadoEm = time.Now()
+	return traduzirErro(r.db.Save(zona).Error)
+}
+
+func (r gormZonasEntrega) Remover(id uint) error {
+	return removido(r.db.Delete(&models.ZonaEntrega{}, id))
+}
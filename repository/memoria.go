@@ -0,0 +1,147 @@
+package repository
+
+import (
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"lanchonete/models"
+)
+
+// dadosMemoria guarda as tabelas normalizadas: os relacionamentos são montados
+// a cada leitura, como faria o Preload do GORM
+type dadosMemoria struct {
+	itens            map[uint]models.Item
+	hamburguers      map[uint]models.Hamburguer
+	receitas         map[uint][]models.HamburguerIngrediente
+	pedidos          map[uuid.UUID]models.Pedido
+	linhasHamburguer []models.PedidoHamburguer
+	linhasBebida     []models.PedidoBebida
+	historico        []models.PedidoStatusHistorico
+	estoques         map[uint]models.Estoque
+	movimentos       []models.MovimentoEstoque
+	clientes         map[string]models.Cliente
+	enderecos        []models.ClienteEndereco
+	zonas            map[uint]models.ZonaEntrega
+	promocoes        map[uint]models.Promocao
+	descontos        []models.PedidoDesconto
+	ultimoID         uint // sequência das linhas, personalizações, histórico, movimentos, endereços, zonas, promoções e descontos
+}
+
+func (d *dadosMemoria) clonar() *dadosMemoria {
+	copia := &dadosMemoria{
+		itens:            maps.Clone(d.itens),
+		hamburguers:      maps.Clone(d.hamburguers),
+		receitas:         make(map[uint][]models.HamburguerIngrediente, len(d.receitas)),
+		pedidos:          maps.Clone(d.pedidos),
+		linhasHamburguer: slices.Clone(d.linhasHamburguer),
+		linhasBebida:     slices.Clone(d.linhasBebida),
+		historico:        slices.Clone(d.historico),
+		estoques:         maps.Clone(d.estoques),
+		movimentos:       slices.Clone(d.movimentos),
+		clientes:         maps.Clone(d.clientes),
+		enderecos:        slices.Clone(d.enderecos),
+		zonas:            maps.Clone(d.zonas),
+		promocoes:        maps.Clone(d.promocoes),
+		descontos:        slices.Clone(d.descontos),
+		ultimoID:         d.ultimoID,
+	}
+	for id, receita := range d.receitas {
+		copia.receitas[id] = slices.Clone(receita)
+	}
+	for i := range copia.linhasHamburguer {
+		copia.linhasHamburguer[i].Personalizacoes = slices.Clone(copia.linhasHamburguer[i].Personalizacoes)
+	}
+	return copia
+}
+
+// removidoAgora é o valor gravado em DeletedAt na remoção lógica, como faz o GORM
+func removidoAgora() gorm.DeletedAt {
+	return gorm.DeletedAt{Time: time.Now(), Valid: true}
+}
+
+// removidoAntes indica se o registro foi removido logicamente antes do instante informado
+func removidoAntes(removido gorm.DeletedAt, antes time.Time) bool {
+	return removido.Valid && removido.Time.Before(antes)
+}
+
+func (d *dadosMemoria) proximoID() uint {
+	d.ultimoID++
+	return d.ultimoID
+}
+
+// memoriaStore implementa Store em memória. As operações são serializadas por mu;
+// uma transação trabalha sobre uma cópia dos dados, que só substitui o original se
+// fn terminar sem erro. Dentro da transação mu é nil, pois o lock já está com ela.
+type memoriaStore struct {
+	mu    *sync.Mutex
+	dados *dadosMemoria
+}
+
+// NewMemoriaStore cria um Store vazio em memória, sem dependência de banco de dados
+func NewMemoriaStore() Store {
+	return &memoriaStore{
+		mu: &sync.Mutex{},
+		dados: &dadosMemoria{
+			itens:       map[uint]models.Item{},
+			hamburguers: map[uint]models.Hamburguer{},
+			receitas:    map[uint][]models.HamburguerIngrediente{},
+			pedidos:     map[uuid.UUID]models.Pedido{},
+			estoques:    map[uint]models.Estoque{},
+			clientes:    map[string]models.Cliente{},
+			zonas:       map[uint]models.ZonaEntrega{},
+			promocoes:   map[uint]models.Promocao{},
+		},
+	}
+}
+
+// travar obtém o lock fora de transações e retorna a função que o libera
+func (s *memoriaStore) travar() func() {
+	if s.mu == nil {
+		return func() {}
+	}
+	s.mu.Lock()
+	return s.mu.Unlock
+}
+
+func (s *memoriaStore) Itens() ItemRepository {
+	return memoriaItens{s}
+}
+
+func (s *memoriaStore) Hamburguers() HamburguerRepository {
+	return memoriaHamburguers{s}
+}
+
+func (s *memoriaStore) Pedidos() PedidoRepository {
+	return memoriaPedidos{s}
+}
+
+func (s *memoriaStore) Estoque() EstoqueRepository {
+	return memoriaEstoque{s}
+}
+
+func (s *memoriaStore) Clientes() ClienteRepository {
+	return memoriaClientes{s}
+}
+
+func (s *memoriaStore) ZonasEntrega() ZonaEntregaRepository {
+	return memoriaZonasEntrega{s}
+}
+
+func (s *memoriaStore) Promocoes() PromocaoRepository {
+	return memoriaPromocoes{s}
+}
+
+func (s *memoriaStore) Transacao(fn func(tx Store) error) error {
+	defer s.travar()()
+
+	tx := &memoriaStore{dados: s.dados.clonar()}
+	if err := fn(tx); err != nil {
+		return err
+	}
+	s.dados = tx.dados
+	return nil
+}
@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"time"
+
+	"lanchonete/models"
+)
+
+type memoriaClientes struct {
+	s *memoriaStore
+}
+
+func (r memoriaClientes) Buscar(telefone string) (models.Cliente, error) {
+	defer r.s.travar()()
+
+	d := r.s.dados
+	cliente, ok := d.clientes[telefone]
+	if !ok {
+		return models.Cliente{}, ErrNaoEncontrado
+	}
+	cliente.Enderecos = nil
+	for _, endereco := range d.enderecos {
+		if endereco.ClienteTelefone == telefone {
+			cliente.Enderecos = append(cliente.Enderecos, endereco)
+		}
+	}
+	return cliente, nil
+}
+
+func (r memoriaClientes) Criar(cliente *models.Cliente) error {
+	defer r.s.travar()()
+
+	d := r.s.dados
+	if _, existe := d.clientes[cliente.Telefone]; existe {
+		return ErrConflito
+	}
+	if cliente.CriadoEm.IsZero() {
+		cliente.CriadoEm = time.Now()
+	}
+
+	definirPrincipal(cliente.Enderecos)
+	for i := range cliente.Enderecos {
+		cliente.Enderecos[i].ID = d.proximoID()
+		cliente.Enderecos[i].ClienteTelefone = cliente.Telefone
+		d.enderecos = append(d.enderecos, cliente.Enderecos[i])
+	}
+	gravado := *cliente
+	gravado.Enderecos = nil
+	d.clientes[cliente.Telefone] = gravado
+	return nil
+}
+
+func (r memoriaClientes) Salvar(cliente *models.Cliente) error {
+	defer r.s.travar()()
+
+	d := r.s.dados
+	gravado, ok := d.clientes[cliente.Telefone]
+	if !ok {
+		return ErrNaoEncontrado
+	}
+	gravado.Nome = cliente.Nome
+	d.clientes[cliente.Telefone] = gravado
+	return nil
+}
+
+func (r memoriaClientes) AdicionarEndereco(endereco *models.ClienteEndereco) error {
+	defer r.s.travar()()
+
+	d := r.s.dados
+	if _, existe := d.clientes[endereco.ClienteTelefone]; !existe {
+		return ErrConflito
+	}
+
+	primeiro := true
+	for _, salvo := range d.enderecos {
+		if salvo.ClienteTelefone == endereco.ClienteTelefone {
+			primeiro = false
+		}
+	}
+	endereco.Principal = endereco.Principal || primeiro
+	if endereco.Principal {
+		for i := range d.enderecos {
+			if d.enderecos[i].ClienteTelefone == endereco.ClienteTelefone {
+				d.enderecos[i].Principal = false
+			}
+		}
+	}
+
+	endereco.ID = d.proximoID()
+	d.enderecos = append(d.enderecos, *endereco)
+	return nil
+}
+
+func (r memoriaClientes) RemoverEndereco(telefone string, id uint) error {
+	defer r.s.travar()()
+
+	d := r.s.dados
+	for i, endereco := range d.enderecos {
+		if endereco.ID != id || endereco.ClienteTelefone != telefone {
+			continue
+		}
+		d.enderecos = append(d.enderecos[:i:i], d.enderecos[i+1:]...)
+		if endereco.Principal {
+			for j := range d.enderecos {
+				if d.enderecos[j].ClienteTelefone == telefone {
+					d.enderecos[j].Principal = true
+					break
+				}
+			}
+		}
+		return nil
+	}
+	return ErrNaoEncontrado
+}
@@ -0,0 +1,145 @@
+package repository
+
+import (
+	"cmp"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"lanchonete/models"
+)
+
+type memoriaEstoque struct {
+	s *memoriaStore
+}
+
+func (r memoriaEstoque) Listar(filtro FiltroEstoque) ([]models.Estoque, error) {
+	defer r.s.travar()()
+
+	d := r.s.dados
+	var estoques []models.Estoque
+	for _, estoque := range d.estoques {
+		if estoque.ItemID > filtro.AposID && (!filtro.AbaixoDoMinimo || estoque.AbaixoDoMinimo()) {
+			estoque.Item = d.itens[estoque.ItemID]
+			estoques = append(estoques, estoque)
+		}
+	}
+	slices.SortFunc(estoques, func(a, b models.Estoque) int { return cmp.Compare(a.ItemID, b.ItemID) })
+
+	if filtro.Limite > 0 && len(estoques) > filtro.Limite {
+		estoques = estoques[:filtro.Limite]
+	}
+	return estoques, nil
+}
+
+func (r memoriaEstoque) Buscar(itemID uint) (models.Estoque, error) {
+	defer r.s.travar()()
+
+	d := r.s.dados
+	estoque, ok := d.estoques[itemID]
+	if !ok {
+		return models.Estoque{}, ErrNaoEncontrado
+	}
+	estoque.Item = d.itens[itemID]
+	return estoque, nil
+}
+
+func (r memoriaEstoque) Configurar(estoque *models.Estoque) error {
+	defer r.s.travar()()
+
+	d := r.s.dados
+	if _, existe := d.itens[estoque.ItemID]; !existe {
+		return ErrConflito
+	}
+	estoque.AtualizadoEm = time.Now()
+	gravado := *estoque
+	gravado.Item = models.Item{}
+	gravado.Quantidade = d.estoques[estoque.ItemID].Quantidade
+	d.estoques[estoque.ItemID] = gravado
+	return nil
+}
+
+func (r memoriaEstoque) Remover(itemID uint) error {
+	defer r.s.travar()()
+
+	if _, existe := r.s.dados.estoques[itemID]; !existe {
+		return ErrNaoEncontrado
+	}
+	delete(r.s.dados.estoques, itemID)
+	return nil
+}
+
+func (r memoriaEstoque) Movimentar(movimento *models.MovimentoEstoque) error {
+	defer r.s.travar()()
+
+	d := r.s.dados
+	estoque, existe := d.estoques[movimento.ItemID]
+	if !existe {
+		return ErrNaoEncontrado
+	}
+	if estoque.Quantidade+movimento.Quantidade < 0 {
+		return ErrConflito
+	}
+	if movimento.Data.IsZero() {
+		movimento.Data = time.Now()
+	}
+
+	estoque.Quantidade += movimento.Quantidade
+	estoque.AtualizadoEm = movimento.Data
+	d.estoques[movimento.ItemID] = estoque
+
+	movimento.ID = d.proximoID()
+	movimento.Saldo = estoque.Quantidade
+	d.movimentos = append(d.movimentos, *movimento)
+	return nil
+}
+
+func (r memoriaEstoque) Movimentos(filtro FiltroMovimentos) ([]models.MovimentoEstoque, error) {
+	defer r.s.travar()()
+
+	// Os movimentos são gravados em ordem de ID
+	var movimentos []models.MovimentoEstoque
+	for _, movimento := range r.s.dados.movimentos {
+		switch {
+		case filtro.ItemID > 0 && movimento.ItemID != filtro.ItemID,
+			filtro.Tipo != "" && movimento.Tipo != filtro.Tipo,
+			filtro.PedidoID != uuid.Nil && (movimento.PedidoID == nil || *movimento.PedidoID != filtro.PedidoID),
+			filtro.DataInicio != nil && movimento.Data.Before(*filtro.DataInicio),
+			filtro.DataFim != nil && !movimento.Data.Before(*filtro.DataFim),
+			movimento.ID <= filtro.AposID:
+			continue
+		}
+		movimentos = append(movimentos, movimento)
+		if filtro.Limite > 0 && len(movimentos) == filtro.Limite {
+			break
+		}
+	}
+	return movimentos, nil
+}
+
+func (r memoriaEstoque) SaldosEm(instante time.Time) ([]models.SaldoEstoque, error) {
+	defer r.s.travar()()
+
+	d := r.s.dados
+	saldos := map[uint]models.SaldoEstoque{}
+	for _, movimento := range d.movimentos {
+		if !movimento.Data.Before(instante) {
+			continue
+		}
+		saldo, existe := saldos[movimento.ItemID]
+		if !existe {
+			saldo = models.SaldoEstoque{
+				ItemID:    movimento.ItemID,
+				Descricao: d.itens[movimento.ItemID].Descricao,
+				Unidade:   d.estoques[movimento.ItemID].Unidade,
+			}
+		}
+		saldo.Quantidade += movimento.Quantidade
+		saldos[movimento.ItemID] = saldo
+	}
+
+	lista := slices.Collect(maps.Values(saldos))
+	slices.SortFunc(lista, func(a, b models.SaldoEstoque) int { return cmp.Compare(a.ItemID, b.ItemID) })
+	return lista, nil
+}
@@ -0,0 +1,176 @@
+package repository
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+	"lanchonete/models"
+)
+
+type memoriaHamburguers struct {
+	s *memoriaStore
+}
+
+// montarHamburguer devolve o hambúrguer com a receita, os itens de cada ingrediente e a disponibilidade para venda
+func (d *dadosMemoria) montarHamburguer(hamburguer models.Hamburguer) models.Hamburguer {
+	hamburguer.HamburguerIngredientes = []models.HamburguerIngrediente{}
+	for _, ingrediente := range d.receitas[hamburguer.ID] {
+		ingrediente.Item = d.itens[ingrediente.ItemID]
+		hamburguer.HamburguerIngredientes = append(hamburguer.HamburguerIngredientes, ingrediente)
+	}
+	hamburguer.VerificarDisponibilidade()
+	return hamburguer
+}
+
+func (r memoriaHamburguers) Listar(filtro FiltroHamburguers) ([]models.Hamburguer, error) {
+	defer r.s.travar()()
+
+	d := r.s.dados
+	nome := strings.ToLower(filtro.Nome)
+
+	var hamburguers []models.Hamburguer
+	for _, hamburguer := range d.hamburguers {
+		if hamburguer.ID > filtro.AposID && hamburguer.DeletedAt.Valid == filtro.Removidos && strings.Contains(strings.ToLower(hamburguer.Descricao), nome) {
+			hamburguers = append(hamburguers, hamburguer)
+		}
+	}
+	slices.SortFunc(hamburguers, func(a, b models.Hamburguer) int { return cmp.Compare(a.ID, b.ID) })
+
+	if filtro.Limite > 0 && len(hamburguers) > filtro.Limite {
+		hamburguers = hamburguers[:filtro.Limite]
+	}
+	for i := range hamburguers {
+		hamburguers[i] = d.montarHamburguer(hamburguers[i])
+	}
+	return hamburguers, nil
+}
+
+func (r memoriaHamburguers) Buscar(id uint) (models.Hamburguer, error) {
+	defer r.s.travar()()
+
+	hamburguer, ok := r.s.dados.hamburguers[id]
+	if !ok || hamburguer.DeletedAt.Valid {
+		return models.Hamburguer{}, ErrNaoEncontrado
+	}
+	return r.s.dados.montarHamburguer(hamburguer), nil
+}
+
+func (r memoriaHamburguers) Criar(hamburguer *models.Hamburguer) error {
+	defer r.s.travar()()
+
+	if _, existe := r.s.dados.hamburguers[hamburguer.ID]; existe {
+		return ErrConflito
+	}
+	return r.s.dados.gravarHamburguer(hamburguer)
+}
+
+func (r memoriaHamburguers) Salvar(hamburguer *models.Hamburguer) error {
+	defer r.s.travar()()
+
+	return r.s.dados.gravarHamburguer(hamburguer)
+}
+
+// gravarHamburguer grava o hambúrguer e substitui a receita, recusando ingredientes inexistentes
+func (d *dadosMemoria) gravarHamburguer(hamburguer *models.Hamburguer) error {
+	receita := make([]models.HamburguerIngrediente, 0, len(hamburguer.HamburguerIngredientes))
+	for i := range hamburguer.HamburguerIngredientes {
+		ingrediente := &hamburguer.HamburguerIngredientes[i]
+		if _, existe := d.itens[ingrediente.ItemID]; !existe {
+			return ErrConflito
+		}
+		if slices.ContainsFunc(receita, func(i models.HamburguerIngrediente) bool { return i.ItemID == ingrediente.ItemID }) {
+			return ErrConflito
+		}
+		ingrediente.HamburguerID = hamburguer.ID
+		receita = append(receita, models.HamburguerIngrediente{
+			HamburguerID: hamburguer.ID,
+			ItemID:       ingrediente.ItemID,
+			Quantidade:   ingrediente.Quantidade,
+		})
+	}
+
+	semReceita := *hamburguer
+	semReceita.HamburguerIngredientes = nil
+	semReceita.Ingredientes = nil
+	semReceita.DisponivelParaVenda = nil
+	semReceita.IngredientesEmFalta = nil
+	d.hamburguers[hamburguer.ID] = semReceita
+	d.receitas[hamburguer.ID] = receita
+	return nil
+}
+
+func (r memoriaHamburguers) Remover(id uint) error {
+	defer r.s.travar()()
+
+	hamburguer, existe := r.s.dados.hamburguers[id]
+	if !existe || hamburguer.DeletedAt.Valid {
+		return ErrNaoEncontrado
+	}
+	hamburguer.DeletedAt = removidoAgora()
+	r.s.dados.hamburguers[id] = hamburguer
+	return nil
+}
+
+func (r memoriaHamburguers) Restaurar(id uint) error {
+	defer r.s.travar()()
+
+	d := r.s.dados
+	hamburguer, existe := d.hamburguers[id]
+	if !existe || !hamburguer.DeletedAt.Valid {
+		return ErrNaoEncontrado
+	}
+	for _, ingrediente := range d.receitas[id] {
+		if d.itens[ingrediente.ItemID].DeletedAt.Valid {
+			return ErrConflito
+		}
+	}
+
+	hamburguer.DeletedAt = gorm.DeletedAt{}
+	d.hamburguers[id] = hamburguer
+	return nil
+}
+
+func (r memoriaHamburguers) DefinirDisponibilidade(id uint, disponivel bool) error {
+	defer r.s.travar()()
+
+	hamburguer, existe := r.s.dados.hamburguers[id]
+	if !existe || hamburguer.DeletedAt.Valid {
+		return ErrNaoEncontrado
+	}
+	hamburguer.Disponivel = disponivel
+	r.s.dados.hamburguers[id] = hamburguer
+	return nil
+}
+
+func (r memoriaHamburguers) Expurgar(antes time.Time) (int64, error) {
+	defer r.s.travar()()
+
+	d := r.s.dados
+	var apagados int64
+	for id, hamburguer := range d.hamburguers {
+		if !removidoAntes(hamburguer.DeletedAt, antes) ||
+			slices.ContainsFunc(d.linhasHamburguer, func(l models.PedidoHamburguer) bool { return l.HamburguerID == id }) ||
+			d.emPromocao(func(p models.Promocao) *uint { return p.HamburguerID }, id) {
+			continue
+		}
+		delete(d.hamburguers, id)
+		delete(d.receitas, id)
+		apagados++
+	}
+	return apagados, nil
+}
+
+func (r memoriaHamburguers) EmPedidosAbertos(id uint) (bool, error) {
+	defer r.s.travar()()
+
+	d := r.s.dados
+	for _, linha := range d.linhasHamburguer {
+		if linha.HamburguerID == id && d.pedidoAberto(linha.PedidoID) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
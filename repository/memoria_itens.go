@@ -0,0 +1,162 @@
+package repository
+
+import (
+	"cmp"
+	"slices"
+	"time"
+
+	"gorm.io/gorm"
+	"lanchonete/models"
+)
+
+type memoriaItens struct {
+	s *memoriaStore
+}
+
+func (r memoriaItens) Listar(filtro FiltroItens) ([]models.Item, error) {
+	defer r.s.travar()()
+
+	var itens []models.Item
+	for _, item := range r.s.dados.itens {
+		if (filtro.Tipo == "" || item.Tipo == filtro.Tipo) && item.DeletedAt.Valid == filtro.Removidos && item.ID > filtro.AposID {
+			itens = append(itens, item)
+		}
+	}
+	slices.SortFunc(itens, func(a, b models.Item) int { return cmp.Compare(a.ID, b.ID) })
+
+	if filtro.Limite > 0 && len(itens) > filtro.Limite {
+		itens = itens[:filtro.Limite]
+	}
+	return itens, nil
+}
+
+func (r memoriaItens) Buscar(id uint) (models.Item, error) {
+	defer r.s.travar()()
+
+	item, ok := r.s.dados.itens[id]
+	if !ok || item.DeletedAt.Valid {
+		return models.Item{}, ErrNaoEncontrado
+	}
+	return item, nil
+}
+
+func (r memoriaItens) Criar(item *models.Item) error {
+	defer r.s.travar()()
+
+	if _, existe := r.s.dados.itens[item.ID]; existe {
+		return ErrConflito
+	}
+	r.s.dados.itens[item.ID] = *item
+	return nil
+}
+
+func (r memoriaItens) Salvar(item *models.Item) error {
+	defer r.s.travar()()
+
+	r.s.dados.itens[item.ID] = *item
+	return nil
+}
+
+func (r memoriaItens) Remover(id uint) error {
+	defer r.s.travar()()
+
+	d := r.s.dados
+	item, existe := d.itens[id]
+	if !existe || item.DeletedAt.Valid {
+		return ErrNaoEncontrado
+	}
+	for hamburguerID, receita := range d.receitas {
+		if !d.hamburguers[hamburguerID].DeletedAt.Valid && d.naReceita(receita, id) {
+			return ErrConflito
+		}
+	}
+
+	item.DeletedAt = removidoAgora()
+	d.itens[id] = item
+	return nil
+}
+
+func (r memoriaItens) Restaurar(id uint) error {
+	defer r.s.travar()()
+
+	item, existe := r.s.dados.itens[id]
+	if !existe || !item.DeletedAt.Valid {
+		return ErrNaoEncontrado
+	}
+	item.DeletedAt = gorm.DeletedAt{}
+	r.s.dados.itens[id] = item
+	return nil
+}
+
+func (r memoriaItens) DefinirDisponibilidade(id uint, disponivel bool) error {
+	defer r.s.travar()()
+
+	item, existe := r.s.dados.itens[id]
+	if !existe || item.DeletedAt.Valid {
+		return ErrNaoEncontrado
+	}
+	item.Disponivel = disponivel
+	r.s.dados.itens[id] = item
+	return nil
+}
+
+func (r memoriaItens) Expurgar(antes time.Time) (int64, error) {
+	defer r.s.travar()()
+
+	d := r.s.dados
+	var apagados int64
+	for id, item := range d.itens {
+		if removidoAntes(item.DeletedAt, antes) && !d.itemReferenciado(id) {
+			delete(d.itens, id)
+			delete(d.estoques, id) // ON DELETE CASCADE
+			apagados++
+		}
+	}
+	return apagados, nil
+}
+
+func (d *dadosMemoria) naReceita(receita []models.HamburguerIngrediente, id uint) bool {
+	return slices.ContainsFunc(receita, func(i models.HamburguerIngrediente) bool { return i.ItemID == id })
+}
+
+// itemReferenciado aplica as mesmas restrições das chaves estrangeiras do banco
+func (d *dadosMemoria) itemReferenciado(id uint) bool {
+	for _, receita := range d.receitas {
+		if d.naReceita(receita, id) {
+			return true
+		}
+	}
+	if slices.ContainsFunc(d.linhasBebida, func(l models.PedidoBebida) bool { return l.ItemID == id }) {
+		return true
+	}
+	for _, linha := range d.linhasHamburguer {
+		if slices.ContainsFunc(linha.Personalizacoes, func(p models.PedidoHamburguerPersonalizacao) bool { return p.ItemID == id }) {
+			return true
+		}
+	}
+	if d.emPromocao(func(p models.Promocao) *uint { return p.BebidaID }, id) {
+		return true
+	}
+	return slices.ContainsFunc(d.movimentos, func(m models.MovimentoEstoque) bool { return m.ItemID == id })
+}
+
+func (r memoriaItens) EmPedidosAbertos(id uint) (bool, error) {
+	defer r.s.travar()()
+
+	d := r.s.dados
+	for _, linha := range d.linhasBebida {
+		if linha.ItemID == id && d.pedidoAberto(linha.PedidoID) {
+			return true, nil
+		}
+	}
+	for _, linha := range d.linhasHamburguer {
+		if !d.pedidoAberto(linha.PedidoID) {
+			continue
+		}
+		if d.naReceita(d.receitas[linha.HamburguerID], id) ||
+			slices.ContainsFunc(linha.Personalizacoes, func(p models.PedidoHamburguerPersonalizacao) bool { return p.ItemID == id }) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,396 @@
+package repository
+
+import (
+	"bytes"
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"lanchonete/models"
+)
+
+type memoriaPedidos struct {
+	s *memoriaStore
+}
+
+func (d *dadosMemoria) pedidoAberto(id uuid.UUID) bool {
+	pedido, ok := d.pedidos[id]
+	return ok && !pedido.DeletedAt.Valid && !slices.Contains(models.StatusEncerrados, pedido.Status)
+}
+
+// montarPedido devolve o pedido com as linhas, as personalizações, os produtos de cada uma e os descontos
+func (d *dadosMemoria) montarPedido(pedido models.Pedido) models.Pedido {
+	pedido.PedidoHamburgueres = []models.PedidoHamburguer{}
+	for _, linha := range d.linhasHamburguer {
+		if linha.PedidoID != pedido.ID {
+			continue
+		}
+		linha.Hamburguer = d.hamburguers[linha.HamburguerID]
+		linha.Personalizacoes = slices.Clone(linha.Personalizacoes)
+		for i := range linha.Personalizacoes {
+			linha.Personalizacoes[i].Item = d.itens[linha.Personalizacoes[i].ItemID]
+		}
+		pedido.PedidoHamburgueres = append(pedido.PedidoHamburgueres, linha)
+	}
+
+	pedido.PedidoBebidas = []models.PedidoBebida{}
+	for _, linha := range d.linhasBebida {
+		if linha.PedidoID == pedido.ID {
+			linha.Bebida = d.itens[linha.ItemID]
+			pedido.PedidoBebidas = append(pedido.PedidoBebidas, linha)
+		}
+	}
+
+	pedido.Descontos = []models.PedidoDesconto{}
+	for _, desconto := range d.descontos {
+		if desconto.PedidoID == pedido.ID {
+			pedido.Descontos = append(pedido.Descontos, desconto)
+		}
+	}
+	return pedido
+}
+
+// valorOrdem retorna o valor do pedido na coluna de ordenação, no mesmo tipo usado em PosicaoPedido
+func valorOrdem(pedido models.Pedido, ordem string) any {
+	switch ordem {
+	case "valor_total":
+		return pedido.ValorTotal
+	case "nome":
+		return pedido.Nome
+	case "status":
+		return string(pedido.Status)
+	}
+	return pedido.Data
+}
+
+func compararValores(a, b any) int {
+	switch x := a.(type) {
+	case time.Time:
+		if y, ok := b.(time.Time); ok {
+			return x.Compare(y)
+		}
+	case models.Dinheiro:
+		if y, ok := b.(models.Dinheiro); ok {
+			return cmp.Compare(x, y)
+		}
+	case string:
+		if y, ok := b.(string); ok {
+			return strings.Compare(x, y)
+		}
+	}
+	return 0
+}
+
+func (r memoriaPedidos) Listar(filtro FiltroPedidos) ([]models.Pedido, error) {
+	defer r.s.travar()()
+
+	ordem := filtro.Ordem
+	if ordem == "" {
+		ordem = "data"
+	}
+	if !slices.Contains(OrdensPedido, ordem) {
+		return nil, fmt.Errorf("ordenação de pedidos inválida: %s", ordem)
+	}
+
+	// comparar ordena pela coluna e desempata pelo ID, como a chave (coluna, id) do banco
+	comparar := func(valor any, id uuid.UUID, outroValor any, outroID uuid.UUID) int {
+		resultado := compararValores(valor, outroValor)
+		if resultado == 0 {
+			resultado = bytes.Compare(id[:], outroID[:])
+		}
+		if filtro.Desc {
+			return -resultado
+		}
+		return resultado
+	}
+
+	d := r.s.dados
+	nome := strings.ToLower(filtro.Nome)
+
+	var pedidos []models.Pedido
+	for _, pedido := range d.pedidos {
+		switch {
+		case pedido.DeletedAt.Valid != filtro.Removidos,
+			len(filtro.Status) > 0 && !slices.Contains(filtro.Status, pedido.Status),
+			filtro.Tipo != "" && pedido.Tipo != filtro.Tipo,
+			filtro.Abertos && slices.Contains(models.StatusEncerrados, pedido.Status),
+			filtro.DataInicio != nil && pedido.Data.Before(*filtro.DataInicio),
+			filtro.DataFim != nil && !pedido.Data.Before(*filtro.DataFim),
+			filtro.Telefone != "" && pedido.Telefone != filtro.Telefone,
+			!strings.Contains(strings.ToLower(pedido.Nome), nome),
+			filtro.Apos != nil && comparar(valorOrdem(pedido, ordem), pedido.ID, filtro.Apos.Valor, filtro.Apos.ID) <= 0:
+			continue
+		}
+		pedidos = append(pedidos, pedido)
+	}
+
+	slices.SortFunc(pedidos, func(a, b models.Pedido) int {
+		return comparar(valorOrdem(a, ordem), a.ID, valorOrdem(b, ordem), b.ID)
+	})
+
+	if filtro.Limite > 0 && len(pedidos) > filtro.Limite {
+		pedidos = pedidos[:filtro.Limite]
+	}
+	for i := range pedidos {
+		pedidos[i] = d.montarPedido(pedidos[i])
+	}
+	return pedidos, nil
+}
+
+func (r memoriaPedidos) Buscar(id uuid.UUID) (models.Pedido, error) {
+	defer r.s.travar()()
+
+	pedido, ok := r.s.dados.pedidos[id]
+	if !ok || pedido.DeletedAt.Valid {
+		return models.Pedido{}, ErrNaoEncontrado
+	}
+	return r.s.dados.montarPedido(pedido), nil
+}
+
+func (r memoriaPedidos) BuscarParaAtualizar(id uuid.UUID) (models.Pedido, error) {
+	defer r.s.travar()()
+
+	pedido, ok := r.s.dados.pedidos[id]
+	if !ok || pedido.DeletedAt.Valid {
+		return models.Pedido{}, ErrNaoEncontrado
+	}
+	return pedido, nil
+}
+
+func (r memoriaPedidos) Historico(id uuid.UUID) ([]models.PedidoStatusHistorico, error) {
+	defer r.s.travar()()
+
+	var historico []models.PedidoStatusHistorico
+	for _, etapa := range r.s.dados.historico {
+		if etapa.PedidoID == id {
+			historico = append(historico, etapa)
+		}
+	}
+	slices.SortStableFunc(historico, func(a, b models.PedidoStatusHistorico) int {
+		return cmp.Or(a.Data.Compare(b.Data), cmp.Compare(a.ID, b.ID))
+	})
+	return historico, nil
+}
+
+func (r memoriaPedidos) RegistrarHistorico(etapa *models.PedidoStatusHistorico) error {
+	defer r.s.travar()()
+
+	d := r.s.dados
+	if _, existe := d.pedidos[etapa.PedidoID]; !existe {
+		return ErrConflito
+	}
+	if etapa.Data.IsZero() {
+		etapa.Data = time.Now()
+	}
+	etapa.ID = d.proximoID()
+	d.historico = append(d.historico, *etapa)
+	return nil
+}
+
+// dadosPedido remove do pedido os relacionamentos, que são guardados em tabelas próprias
+func dadosPedido(pedido models.Pedido) models.Pedido {
+	pedido.Bebidas = nil
+	pedido.PedidoHamburgueres = nil
+	pedido.PedidoBebidas = nil
+	pedido.Descontos = nil
+	pedido.Historico = nil
+	return pedido
+}
+
+func (r memoriaPedidos) Criar(pedido *models.Pedido) error {
+	defer r.s.travar()()
+
+	// Mesmos valores padrão das colunas do banco
+	if pedido.ID == uuid.Nil {
+		pedido.ID = uuid.New()
+	}
+	if pedido.Data.IsZero() {
+		pedido.Data = time.Now()
+	}
+	if pedido.Status == "" {
+		pedido.Status = models.StatusStarted
+	}
+	if pedido.Tipo == "" {
+		pedido.Tipo = models.TipoDelivery
+	}
+
+	if _, existe := r.s.dados.pedidos[pedido.ID]; existe {
+		return ErrConflito
+	}
+	if !r.referenciasExistem(*pedido) {
+		return ErrConflito
+	}
+	r.s.dados.pedidos[pedido.ID] = dadosPedido(*pedido)
+	return nil
+}
+
+func (r memoriaPedidos) Salvar(pedido *models.Pedido) error {
+	defer r.s.travar()()
+
+	if !r.referenciasExistem(*pedido) {
+		return ErrConflito
+	}
+	r.s.dados.pedidos[pedido.ID] = dadosPedido(*pedido)
+	return nil
+}
+
+// referenciasExistem confere as chaves estrangeiras do pedido: o cliente e a zona de entrega
+func (r memoriaPedidos) referenciasExistem(pedido models.Pedido) bool {
+	if _, existe := r.s.dados.clientes[pedido.Telefone]; !existe {
+		return false
+	}
+	if pedido.ZonaEntregaID != nil {
+		if _, existe := r.s.dados.zonas[*pedido.ZonaEntregaID]; !existe {
+			return false
+		}
+	}
+	return true
+}
+
+func (r memoriaPedidos) AdicionarHamburguer(linha *models.PedidoHamburguer) error {
+	defer r.s.travar()()
+
+	d := r.s.dados
+	if _, existe := d.pedidos[linha.PedidoID]; !existe {
+		return ErrConflito
+	}
+	if _, existe := d.hamburguers[linha.HamburguerID]; !existe {
+		return ErrConflito
+	}
+	for _, personalizacao := range linha.Personalizacoes {
+		if _, existe := d.itens[personalizacao.ItemID]; !existe {
+			return ErrConflito
+		}
+	}
+
+	linha.ID = d.proximoID()
+	gravada := *linha
+	gravada.Hamburguer = models.Hamburguer{}
+	gravada.Personalizacoes = make([]models.PedidoHamburguerPersonalizacao, len(linha.Personalizacoes))
+	for i := range linha.Personalizacoes {
+		linha.Personalizacoes[i].ID = d.proximoID()
+		linha.Personalizacoes[i].PedidoHamburguerID = linha.ID
+		gravada.Personalizacoes[i] = linha.Personalizacoes[i]
+		gravada.Personalizacoes[i].Item = models.Item{}
+	}
+	d.linhasHamburguer = append(d.linhasHamburguer, gravada)
+	return nil
+}
+
+func (r memoriaPedidos) AdicionarBebida(linha *models.PedidoBebida) error {
+	defer r.s.travar()()
+
+	d := r.s.dados
+	if _, existe := d.pedidos[linha.PedidoID]; !existe {
+		return ErrConflito
+	}
+	if _, existe := d.itens[linha.ItemID]; !existe {
+		return ErrConflito
+	}
+	if slices.ContainsFunc(d.linhasBebida, func(l models.PedidoBebida) bool {
+		return l.PedidoID == linha.PedidoID && l.ItemID == linha.ItemID
+	}) {
+		return ErrConflito
+	}
+
+	gravada := *linha
+	gravada.Bebida = models.Item{}
+	d.linhasBebida = append(d.linhasBebida, gravada)
+	return nil
+}
+
+func (r memoriaPedidos) RemoverHamburgueres(pedidoID uuid.UUID) error {
+	defer r.s.travar()()
+
+	d := r.s.dados
+	d.linhasHamburguer = slices.DeleteFunc(d.linhasHamburguer, func(l models.PedidoHamburguer) bool { return l.PedidoID == pedidoID })
+	return nil
+}
+
+func (r memoriaPedidos) RemoverBebidas(pedidoID uuid.UUID) error {
+	defer r.s.travar()()
+
+	d := r.s.dados
+	d.linhasBebida = slices.DeleteFunc(d.linhasBebida, func(l models.PedidoBebida) bool { return l.PedidoID == pedidoID })
+	return nil
+}
+
+func (r memoriaPedidos) AdicionarDesconto(desconto *models.PedidoDesconto) error {
+	defer r.s.travar()()
+
+	d := r.s.dados
+	if _, existe := d.pedidos[desconto.PedidoID]; !existe {
+		return ErrConflito
+	}
+	if desconto.PromocaoID != nil {
+		if _, existe := d.promocoes[*desconto.PromocaoID]; !existe {
+			return ErrConflito
+		}
+	}
+
+	desconto.ID = d.proximoID()
+	d.descontos = append(d.descontos, *desconto)
+	return nil
+}
+
+func (r memoriaPedidos) RemoverDescontos(pedidoID uuid.UUID) error {
+	defer r.s.travar()()
+
+	d := r.s.dados
+	d.descontos = slices.DeleteFunc(d.descontos, func(desconto models.PedidoDesconto) bool { return desconto.PedidoID == pedidoID })
+	return nil
+}
+
+func (r memoriaPedidos) Remover(id uuid.UUID) error {
+	defer r.s.travar()()
+
+	pedido, existe := r.s.dados.pedidos[id]
+	if !existe || pedido.DeletedAt.Valid {
+		return ErrNaoEncontrado
+	}
+	pedido.DeletedAt = removidoAgora()
+	r.s.dados.pedidos[id] = pedido
+	return nil
+}
+
+func (r memoriaPedidos) Restaurar(id uuid.UUID) error {
+	defer r.s.travar()()
+
+	pedido, existe := r.s.dados.pedidos[id]
+	if !existe || !pedido.DeletedAt.Valid {
+		return ErrNaoEncontrado
+	}
+	pedido.DeletedAt = gorm.DeletedAt{}
+	r.s.dados.pedidos[id] = pedido
+	return nil
+}
+
+func (r memoriaPedidos) Expurgar(antes time.Time) (int64, error) {
+	defer r.s.travar()()
+
+	d := r.s.dados
+	expurgados := map[uuid.UUID]bool{}
+	for id, pedido := range d.pedidos {
+		if removidoAntes(pedido.DeletedAt, antes) {
+			expurgados[id] = true
+			delete(d.pedidos, id)
+		}
+	}
+
+	d.linhasHamburguer = slices.DeleteFunc(d.linhasHamburguer, func(l models.PedidoHamburguer) bool { return expurgados[l.PedidoID] })
+	d.linhasBebida = slices.DeleteFunc(d.linhasBebida, func(l models.PedidoBebida) bool { return expurgados[l.PedidoID] })
+	d.descontos = slices.DeleteFunc(d.descontos, func(desconto models.PedidoDesconto) bool { return expurgados[desconto.PedidoID] })
+	d.historico = slices.DeleteFunc(d.historico, func(h models.PedidoStatusHistorico) bool { return expurgados[h.PedidoID] })
+
+	// Os movimentos de estoque são mantidos sem o vínculo, como no ON DELETE SET NULL
+	for i, movimento := range d.movimentos {
+		if movimento.PedidoID != nil && expurgados[*movimento.PedidoID] {
+			d.movimentos[i].PedidoID = nil
+		}
+	}
+	return int64(len(expurgados)), nil
+}
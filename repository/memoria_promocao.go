@@ -0,0 +1,157 @@
+package repository
+
+import (
+	"cmp"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"lanchonete/models"
+)
+
+type memoriaPromocoes struct {
+	s *memoriaStore
+}
+
+func (r memoriaPromocoes) Listar(filtro FiltroPromocoes) ([]models.Promocao, error) {
+	defer r.s.travar()()
+
+	var promocoes []models.Promocao
+	for _, promocao := range r.s.dados.promocoes {
+		if promocao.ID > filtro.AposID && (!filtro.Ativas || promocao.Ativa) {
+			promocoes = append(promocoes, promocao)
+		}
+	}
+	slices.SortFunc(promocoes, func(a, b models.Promocao) int { return cmp.Compare(a.ID, b.ID) })
+
+	if filtro.Limite > 0 && len(promocoes) > filtro.Limite {
+		promocoes = promocoes[:filtro.Limite]
+	}
+	return promocoes, nil
+}
+
+func (r memoriaPromocoes) Buscar(id uint) (models.Promocao, error) {
+	defer r.s.travar()()
+
+	promocao, ok := r.s.dados.promocoes[id]
+	if !ok {
+		return models.Promocao{}, ErrNaoEncontrado
+	}
+	return promocao, nil
+}
+
+func (r memoriaPromocoes) BuscarCupom(cupom string) (models.Promocao, error) {
+	defer r.s.travar()()
+
+	for _, promocao := range r.s.dados.promocoes {
+		if cupom != "" && promocao.Cupom == cupom {
+			return promocao, nil
+		}
+	}
+	return models.Promocao{}, ErrNaoEncontrado
+}
+
+// BuscarParaAtualizar não precisa bloquear nada: as transações em memória já são serializadas
+func (r memoriaPromocoes) BuscarParaAtualizar(id uint) (models.Promocao, error) {
+	return r.Buscar(id)
+}
+
+func (r memoriaPromocoes) Criar(promocao *models.Promocao) error {
+	defer r.s.travar()()
+
+	d := r.s.dados
+	if !r.valida(*promocao) {
+		return ErrConflito
+	}
+	promocao.ID = d.proximoID()
+	promocao.AtualizadoEm = time.Now()
+	d.promocoes[promocao.ID] = *promocao
+	return nil
+}
+
+func (r memoriaPromocoes) Salvar(promocao *models.Promocao) error {
+	defer r.s.travar()()
+
+	if !r.valida(*promocao) {
+		return ErrConflito
+	}
+	promocao.AtualizadoEm = time.Now()
+	r.s.dados.promocoes[promocao.ID] = *promocao
+	return nil
+}
+
+func (r memoriaPromocoes) Remover(id uint) error {
+	defer r.s.travar()()
+
+	d := r.s.dados
+	if _, ok := d.promocoes[id]; !ok {
+		return ErrNaoEncontrado
+	}
+	delete(d.promocoes, id)
+
+	// Como o ON DELETE SET NULL da chave estrangeira
+	for i, desconto := range d.descontos {
+		if desconto.PromocaoID != nil && *desconto.PromocaoID == id {
+			d.descontos[i].PromocaoID = nil
+		}
+	}
+	return nil
+}
+
+func (r memoriaPromocoes) Usos(id uint, telefone string, exceto uuid.UUID) (total, doCliente int64, err error) {
+	defer r.s.travar()()
+
+	d := r.s.dados
+	contados := map[uuid.UUID]bool{}
+	for _, desconto := range d.descontos {
+		pedido, ok := d.pedidos[desconto.PedidoID]
+		switch {
+		case desconto.PromocaoID == nil || *desconto.PromocaoID != id,
+			!ok || pedido.DeletedAt.Valid || pedido.Status == models.StatusCancelled,
+			pedido.ID == exceto || contados[pedido.ID]:
+			continue
+		}
+
+		contados[pedido.ID] = true
+		total++
+		if pedido.Telefone == telefone {
+			doCliente++
+		}
+	}
+	return total, doCliente, nil
+}
+
+// valida confere as chaves únicas do nome e do cupom, ignorando a própria promoção,
+// e as chaves estrangeiras do hambúrguer e da bebida
+func (r memoriaPromocoes) valida(promocao models.Promocao) bool {
+	d := r.s.dados
+	for _, outra := range d.promocoes {
+		if outra.ID == promocao.ID {
+			continue
+		}
+		if outra.Nome == promocao.Nome || (promocao.Cupom != "" && outra.Cupom == promocao.Cupom) {
+			return false
+		}
+	}
+	if promocao.HamburguerID != nil {
+		if _, existe := d.hamburguers[*promocao.HamburguerID]; !existe {
+			return false
+		}
+	}
+	if promocao.BebidaID != nil {
+		if _, existe := d.itens[*promocao.BebidaID]; !existe {
+			return false
+		}
+	}
+	return true
+}
+
+// emPromocao indica se alguma promoção referencia o ID no campo retornado por campo
+func (d *dadosMemoria) emPromocao(campo func(models.Promocao) *uint, id uint) bool {
+	for _, promocao := range d.promocoes {
+		if referencia := campo(promocao); referencia != nil && *referencia == id {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"errors"
+	"testing"
+
+	"lanchonete/models"
+)
+
+func TestTransacaoDesfazAlteracoesComErro(t *testing.T) {
+	store := NewMemoriaStore()
+	falha := errors.New("falha")
+
+	err := store.Transacao(func(tx Store) error {
+		if err := tx.Itens().Criar(&models.Item{ID: 1, Tipo: models.TipoIngrediente, Descricao: "Pão"}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tx.Itens().Buscar(1); err != nil {
+			t.Fatalf("item criado não é visto dentro da transação: %v", err)
+		}
+		return falha
+	})
+	if !errors.Is(err, falha) {
+		t.Fatalf("erro %v, esperado o erro de fn", err)
+	}
+	if _, err := store.Itens().Buscar(1); !errors.Is(err, ErrNaoEncontrado) {
+		t.Errorf("item da transação desfeita: erro %v, esperado ErrNaoEncontrado", err)
+	}
+
+	err = store.Transacao(func(tx Store) error {
+		return tx.Itens().Criar(&models.Item{ID: 1, Tipo: models.TipoIngrediente, Descricao: "Pão"})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.Itens().Buscar(1); err != nil {
+		t.Errorf("item da transação confirmada: %v", err)
+	}
+}
+
+func TestRemocaoLogicaDeItens(t *testing.T) {
+	store := NewMemoriaStore()
+	for _, item := range []models.Item{
+		{ID: 1, Tipo: models.TipoIngrediente, Descricao: "Pão"},
+		{ID: 2, Tipo: models.TipoIngrediente, Descricao: "Queijo"},
+	} {
+		if err := store.Itens().Criar(&item); err != nil {
+			t.Fatal(err)
+		}
+	}
+	hamburguer := models.Hamburguer{
+		ID:                     1,
+		Descricao:              "X-Burguer",
+		HamburguerIngredientes: []models.HamburguerIngrediente{{HamburguerID: 1, ItemID: 1, Quantidade: 1}},
+	}
+	if err := store.Hamburguers().Criar(&hamburguer); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := store.Itens().Remover(1); !errors.Is(err, ErrConflito) {
+		t.Errorf("remover item de receita ativa: erro %v, esperado ErrConflito", err)
+	}
+
+	if err := store.Itens().Remover(2); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.Itens().Buscar(2); !errors.Is(err, ErrNaoEncontrado) {
+		t.Errorf("buscar item removido: erro %v, esperado ErrNaoEncontrado", err)
+	}
+	removidos, err := store.Itens().Listar(FiltroItens{Removidos: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(removidos) != 1 || removidos[0].ID != 2 {
+		t.Errorf("itens removidos = %+v, esperado apenas o 2", removidos)
+	}
+
+	if err := store.Itens().Restaurar(2); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.Itens().Buscar(2); err != nil {
+		t.Errorf("buscar item restaurado: %v", err)
+	}
+	if err := store.Itens().Restaurar(2); !errors.Is(err, ErrNaoEncontrado) {
+		t.Errorf("restaurar item ativo: erro %v, esperado ErrNaoEncontrado", err)
+	}
+}
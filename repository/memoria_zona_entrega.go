@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"cmp"
+	"slices"
+	"time"
+
+	"lanchonete/models"
+)
+
+type memoriaZonasEntrega struct {
+	s *memoriaStore
+}
+
+func (r memoriaZonasEntrega) Listar(filtro FiltroZonas) ([]models.ZonaEntrega, error) {
+	defer r.s.travar()()
+
+	var zonas []models.ZonaEntrega
+	for _, zona := range r.s.dados.zonas {
+		if zona.ID > filtro.AposID && (!filtro.Ativas || zona.Ativa) {
+			zonas = append(zonas, zona)
+		}
+	}
+	slices.SortFunc(zonas, func(a, b models.ZonaEntrega) int { return cmp.Compare(a.ID, b.ID) })
+
+	if filtro.Limite > 0 && len(zonas) > filtro.Limite {
+		zonas = zonas[:filtro.Limite]
+	}
+	return zonas, nil
+}
+
+func (r memoriaZonasEntrega) Buscar(id uint) (models.ZonaEntrega, error) {
+	defer r.s.travar()()
+
+	zona, ok := r.s.dados.zonas[id]
+	if !ok {
+		return models.ZonaEntrega{}, ErrNaoEncontrado
+	}
+	return zona, nil
+}
+
+func (r memoriaZonasEntrega) Criar(zona *models.ZonaEntrega) error {
+	defer r.s.travar()()
+
+	d := r.s.dados
+	if r.nomeEmUso(zona.Nome, 0) {
+		return ErrConflito
+	}
+	zona.ID = d.proximoID()
+	zona.AtualizadoEm = time.Now()
+	d.zonas[zona.ID] = *zona
+	return nil
+}
+
+func (r memoriaZonasEntrega) Salvar(zona *models.ZonaEntrega) error {
+	defer r.s.travar()()
+
+	if r.nomeEmUso(zona.Nome, zona.ID) {
+		return ErrConflito
+	}
+	zona.AtualizadoEm = time.Now()
+	r.s.dados.zonas[zona.ID] = *zona
+	return nil
+}
+
+func (r memoriaZonasEntrega) Remover(id uint) error {
+	defer r.s.travar()()
+
+	d := r.s.dados
+	if _, ok := d.zonas[id]; !ok {
+		return ErrNaoEncontrado
+	}
+	delete(d.zonas, id)
+
+	// Como o ON DELETE SET NULL da chave estrangeira
+	for pedidoID, pedido := range d.pedidos {
+		if pedido.ZonaEntregaID != nil && *pedido.ZonaEntregaID == id {
+			pedido.ZonaEntregaID = nil
+			d.pedidos[pedidoID] = pedido
+		}
+	}
+	return nil
+}
+
+// nomeEmUso confere a chave única do nome, ignorando a própria zona
+func (r memoriaZonasEntrega) nomeEmUso(nome string, id uint) bool {
+	for _, zona := range r.s.dados.zonas {
+		if zona.Nome == nome && zona.ID != id {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,177 @@
+package repository
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"lanchonete/database"
+	"lanchonete/models"
+)
+
+// gormDeTeste abre TEST_DATABASE_URL com um schema exclusivo e migrado, removido no fim do teste
+func gormDeTeste(t *testing.T) Store {
+	t.Helper()
+
+	dsn := os.Getenv("TEST_DATABASE_URL")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_URL não definida")
+	}
+
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Uma única conexão, para que o search_path valha em todas as consultas
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+
+	schema := fmt.Sprintf("teste_pedidos_%d", time.Now().UnixNano())
+	if err := db.Exec("CREATE SCHEMA " + schema).Error; err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		db.Exec("DROP SCHEMA " + schema + " CASCADE")
+		sqlDB.Close()
+	})
+	if err := db.Exec("SET search_path TO " + schema).Error; err != nil {
+		t.Fatal(err)
+	}
+	if _, err := database.MigrateUp(db); err != nil {
+		t.Fatal(err)
+	}
+
+	return NewGormStore(db)
+}
+
+func idPedido(n int) uuid.UUID {
+	return uuid.MustParse(fmt.Sprintf("00000000-0000-0000-0000-%012d", n))
+}
+
+// pedidosParaListar grava os mesmos pedidos em qualquer store, com IDs fixos para que o
+// desempate seja igual em todos. Os nomes só diferem depois da inicial maiúscula, para
+// que a collation do banco ordene como a comparação de bytes da memória.
+func pedidosParaListar(t *testing.T, store Store) time.Time {
+	t.Helper()
+
+	for _, telefone := range []string{"11911111111", "11922222222", "11933333333"} {
+		cliente := models.Cliente{Telefone: telefone, Nome: "Cliente", Enderecos: []models.ClienteEndereco{}}
+		if err := store.Clientes().Criar(&cliente); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	dia := time.Date(2026, time.March, 10, 0, 0, 0, 0, time.UTC)
+	pedidos := []models.Pedido{
+		{ID: idPedido(1), Data: dia.Add(9 * time.Hour), Status: models.StatusStarted, Tipo: models.TipoDelivery, Nome: "Maria", Telefone: "11911111111", ValorTotal: models.Centavos(3000)},
+		{ID: idPedido(2), Data: dia.Add(12 * time.Hour), Status: models.StatusFinalized, Tipo: models.TipoPickup, Nome: "Marcos", Telefone: "11922222222", ValorTotal: models.Centavos(1500)},
+		{ID: idPedido(3), Data: dia.Add(12 * time.Hour), Status: models.StatusCancelled, Tipo: models.TipoDineIn, Mesa: 2, Nome: "Ana", Telefone: "11911111111", ValorTotal: models.Centavos(3000)},
+		{ID: idPedido(4), Data: dia.Add(34 * time.Hour), Status: models.StatusReadyForPickup, Tipo: models.TipoPickup, Nome: "Bruno", Telefone: "11922222222", ValorTotal: models.Centavos(4500)},
+		{ID: idPedido(5), Data: dia.Add(48*time.Hour - time.Second), Status: models.StatusDelivery, Tipo: models.TipoDelivery, Nome: "Mariana", Telefone: "11933333333", ValorTotal: models.Centavos(1500)},
+		{ID: idPedido(6), Data: dia.Add(48 * time.Hour), Status: models.StatusStarted, Tipo: models.TipoDineIn, Mesa: 1, Nome: "Ana", Telefone: "11933333333", ValorTotal: models.Centavos(3000)},
+		{ID: idPedido(7), Data: dia.Add(10 * time.Hour), Status: models.StatusStarted, Tipo: models.TipoPickup, Nome: "Maria", Telefone: "11911111111", ValorTotal: models.Centavos(3000)},
+	}
+	for i := range pedidos {
+		pedidos[i].Descricao = "Pedido de teste"
+		if err := store.Pedidos().Criar(&pedidos[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := store.Pedidos().Remover(idPedido(7)); err != nil {
+		t.Fatal(err)
+	}
+	return dia
+}
+
+type listagemPedidos struct {
+	nome      string
+	filtro    FiltroPedidos
+	esperados []int
+}
+
+func listagensPedidos(dia time.Time) []listagemPedidos {
+	inicio, fim := dia.AddDate(0, 0, 1), dia.AddDate(0, 0, 2)
+	return []listagemPedidos{
+		{"sem filtro", FiltroPedidos{}, []int{1, 2, 3, 4, 5, 6}},
+		{"status", FiltroPedidos{Status: models.StatusEncerrados}, []int{2, 3}},
+		{"tipo", FiltroPedidos{Tipo: models.TipoPickup}, []int{2, 4}},
+		{"abertos", FiltroPedidos{Abertos: true}, []int{1, 4, 5, 6}},
+		{"período com fim exclusivo", FiltroPedidos{DataInicio: &inicio, DataFim: &fim}, []int{4, 5}},
+		{"telefone", FiltroPedidos{Telefone: "11911111111"}, []int{1, 3}},
+		{"parte do nome", FiltroPedidos{Nome: "mar"}, []int{1, 2, 5}},
+		{"nome sem diferenciar maiúsculas", FiltroPedidos{Nome: "MARIA"}, []int{1, 5}},
+		{"removidos", FiltroPedidos{Removidos: true}, []int{7}},
+		{"data decrescente", FiltroPedidos{Desc: true}, []int{6, 5, 4, 3, 2, 1}},
+		{"valor total", FiltroPedidos{Ordem: "valor_total"}, []int{2, 5, 1, 3, 6, 4}},
+		{"valor total decrescente", FiltroPedidos{Ordem: "valor_total", Desc: true}, []int{4, 6, 3, 1, 5, 2}},
+		{"nome", FiltroPedidos{Ordem: "nome"}, []int{3, 6, 4, 2, 1, 5}},
+		{"status", FiltroPedidos{Ordem: "status"}, []int{3, 5, 2, 4, 1, 6}},
+		{"após o cursor", FiltroPedidos{Ordem: "valor_total", Apos: &PosicaoPedido{Valor: models.Centavos(3000), ID: idPedido(1)}}, []int{3, 6, 4}},
+		{"após o cursor decrescente", FiltroPedidos{Desc: true, Apos: &PosicaoPedido{Valor: dia.Add(12 * time.Hour), ID: idPedido(3)}}, []int{2, 1}},
+		{"filtros combinados", FiltroPedidos{Abertos: true, Telefone: "11933333333", Desc: true}, []int{6, 5}},
+		{"limite", FiltroPedidos{Limite: 2}, []int{1, 2}},
+	}
+}
+
+func conferirListagem(t *testing.T, store Store, listagem listagemPedidos) []uuid.UUID {
+	t.Helper()
+
+	pedidos, err := store.Pedidos().Listar(listagem.filtro)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ids []uuid.UUID
+	for _, pedido := range pedidos {
+		ids = append(ids, pedido.ID)
+	}
+
+	var esperados []uuid.UUID
+	for _, n := range listagem.esperados {
+		esperados = append(esperados, idPedido(n))
+	}
+	if !slices.Equal(ids, esperados) {
+		t.Errorf("Listar = %v, esperado %v", ids, esperados)
+	}
+	return ids
+}
+
+func TestListarPedidosFiltraEOrdena(t *testing.T) {
+	store := NewMemoriaStore()
+	dia := pedidosParaListar(t, store)
+
+	for _, listagem := range listagensPedidos(dia) {
+		t.Run(listagem.nome, func(t *testing.T) {
+			conferirListagem(t, store, listagem)
+		})
+	}
+
+	if _, err := store.Pedidos().Listar(FiltroPedidos{Ordem: "id; DROP TABLE pedidos"}); err == nil {
+		t.Error("ordenação fora de OrdensPedido aceita")
+	}
+}
+
+func TestListarPedidosNaMesmaOrdemNaMemoriaENoBanco(t *testing.T) {
+	banco := gormDeTeste(t)
+	memoria := NewMemoriaStore()
+	dia := pedidosParaListar(t, banco)
+	pedidosParaListar(t, memoria)
+
+	for _, listagem := range listagensPedidos(dia) {
+		t.Run(listagem.nome, func(t *testing.T) {
+			noBanco := conferirListagem(t, banco, listagem)
+			naMemoria := conferirListagem(t, memoria, listagem)
+			if !slices.Equal(noBanco, naMemoria) {
+				t.Errorf("banco %v, memória %v", noBanco, naMemoria)
+			}
+		})
+	}
+}
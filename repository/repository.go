@@ -0,0 +1,284 @@
+// Package repository isola o acesso aos dados dos controllers. Cada agregado
+// (itens, hambúrgueres, pedidos, estoque, clientes, zonas de entrega e promoções) tem uma interface com duas implementações:
+// uma sobre o GORM/Postgres e outra em memória, usada para exercitar as regras
+// de negócio sem banco de dados.
+//
+// Itens, hambúrgueres e pedidos são removidos logicamente: Remover preenche
+// DeletedAt, Listar e Buscar ignoram os removidos e Restaurar os traz de volta.
+// Expurgar apaga de vez os removidos há mais tempo que o prazo de retenção.
+//
+// O registro de movimentos do estoque só recebe inclusões: o saldo muda apenas
+// por Movimentar, que grava o movimento junto com o novo saldo.
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"lanchonete/models"
+)
+
+var (
+	// ErrNaoEncontrado indica que o registro buscado não existe
+	ErrNaoEncontrado = errors.New("registro não encontrado")
+	// ErrConflito indica que a operação violaria uma chave estrangeira ou uma chave única
+	ErrConflito = errors.New("operação viola a integridade dos dados")
+)
+
+// Store reúne os repositórios e permite executá-los dentro de uma mesma transação
+type Store interface {
+	Itens() ItemRepository
+	Hamburguers() HamburguerRepository
+	Pedidos() PedidoRepository
+	Estoque() EstoqueRepository
+	Clientes() ClienteRepository
+	ZonasEntrega() ZonaEntregaRepository
+	Promocoes() PromocaoRepository
+
+	// Transacao executa fn com um Store transacional; qualquer erro retornado desfaz as alterações
+	Transacao(fn func(tx Store) error) error
+}
+
+// FiltroItens restringe a listagem de itens; AposID e Limite fazem a paginação por ID
+type FiltroItens struct {
+	Tipo      models.TipoItem
+	Removidos bool // lista apenas os itens removidos, no lugar dos ativos
+	AposID    uint
+	Limite    int
+}
+
+type ItemRepository interface {
+	Listar(filtro FiltroItens) ([]models.Item, error)
+	Buscar(id uint) (models.Item, error)
+	Criar(item *models.Item) error
+	Salvar(item *models.Item) error
+
+	// Remover retorna ErrConflito se o item estiver na receita de um hambúrguer ativo
+	Remover(id uint) error
+
+	// Restaurar retorna ErrNaoEncontrado se não houver item removido com o ID
+	Restaurar(id uint) error
+
+	// DefinirDisponibilidade marca o item como disponível ou em falta, sem passar por Salvar
+	DefinirDisponibilidade(id uint, disponivel bool) error
+
+	// Expurgar apaga os itens removidos antes do instante informado, exceto os que
+	// ainda aparecem em receitas, pedidos, movimentos de estoque ou promoções, e retorna quantos
+	// foram apagados
+	Expurgar(antes time.Time) (int64, error)
+
+	// EmPedidosAbertos indica se o item é vendido, faz parte da receita ou foi
+	// adicionado como extra em algum pedido ainda não encerrado
+	EmPedidosAbertos(id uint) (bool, error)
+}
+
+// FiltroHamburguers restringe a listagem de hambúrgueres; AposID e Limite fazem a paginação por ID
+type FiltroHamburguers struct {
+	Nome      string // parte da descrição, sem diferenciar maiúsculas
+	Removidos bool   // lista apenas os hambúrgueres removidos, no lugar dos ativos
+	AposID    uint
+	Limite    int
+}
+
+type HamburguerRepository interface {
+	// Listar e Buscar retornam os hambúrgueres com a receita e os itens de cada ingrediente,
+	// já com a disponibilidade para venda calculada
+	Listar(filtro FiltroHamburguers) ([]models.Hamburguer, error)
+	Buscar(id uint) (models.Hamburguer, error)
+
+	// Criar e Salvar gravam o hambúrguer e substituem a receita por HamburguerIngredientes
+	Criar(hamburguer *models.Hamburguer) error
+	Salvar(hamburguer *models.Hamburguer) error
+
+	// Remover mantém a receita, para que o hambúrguer possa ser restaurado
+	Remover(id uint) error
+
+	// Restaurar retorna ErrNaoEncontrado se não houver hambúrguer removido com o ID
+	// e ErrConflito se algum ingrediente da receita também estiver removido
+	Restaurar(id uint) error
+
+	// DefinirDisponibilidade marca o hambúrguer como disponível ou em falta, sem tocar na receita
+	DefinirDisponibilidade(id uint, disponivel bool) error
+
+	// Expurgar apaga os hambúrgueres removidos antes do instante informado, com
+	// suas receitas, exceto os vendidos em pedidos ou usados em promoções, e retorna quantos foram apagados
+	Expurgar(antes time.Time) (int64, error)
+
+	EmPedidosAbertos(id uint) (bool, error)
+}
+
+// PosicaoPedido é o último pedido entregue na página anterior
+type PosicaoPedido struct {
+	Valor any // valor da coluna de ordenação: time.Time, models.Dinheiro ou string
+	ID    uuid.UUID
+}
+
+// FiltroPedidos restringe e ordena a listagem de pedidos
+type FiltroPedidos struct {
+	Status     []models.StatusPedido
+	Tipo       models.TipoPedido
+	Abertos    bool
+	DataInicio *time.Time // inclusiva
+	DataFim    *time.Time // exclusiva
+	Telefone   string
+	Nome       string // parte do nome do cliente, sem diferenciar maiúsculas
+	Removidos  bool   // lista apenas os pedidos removidos, no lugar dos ativos
+	Ordem      string // data, valor_total, nome ou status
+	Desc       bool
+	Apos       *PosicaoPedido
+	Limite     int
+}
+
+// OrdensPedido são as colunas aceitas em FiltroPedidos.Ordem
+var OrdensPedido = []string{"data", "valor_total", "nome", "status"}
+
+type PedidoRepository interface {
+	// Listar e Buscar retornam os pedidos com as linhas de hambúrgueres e bebidas
+	Listar(filtro FiltroPedidos) ([]models.Pedido, error)
+	Buscar(id uuid.UUID) (models.Pedido, error)
+
+	// BuscarParaAtualizar retorna apenas os dados do pedido, bloqueando-o até o fim da transação
+	BuscarParaAtualizar(id uuid.UUID) (models.Pedido, error)
+
+	Historico(id uuid.UUID) ([]models.PedidoStatusHistorico, error)
+	RegistrarHistorico(etapa *models.PedidoStatusHistorico) error
+
+	// Criar e Salvar gravam apenas os dados do pedido; as linhas têm métodos próprios
+	Criar(pedido *models.Pedido) error
+	Salvar(pedido *models.Pedido) error
+
+	AdicionarHamburguer(linha *models.PedidoHamburguer) error
+	AdicionarBebida(linha *models.PedidoBebida) error
+	RemoverHamburgueres(pedidoID uuid.UUID) error
+	RemoverBebidas(pedidoID uuid.UUID) error
+
+	// AdicionarDesconto e RemoverDescontos gravam as promoções aplicadas ao pedido
+	AdicionarDesconto(desconto *models.PedidoDesconto) error
+	RemoverDescontos(pedidoID uuid.UUID) error
+
+	// Remover mantém as linhas, os descontos e o histórico, para que o pedido possa ser restaurado
+	Remover(id uuid.UUID) error
+
+	// Restaurar retorna ErrNaoEncontrado se não houver pedido removido com o ID
+	Restaurar(id uuid.UUID) error
+
+	// Expurgar apaga os pedidos removidos antes do instante informado, com
+	// linhas, descontos e histórico, e retorna quantos foram apagados
+	Expurgar(antes time.Time) (int64, error)
+}
+
+// FiltroEstoque restringe a listagem do estoque; AposID e Limite fazem a paginação pelo ID do item
+type FiltroEstoque struct {
+	AbaixoDoMinimo bool // apenas os itens com saldo no ponto de reposição ou abaixo dele
+	AposID         uint
+	Limite         int
+}
+
+type EstoqueRepository interface {
+	// Listar e Buscar retornam o saldo com o item controlado, mesmo que removido
+	Listar(filtro FiltroEstoque) ([]models.Estoque, error)
+	Buscar(itemID uint) (models.Estoque, error)
+
+	// Configurar passa a controlar o estoque do item com saldo zero ou atualiza a
+	// unidade e o ponto de reposição, sem alterar o saldo. Retorna ErrConflito se
+	// o item não existir.
+	Configurar(estoque *models.Estoque) error
+
+	// Remover deixa de controlar o estoque do item; os movimentos são mantidos
+	Remover(itemID uint) error
+
+	// Movimentar soma a quantidade do movimento ao saldo, negativa nas saídas, sem
+	// ler o saldo antes, e grava o movimento com o saldo resultante. Retorna
+	// ErrNaoEncontrado se o item não tem estoque controlado e ErrConflito se a
+	// saída deixaria o saldo negativo.
+	Movimentar(movimento *models.MovimentoEstoque) error
+
+	// Movimentos lista o registro de movimentos em ordem de inclusão
+	Movimentos(filtro FiltroMovimentos) ([]models.MovimentoEstoque, error)
+
+	// SaldosEm soma os movimentos de cada item anteriores ao instante informado
+	SaldosEm(instante time.Time) ([]models.SaldoEstoque, error)
+}
+
+// FiltroMovimentos restringe o registro de movimentos; AposID e Limite fazem a paginação por ID
+type FiltroMovimentos struct {
+	ItemID     uint
+	Tipo       models.TipoMovimento
+	PedidoID   uuid.UUID  // apenas as baixas e estornos do pedido
+	DataInicio *time.Time // inclusiva
+	DataFim    *time.Time // exclusiva
+	AposID     uint
+	Limite     int
+}
+
+type ClienteRepository interface {
+	// Buscar retorna o cliente com os endereços salvos, na ordem de inclusão
+	Buscar(telefone string) (models.Cliente, error)
+
+	// Criar grava o cliente com os endereços informados; retorna ErrConflito se o
+	// telefone já estiver cadastrado
+	Criar(cliente *models.Cliente) error
+
+	// Salvar grava apenas o nome do cliente; os endereços têm métodos próprios
+	Salvar(cliente *models.Cliente) error
+
+	// AdicionarEndereco salva o endereço no cadastro. O primeiro endereço do cliente
+	// é sempre o principal, e um novo principal substitui o anterior. Retorna
+	// ErrConflito se o cliente não existir.
+	AdicionarEndereco(endereco *models.ClienteEndereco) error
+
+	// RemoverEndereco apaga o endereço do cadastro; se ele era o principal, o mais
+	// antigo dos restantes assume o lugar. Pedidos antigos mantêm sua cópia do texto.
+	RemoverEndereco(telefone string, id uint) error
+}
+
+// FiltroZonas restringe a listagem de zonas de entrega; AposID e Limite fazem a paginação por ID
+type FiltroZonas struct {
+	Ativas bool
+	AposID uint
+	Limite int
+}
+
+type ZonaEntregaRepository interface {
+	Listar(filtro FiltroZonas) ([]models.ZonaEntrega, error)
+	Buscar(id uint) (models.ZonaEntrega, error)
+
+	// Criar e Salvar retornam ErrConflito se já houver outra zona com o mesmo nome
+	Criar(zona *models.ZonaEntrega) error
+	Salvar(zona *models.ZonaEntrega) error
+
+	// Remover apaga a zona; os pedidos feitos nela mantêm a taxa e o prazo cobrados
+	Remover(id uint) error
+}
+
+// FiltroPromocoes restringe a listagem de promoções; AposID e Limite fazem a paginação por ID
+type FiltroPromocoes struct {
+	Ativas bool
+	AposID uint
+	Limite int
+}
+
+type PromocaoRepository interface {
+	Listar(filtro FiltroPromocoes) ([]models.Promocao, error)
+	Buscar(id uint) (models.Promocao, error)
+
+	// BuscarCupom retorna a promoção do cupom, mesmo que inativa
+	BuscarCupom(cupom string) (models.Promocao, error)
+
+	// BuscarParaAtualizar bloqueia a promoção até o fim da transação, para que dois
+	// pedidos não passem juntos do limite de usos
+	BuscarParaAtualizar(id uint) (models.Promocao, error)
+
+	// Criar e Salvar retornam ErrConflito se já houver outra promoção com o mesmo nome
+	// ou cupom, ou se o hambúrguer ou a bebida não existirem
+	Criar(promocao *models.Promocao) error
+	Salvar(promocao *models.Promocao) error
+
+	// Remover apaga a promoção; os pedidos mantêm o desconto concedido, sem o vínculo
+	Remover(id uint) error
+
+	// Usos conta os pedidos não cancelados que usaram a promoção, no total e do
+	// telefone informado, sem contar o pedido exceto
+	Usos(id uint, telefone string, exceto uuid.UUID) (total, doCliente int64, err error)
+}
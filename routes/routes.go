@@ -1,8 +1,12 @@
 package routes
 
 import (
+	"lanchonete/cep"
 	"lanchonete/controller"
+	"lanchonete/middleware"
 	_ "lanchonete/docs"
+	"lanchonete/repository"
+	"lanchonete/service"
 
 	"github.com/gin-gonic/gin"
 	swaggerFiles "github.com/swaggo/files"
@@ -14,7 +18,19 @@ import (
 // @description API para gerenciamento de pedidos de uma lanchonete
 // @host localhost:8080
 // @BasePath /
-func HandleRequests(r *gin.Engine) {
+func HandleRequests(r *gin.Engine, store repository.Store, ceps cep.Consulta) {
+	itens := controller.NewItemController(store.Itens())
+	hamburguers := controller.NewHamburguerController(store.Hamburguers(), store.Itens())
+	pedidos := controller.NewPedidoController(store, service.NewPedidoService(store, ceps))
+	estoque := controller.NewEstoqueController(store, service.NewEstoqueService(store))
+	zonas := controller.NewZonaEntregaController(store.ZonasEntrega())
+	promocoes := controller.NewPromocaoController(store.Promocoes(), store.Hamburguers(), store.Itens())
+	clientes := controller.NewClienteController(store.Clientes(), service.NewClienteService(store, ceps))
+
+	// Todas as respostas, inclusive as de erro, levam o ID da requisição e são
+	// escritas no idioma pedido em Accept-Language
+	r.Use(middleware.RequestID(), middleware.Idioma())
+
 	// Swagger
 	r.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 
@@ -23,28 +39,77 @@ func HandleRequests(r *gin.Engine) {
 	})
 	
 	// Rotas de itens
-	r.GET("/itens/todos", controller.GetAllItens)      // Lista todos os itens
-	r.GET("/itens/:codigo", controller.GetItem)        // Busca item por código
-	r.POST("/itens", controller.CreateItem)            // Cria novo item
-	r.PUT("/itens/:codigo", controller.UpdateItem)     // Atualiza item existente
-	r.DELETE("/itens/:codigo", controller.DeleteItem)  // Remove item existente
-	r.GET("/itens/bebidas", controller.GetBebidas)     // Lista todas as bebidas
-	r.GET("/itens/ingredientes", controller.GetIngredientes) // Lista todos os ingredientes
+	r.GET("/itens/todos", itens.GetAllItens)      // Lista todos os itens
+	r.GET("/itens/:codigo", itens.GetItem)        // Busca item por código
+	r.POST("/itens", itens.CreateItem)            // Cria novo item
+	r.PUT("/itens/:codigo", itens.UpdateItem)     // Atualiza item existente
+	r.DELETE("/itens/:codigo", itens.DeleteItem)  // Remove item existente
+	r.PUT("/itens/:codigo/disponibilidade", itens.UpdateItemDisponibilidade) // Marca item como disponível ou em falta
+	r.GET("/itens/bebidas", itens.GetBebidas)     // Lista todas as bebidas
+	r.GET("/itens/ingredientes", itens.GetIngredientes) // Lista todos os ingredientes
 
 	// Rotas de hamburguers
-	r.GET("/hamburguers", controller.GetAllHamburguers)
-	r.GET("/hamburguers/:id", controller.GetHamburguerByID)
-	r.GET("/hamburguers/nome/:nome", controller.GetHamburguerByName)
-	r.POST("/hamburguers", controller.CreateHamburguer)
-	r.PUT("/hamburguers/:id", controller.UpdateHamburguer)
-	r.DELETE("/hamburguers/:id", controller.DeleteHamburguer)
+	r.GET("/hamburguers", hamburguers.GetAllHamburguers)
+	r.GET("/hamburguers/:id", hamburguers.GetHamburguerByID)
+	r.GET("/hamburguers/nome/:nome", hamburguers.GetHamburguerByName)
+	r.POST("/hamburguers", hamburguers.CreateHamburguer)
+	r.PUT("/hamburguers/:id", hamburguers.UpdateHamburguer)
+	r.PUT("/hamburguers/:id/disponibilidade", hamburguers.UpdateHamburguerDisponibilidade)
+	r.DELETE("/hamburguers/:id", hamburguers.DeleteHamburguer)
 
 	// Rotas de pedidos
-	r.GET("/pedidos", controller.GetAllPedidos)
-	r.GET("/pedidos/:id", controller.GetPedidoByID)
-	r.POST("/pedidos", controller.CreatePedido)
-	r.PUT("/pedidos/:id", controller.UpdatePedido)
-	r.DELETE("/pedidos/:id", controller.DeletePedido)
+	r.GET("/pedidos", pedidos.GetAllPedidos)
+	r.GET("/pedidos/:id", pedidos.GetPedidoByID)
+	r.GET("/pedidos/:id/historico", pedidos.GetPedidoHistorico)
+	r.POST("/pedidos", pedidos.CreatePedido)
+	r.POST("/pedidos/cotacao", pedidos.QuotePedido)
+	r.PUT("/pedidos/:id", pedidos.UpdatePedido)
+	r.POST("/pedidos/:id/status", pedidos.UpdatePedidoStatus)
+	r.POST("/pedidos/:id/cancelar", pedidos.CancelPedido)
+	r.POST("/pedidos/:id/reembolso", pedidos.RefundPedido)
+	r.DELETE("/pedidos/:id", pedidos.DeletePedido)
+
+	// Rotas de estoque
+	r.GET("/estoque", estoque.GetAllEstoque)
+	r.GET("/estoque/alertas", estoque.GetAlertasEstoque)          // Itens no ponto de reposição ou abaixo
+	r.GET("/estoque/relatorio", estoque.GetRelatorioEstoque)      // Saldos ao fim de uma data, pelos movimentos
+	r.GET("/estoque/:codigo", estoque.GetEstoqueItem)
+	r.PUT("/estoque/:codigo", estoque.UpdateEstoqueItem)          // Define saldo, unidade e ponto de reposição
+	r.DELETE("/estoque/:codigo", estoque.DeleteEstoqueItem)
+	r.GET("/estoque/:codigo/movimentos", estoque.GetMovimentos)
+	r.POST("/estoque/:codigo/recebimentos", estoque.CreateRecebimento)
+	r.POST("/estoque/:codigo/ajustes", estoque.CreateAjuste)
+
+	// Rotas de zonas de entrega
+	r.GET("/zonas-entrega", zonas.GetAllZonasEntrega)
+	r.GET("/zonas-entrega/:id", zonas.GetZonaEntrega)
+	r.POST("/zonas-entrega", zonas.CreateZonaEntrega)
+	r.PUT("/zonas-entrega/:id", zonas.UpdateZonaEntrega)
+	r.DELETE("/zonas-entrega/:id", zonas.DeleteZonaEntrega)
+
+	// Rotas de promoções
+	r.GET("/promocoes", promocoes.GetAllPromocoes)
+	r.GET("/promocoes/:id", promocoes.GetPromocao)
+	r.POST("/promocoes", promocoes.CreatePromocao)
+	r.PUT("/promocoes/:id", promocoes.UpdatePromocao)
+	r.DELETE("/promocoes/:id", promocoes.DeletePromocao)
+
+	// Rotas de clientes
+	r.GET("/clientes/:telefone", clientes.GetCliente)
+	r.POST("/clientes", clientes.CreateCliente)
+	r.PUT("/clientes/:telefone", clientes.UpdateCliente)
+	r.GET("/clientes/:telefone/pedidos", pedidos.GetPedidosCliente)      // Histórico de pedidos do cliente
+	r.POST("/clientes/:telefone/enderecos", clientes.CreateEndereco)
+	r.DELETE("/clientes/:telefone/enderecos/:id", clientes.DeleteEndereco)
+
+	// Rotas de administração: registros removidos logicamente e sua restauração
+	admin := r.Group("/admin")
+	admin.GET("/itens/removidos", itens.GetDeletedItens)
+	admin.POST("/itens/:codigo/restaurar", itens.RestoreItem)
+	admin.GET("/hamburguers/removidos", hamburguers.GetDeletedHamburguers)
+	admin.POST("/hamburguers/:id/restaurar", hamburguers.RestoreHamburguer)
+	admin.GET("/pedidos/removidos", pedidos.GetDeletedPedidos)
+	admin.POST("/pedidos/:id/restaurar", pedidos.RestorePedido)
 
 	r.Run(":8080")
 }
@@ -0,0 +1,212 @@
+package service
+
+import (
+	"cmp"
+	"errors"
+
+	"lanchonete/cep"
+	"lanchonete/mensagens"
+	"lanchonete/models"
+	"lanchonete/repository"
+)
+
+// ClienteService cadastra clientes e endereços, completando os endereços pela consulta de CEP
+type ClienteService struct {
+	store repository.Store
+	ceps  cep.Consulta
+}
+
+func NewClienteService(store repository.Store, ceps cep.Consulta) *ClienteService {
+	return &ClienteService{store: store, ceps: ceps}
+}
+
+// RegisterCustomer cadastra o cliente com os endereços informados
+func (s *ClienteService) RegisterCustomer(request models.ClienteRequest) (models.Cliente, error) {
+	cliente := models.Cliente{Telefone: request.Telefone, Nome: request.Nome, Enderecos: []models.ClienteEndereco{}}
+	for _, endereco := range request.Enderecos {
+		completo, err := completarEndereco(s.ceps, endereco.Endereco)
+		if err != nil {
+			return models.Cliente{}, err
+		}
+		cliente.Enderecos = append(cliente.Enderecos, enderecoCliente(endereco.Apelido, completo, endereco.Principal))
+	}
+
+	if err := s.store.Clientes().Criar(&cliente); err != nil {
+		return models.Cliente{}, falhaRepositorio(err, mensagens.ClienteJaExiste, mensagens.ClienteErroCriar)
+	}
+	return cliente, nil
+}
+
+// AddAddress salva um endereço no cadastro do cliente e retorna o cadastro atualizado
+func (s *ClienteService) AddAddress(telefone string, request models.ClienteEnderecoRequest) (models.Cliente, error) {
+	completo, err := completarEndereco(s.ceps, request.Endereco)
+	if err != nil {
+		return models.Cliente{}, err
+	}
+
+	endereco := enderecoCliente(request.Apelido, completo, request.Principal)
+	endereco.ClienteTelefone = telefone
+	if err := s.store.Clientes().AdicionarEndereco(&endereco); err != nil {
+		return models.Cliente{}, falhaRepositorio(err, mensagens.ClienteNaoEncontrado, mensagens.ClienteErroAtualizar)
+	}
+
+	cliente, err := s.store.Clientes().Buscar(telefone)
+	if err != nil {
+		return models.Cliente{}, erroInterno(mensagens.ClienteErroBuscar)
+	}
+	return cliente, nil
+}
+
+// entrega é o nome e o endereço copiados para o pedido
+type entrega struct {
+	Nome     string
+	Endereco string // texto em uma linha, único dado dos endereços antigos
+	models.EnderecoEntrega
+}
+
+// identificarCliente encontra o cliente do telefone, ou o cadastra no primeiro pedido,
+// e decide o endereço de entrega: o salvo indicado por enderecoID, o informado (que
+// passa a fazer parte do cadastro) ou, sem nenhum dos dois, o principal
+func identificarCliente(tx repository.Store, ceps cep.Consulta, telefone, nome string, endereco *models.EnderecoRequest, enderecoID uint) (entrega, error) {
+	var informado models.EnderecoEntrega
+	if endereco != nil && enderecoID == 0 {
+		var err error
+		if informado, err = completarEndereco(ceps, *endereco); err != nil {
+			return entrega{}, err
+		}
+	}
+
+	cliente, err := tx.Clientes().Buscar(telefone)
+	if errors.Is(err, repository.ErrNaoEncontrado) {
+		if enderecoID != 0 {
+			return entrega{}, erroValidacao(mensagens.EnderecoNaoEncontrado, enderecoID)
+		}
+		if nome == "" || endereco == nil {
+			return entrega{}, erroValidacao(mensagens.ClienteNovoSemDados)
+		}
+
+		cliente = models.Cliente{
+			Telefone:  telefone,
+			Nome:      nome,
+			Enderecos: []models.ClienteEndereco{enderecoCliente("", informado, true)},
+		}
+		if err := tx.Clientes().Criar(&cliente); err != nil {
+			return entrega{}, falhaRepositorio(err, mensagens.ClienteJaExiste, mensagens.ClienteErroCriar)
+		}
+		return entrega{Nome: nome, Endereco: informado.Texto(), EnderecoEntrega: informado}, nil
+	}
+	if err != nil {
+		return entrega{}, erroInterno(mensagens.ClienteErroBuscar)
+	}
+
+	resultado := entrega{Nome: nome}
+	if resultado.Nome == "" {
+		resultado.Nome = cliente.Nome
+	}
+
+	switch {
+	case enderecoID != 0:
+		salvo, ok := enderecoSalvo(cliente, enderecoID)
+		if !ok {
+			return entrega{}, erroValidacao(mensagens.EnderecoNaoEncontrado, enderecoID)
+		}
+		resultado.Endereco, resultado.EnderecoEntrega = salvo.Endereco, salvo.EnderecoEntrega
+
+	case endereco != nil:
+		if _, ok := enderecoNoCadastro(cliente, informado); !ok {
+			novo := enderecoCliente("", informado, false)
+			novo.ClienteTelefone = telefone
+			if err := tx.Clientes().AdicionarEndereco(&novo); err != nil {
+				return entrega{}, falhaRepositorio(err, mensagens.ClienteNaoEncontrado, mensagens.ClienteErroAtualizar)
+			}
+		}
+		resultado.Endereco, resultado.EnderecoEntrega = informado.Texto(), informado
+
+	default:
+		principal, ok := cliente.EnderecoPrincipal()
+		if !ok {
+			return entrega{}, erroValidacao(mensagens.ClienteSemEndereco)
+		}
+		resultado.Endereco, resultado.EnderecoEntrega = principal.Endereco, principal.EnderecoEntrega
+	}
+	return resultado, nil
+}
+
+// identificarClienteSemEntrega encontra o cliente do telefone, ou o cadastra sem endereço
+// no primeiro pedido, e retorna o nome copiado para um pedido que não é entregue
+func identificarClienteSemEntrega(tx repository.Store, telefone, nome string) (string, error) {
+	cliente, err := tx.Clientes().Buscar(telefone)
+	if errors.Is(err, repository.ErrNaoEncontrado) {
+		if nome == "" {
+			return "", erroValidacao(mensagens.ClienteNovoSemNome)
+		}
+
+		cliente = models.Cliente{Telefone: telefone, Nome: nome, Enderecos: []models.ClienteEndereco{}}
+		if err := tx.Clientes().Criar(&cliente); err != nil {
+			return "", falhaRepositorio(err, mensagens.ClienteJaExiste, mensagens.ClienteErroCriar)
+		}
+		return nome, nil
+	}
+	if err != nil {
+		return "", erroInterno(mensagens.ClienteErroBuscar)
+	}
+	return cmp.Or(nome, cliente.Nome), nil
+}
+
+// completarEndereco valida o CEP na consulta e preenche os campos que o cliente deixou
+// em branco. Cidade e UF vêm sempre da consulta; com ela indisponível, o endereço
+// precisa ter sido informado por completo.
+func completarEndereco(ceps cep.Consulta, request models.EnderecoRequest) (models.EnderecoEntrega, error) {
+	endereco := request.Endereco()
+
+	encontrado, err := ceps.Buscar(endereco.CEP)
+	switch {
+	case errors.Is(err, cep.ErrNaoEncontrado):
+		return models.EnderecoEntrega{}, erroValidacao(mensagens.CEPNaoEncontrado, request.CEP)
+	case err == nil:
+		if endereco.UF != "" && endereco.UF != encontrado.UF {
+			return models.EnderecoEntrega{}, erroValidacao(mensagens.CEPOutraUF, request.CEP, encontrado.Cidade, encontrado.UF)
+		}
+		endereco.Cidade, endereco.UF = encontrado.Cidade, encontrado.UF
+		if endereco.Logradouro == "" {
+			endereco.Logradouro = encontrado.Logradouro
+		}
+		if endereco.Bairro == "" {
+			endereco.Bairro = encontrado.Bairro
+		}
+	}
+
+	if !endereco.Completo() {
+		return models.EnderecoEntrega{}, erroValidacao(mensagens.EnderecoIncompleto)
+	}
+	return endereco, nil
+}
+
+// enderecoCliente monta o endereço do cadastro com o texto usado nos pedidos
+func enderecoCliente(apelido string, endereco models.EnderecoEntrega, principal bool) models.ClienteEndereco {
+	return models.ClienteEndereco{
+		Apelido:         apelido,
+		Endereco:        endereco.Texto(),
+		EnderecoEntrega: endereco,
+		Principal:       principal,
+	}
+}
+
+func enderecoSalvo(cliente models.Cliente, id uint) (models.ClienteEndereco, bool) {
+	for _, endereco := range cliente.Enderecos {
+		if endereco.ID == id {
+			return endereco, true
+		}
+	}
+	return models.ClienteEndereco{}, false
+}
+
+// enderecoNoCadastro procura o mesmo local entre os endereços salvos, mesmo escrito de outra forma
+func enderecoNoCadastro(cliente models.Cliente, endereco models.EnderecoEntrega) (models.ClienteEndereco, bool) {
+	for _, salvo := range cliente.Enderecos {
+		if salvo.MesmoLocal(endereco) {
+			return salvo, true
+		}
+	}
+	return models.ClienteEndereco{}, false
+}
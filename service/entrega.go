@@ -0,0 +1,76 @@
+package service
+
+import (
+	"errors"
+
+	"lanchonete/cep"
+	"lanchonete/mensagens"
+	"lanchonete/models"
+	"lanchonete/repository"
+)
+
+// cotarEntrega escolhe a zona que atende o endereço e soma a taxa à cotação, recusando
+// endereços fora de todas as zonas e pedidos abaixo do valor mínimo da zona. O mínimo
+// vale para o subtotal das linhas, antes dos descontos e sem a taxa: uma promoção não
+// faz o pedido deixar de ser entregue.
+func cotarEntrega(store repository.Store, cotacao *Cotacao, endereco models.EnderecoEntrega) error {
+	cotacao.calcularTotal()
+	if endereco.CEP == "" {
+		return erroValidacao(mensagens.EntregaEnderecoSemCEP)
+	}
+
+	zonas, err := store.ZonasEntrega().Listar(repository.FiltroZonas{Ativas: true})
+	if err != nil {
+		return erroInterno(mensagens.ZonaErroBuscar)
+	}
+	zona, ok := models.EscolherZona(zonas, endereco)
+	if !ok {
+		return erroForaDaArea(mensagens.EntregaForaDaArea, endereco.Bairro, endereco.Cidade, endereco.UF)
+	}
+	if cotacao.Subtotal < zona.PedidoMinimo {
+		return erroValidacao(mensagens.EntregaPedidoMinimo, zona.Nome, zona.PedidoMinimo)
+	}
+
+	cotacao.Zona = &zona
+	cotacao.TaxaEntrega = zona.Taxa
+	cotacao.calcularTotal()
+	return nil
+}
+
+// aplicarEntrega copia para o pedido a taxa e o prazo da zona cotada
+func aplicarEntrega(pedido *models.Pedido, cotacao Cotacao) {
+	pedido.TaxaEntrega = cotacao.TaxaEntrega
+	pedido.ZonaEntregaID = nil
+	pedido.PrazoEntregaMinutos = 0
+	if cotacao.Zona != nil {
+		pedido.ZonaEntregaID = &cotacao.Zona.ID
+		pedido.PrazoEntregaMinutos = cotacao.Zona.PrazoMinutos
+	}
+}
+
+// enderecoCotacao decide o endereço de uma cotação sem gravar nada: o informado, o
+// salvo indicado ou o principal do cliente. Sem endereço, a cotação não inclui a entrega.
+func enderecoCotacao(store repository.Store, ceps cep.Consulta, request models.PedidoRequest) (models.EnderecoEntrega, bool, error) {
+	if request.Endereco != nil && request.EnderecoID == 0 {
+		endereco, err := completarEndereco(ceps, *request.Endereco)
+		return endereco, err == nil, err
+	}
+
+	cliente, err := store.Clientes().Buscar(request.Telefone)
+	if errors.Is(err, repository.ErrNaoEncontrado) {
+		return models.EnderecoEntrega{}, false, nil
+	}
+	if err != nil {
+		return models.EnderecoEntrega{}, false, erroInterno(mensagens.ClienteErroBuscar)
+	}
+
+	if request.EnderecoID != 0 {
+		salvo, ok := enderecoSalvo(cliente, request.EnderecoID)
+		if !ok {
+			return models.EnderecoEntrega{}, false, erroValidacao(mensagens.EnderecoNaoEncontrado, request.EnderecoID)
+		}
+		return salvo.EnderecoEntrega, true, nil
+	}
+	principal, ok := cliente.EnderecoPrincipal()
+	return principal.EnderecoEntrega, ok, nil
+}
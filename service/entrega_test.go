@@ -0,0 +1,111 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"lanchonete/cep"
+	"lanchonete/models"
+	"lanchonete/repository"
+)
+
+func TestCotarEntregaEscolheAZona(t *testing.T) {
+	store := repository.NewMemoriaStore()
+	zonas := []models.ZonaEntrega{
+		{Nome: "Centro", CEPInicio: "01000000", CEPFim: "01599999", Taxa: models.Centavos(500), PrazoMinutos: 40, Ativa: true},
+		{Nome: "Paulista", CEPInicio: "01310000", CEPFim: "01319999", Taxa: models.Centavos(300), PrazoMinutos: 20, Ativa: true},
+		{Nome: "Bela Vista", Bairro: "Bela Vista", Cidade: "São Paulo", UF: "SP", Taxa: models.Centavos(400), PrazoMinutos: 30, Ativa: true},
+		{Nome: "Fechada", CEPInicio: "02000000", CEPFim: "02999999", Taxa: models.Centavos(100), PrazoMinutos: 10},
+	}
+	for i := range zonas {
+		if err := store.ZonasEntrega().Criar(&zonas[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	casos := []struct {
+		nome     string
+		endereco models.EnderecoEntrega
+		zona     string
+		taxa     models.Dinheiro
+	}{
+		{"faixa de CEPs", models.EnderecoEntrega{CEP: "01001000", Bairro: "Sé", Cidade: "São Paulo", UF: "SP"}, "Centro", models.Centavos(500)},
+		{"faixa mais estreita", models.EnderecoEntrega{CEP: "01310100", Bairro: "Cerqueira César", Cidade: "São Paulo", UF: "SP"}, "Paulista", models.Centavos(300)},
+		{"bairro antes das faixas", models.EnderecoEntrega{CEP: "01310100", Bairro: "Bela Vista", Cidade: "São Paulo", UF: "SP"}, "Bela Vista", models.Centavos(400)},
+		{"bairro fora das faixas", models.EnderecoEntrega{CEP: "04000000", Bairro: "bela vista", Cidade: "SÃO PAULO", UF: "SP"}, "Bela Vista", models.Centavos(400)},
+	}
+	for _, caso := range casos {
+		t.Run(caso.nome, func(t *testing.T) {
+			cotacao := Cotacao{Hamburgueres: []models.PedidoHamburguer{{HamburguerID: 1, Quantidade: 1, PrecoUnitario: models.Centavos(2590)}}}
+			if err := cotarEntrega(store, &cotacao, caso.endereco); err != nil {
+				t.Fatal(err)
+			}
+			if cotacao.Zona == nil || cotacao.Zona.Nome != caso.zona {
+				t.Fatalf("zona = %+v, esperado %s", cotacao.Zona, caso.zona)
+			}
+			if cotacao.TaxaEntrega != caso.taxa || cotacao.ValorTotal != models.Centavos(2590)+caso.taxa {
+				t.Errorf("taxa %s, total %s", cotacao.TaxaEntrega, cotacao.ValorTotal)
+			}
+		})
+	}
+
+	recusados := map[string]struct {
+		endereco models.EnderecoEntrega
+		erro     error
+	}{
+		"fora de todas as zonas": {models.EnderecoEntrega{CEP: "20040020", Bairro: "Centro", Cidade: "Rio de Janeiro", UF: "RJ"}, ErrForaDaArea},
+		"só em zona inativa":     {models.EnderecoEntrega{CEP: "02000000", Bairro: "Santana", Cidade: "São Paulo", UF: "SP"}, ErrForaDaArea},
+		"sem CEP":                {models.EnderecoEntrega{Bairro: "Bela Vista", Cidade: "São Paulo", UF: "SP"}, ErrValidacao},
+	}
+	for nome, recusado := range recusados {
+		t.Run(nome, func(t *testing.T) {
+			cotacao := Cotacao{Hamburgueres: []models.PedidoHamburguer{{HamburguerID: 1, Quantidade: 1, PrecoUnitario: models.Centavos(2590)}}}
+			if err := cotarEntrega(store, &cotacao, recusado.endereco); !errors.Is(err, recusado.erro) {
+				t.Errorf("erro %v, esperado %v", err, recusado.erro)
+			}
+		})
+	}
+}
+
+func TestPedidoMinimoDaZonaAntesDosDescontos(t *testing.T) {
+	store := repository.NewMemoriaStore()
+	cardapioDeTeste(t, store)
+	zona := models.ZonaEntrega{Nome: "Centro", CEPInicio: "01000000", CEPFim: "01599999", Taxa: models.Centavos(500), PedidoMinimo: models.Centavos(3000), PrazoMinutos: 40, Ativa: true}
+	if err := store.ZonasEntrega().Criar(&zona); err != nil {
+		t.Fatal(err)
+	}
+	desconto := models.Promocao{Nome: "Dez reais", Tipo: models.PromocaoValorFixo, Valor: models.Centavos(1000), Ativa: true}
+	if err := store.Promocoes().Criar(&desconto); err != nil {
+		t.Fatal(err)
+	}
+	servico := NewPedidoService(store, cep.SemConsulta{})
+
+	request := models.PedidoRequest{
+		Descricao:    "Pedido de teste",
+		Tipo:         models.TipoDelivery,
+		Nome:         "Maria",
+		Telefone:     "11987654321",
+		Endereco:     &enderecoDeTeste,
+		Hamburgueres: []models.PedidoHamburguerRequest{{ID: 1, Quantidade: 1}},
+		Bebidas:      []models.PedidoItemRequest{{ID: 10, Quantidade: 1}},
+	}
+
+	// Subtotal de 25.90 + 6.00: acima do mínimo, mesmo com o total sem a taxa em 21.90
+	pedido, err := servico.PlaceOrder(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pedido.Desconto != models.Centavos(1000) || pedido.ValorTotal != models.Centavos(2590+600-1000+500) {
+		t.Errorf("desconto %s, total %s", pedido.Desconto, pedido.ValorTotal)
+	}
+
+	// Só o hambúrguer, 25.90, fica abaixo do mínimo com ou sem o desconto
+	semBebida := request
+	semBebida.Bebidas = nil
+	if _, err := servico.QuoteOrder(semBebida); !errors.Is(err, ErrValidacao) {
+		t.Errorf("QuoteOrder abaixo do mínimo: erro %v, esperado ErrValidacao", err)
+	}
+	if _, err := servico.PlaceOrder(semBebida); !errors.Is(err, ErrValidacao) {
+		t.Errorf("PlaceOrder abaixo do mínimo: erro %v, esperado ErrValidacao", err)
+	}
+}
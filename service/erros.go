@@ -0,0 +1,178 @@
+// Package service concentra as regras de negócio dos pedidos (validação,
+// precificação e mudanças de status) e do estoque, sem depender de HTTP. Os
+// controllers, comandos de linha e futuros canais chamam o mesmo serviço.
+package service
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"lanchonete/mensagens"
+	"lanchonete/models"
+)
+
+// Categorias dos erros retornados pelo serviço, para uso com errors.Is
+var (
+	ErrValidacao     = errors.New("dados inválidos")
+	ErrNaoEncontrado = errors.New("registro não encontrado")
+	ErrConflito      = errors.New("conflito com o estado atual")
+	ErrInterno       = errors.New("erro interno")
+
+	// ErrForaDaArea é um endereço que nenhuma zona de entrega atende; também é ErrValidacao
+	ErrForaDaArea = fmt.Errorf("%w: endereço fora da área de entrega", ErrValidacao)
+
+	// ErrCupomInvalido é um cupom que não pode ser usado no pedido; também é ErrValidacao
+	ErrCupomInvalido = fmt.Errorf("%w: cupom inválido", ErrValidacao)
+)
+
+// Erro é uma falha do serviço com a categoria em Causa e a mensagem do catálogo, que
+// quem chama traduz para o idioma do cliente
+type Erro struct {
+	Causa    error
+	Mensagem mensagens.Mensagem
+}
+
+func (e *Erro) Error() string {
+	return e.Mensagem.Traduzir(mensagens.Padrao)
+}
+
+func (e *Erro) Unwrap() error {
+	return e.Causa
+}
+
+func erroValidacao(chave mensagens.Chave, args ...any) error {
+	return &Erro{Causa: ErrValidacao, Mensagem: mensagens.Nova(chave, args...)}
+}
+
+func erroNaoEncontrado(chave mensagens.Chave, args ...any) error {
+	return &Erro{Causa: ErrNaoEncontrado, Mensagem: mensagens.Nova(chave, args...)}
+}
+
+func erroConflito(chave mensagens.Chave, args ...any) error {
+	return &Erro{Causa: ErrConflito, Mensagem: mensagens.Nova(chave, args...)}
+}
+
+func erroForaDaArea(chave mensagens.Chave, args ...any) error {
+	return &Erro{Causa: ErrForaDaArea, Mensagem: mensagens.Nova(chave, args...)}
+}
+
+func erroCupomInvalido(chave mensagens.Chave, args ...any) error {
+	return &Erro{Causa: ErrCupomInvalido, Mensagem: mensagens.Nova(chave, args...)}
+}
+
+func erroInterno(chave mensagens.Chave, args ...any) error {
+	return &Erro{Causa: ErrInterno, Mensagem: mensagens.Nova(chave, args...)}
+}
+
+// ErroTransicao é uma mudança de status que o fluxo do pedido não permite
+type ErroTransicao struct {
+	Mensagem         mensagens.Mensagem
+	StatusAtual      models.StatusPedido
+	StatusPermitidos []models.StatusPedido
+}
+
+func (e *ErroTransicao) Error() string {
+	return e.Mensagem.Traduzir(mensagens.Padrao)
+}
+
+func (e *ErroTransicao) Unwrap() error {
+	return ErrConflito
+}
+
+// erroTransicao recusa a mudança de status informando para onde o pedido pode seguir no fluxo do seu tipo
+func erroTransicao(pedido models.Pedido, chave mensagens.Chave, args ...any) error {
+	return &ErroTransicao{
+		Mensagem:         mensagens.Nova(chave, args...),
+		StatusAtual:      pedido.Status,
+		StatusPermitidos: pedido.Tipo.ProximosStatus(pedido.Status),
+	}
+}
+
+// LinhaInvalida identifica uma linha do pedido recusada e o motivo
+type LinhaInvalida struct {
+	Campo  string // posição da linha no corpo, como bebidas[1]
+	ID     uint
+	Motivo mensagens.Mensagem
+}
+
+// ErroLinhas reúne todas as linhas recusadas de um pedido, para que o cliente corrija todas de uma vez
+type ErroLinhas struct {
+	Linhas []LinhaInvalida
+}
+
+// Resumo é a mensagem geral do erro, com a quantidade de linhas recusadas
+func (e *ErroLinhas) Resumo() mensagens.Mensagem {
+	if len(e.Linhas) == 1 {
+		return mensagens.Nova(mensagens.PedidoLinhaInvalida)
+	}
+	return mensagens.Nova(mensagens.PedidoLinhasInvalidas, len(e.Linhas))
+}
+
+func (e *ErroLinhas) Error() string {
+	return e.Resumo().Traduzir(mensagens.Padrao)
+}
+
+func (e *ErroLinhas) Unwrap() error {
+	return ErrValidacao
+}
+
+// linhasInvalidas acumula as linhas recusadas durante a validação de um pedido
+type linhasInvalidas []LinhaInvalida
+
+func (l *linhasInvalidas) recusar(grupo string, indice int, id uint, err error) {
+	motivo := mensagens.Nova(mensagens.PedidoErroProcessar)
+	var erro *Erro
+	if errors.As(err, &erro) {
+		motivo = erro.Mensagem
+	}
+
+	*l = append(*l, LinhaInvalida{
+		Campo:  grupo + "[" + strconv.Itoa(indice) + "]",
+		ID:     id,
+		Motivo: motivo,
+	})
+}
+
+// erro retorna nil quando nenhuma linha foi recusada
+func (l linhasInvalidas) erro() error {
+	if len(l) == 0 {
+		return nil
+	}
+	return &ErroLinhas{Linhas: l}
+}
+
+// FaltaEstoque é um item cujo saldo não cobre o que o pedido consome
+type FaltaEstoque struct {
+	ItemID     uint
+	Descricao  string
+	Unidade    models.UnidadeEstoque
+	Necessario int
+	Disponivel int
+}
+
+// Motivo descreve a falta com as quantidades, para o detalhe da resposta
+func (f FaltaEstoque) Motivo() mensagens.Mensagem {
+	return mensagens.Nova(mensagens.EstoqueFalta, f.Descricao, f.Necessario, f.Unidade, f.Disponivel, f.Unidade)
+}
+
+// ErroEstoque reúne todos os itens sem saldo para o pedido
+type ErroEstoque struct {
+	Faltas []FaltaEstoque
+}
+
+// Resumo é a mensagem geral do erro, com a quantidade de itens em falta
+func (e *ErroEstoque) Resumo() mensagens.Mensagem {
+	if len(e.Faltas) == 1 {
+		return mensagens.Nova(mensagens.EstoqueInsuficienteItem)
+	}
+	return mensagens.Nova(mensagens.EstoqueInsuficienteItens, len(e.Faltas))
+}
+
+func (e *ErroEstoque) Error() string {
+	return e.Resumo().Traduzir(mensagens.Padrao)
+}
+
+func (e *ErroEstoque) Unwrap() error {
+	return ErrConflito
+}
@@ -0,0 +1,308 @@
+package service
+
+import (
+	"errors"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"lanchonete/mensagens"
+	"lanchonete/models"
+	"lanchonete/repository"
+)
+
+// consumo é a quantidade de cada item usada pelas linhas de um pedido, na unidade do estoque
+type consumo map[uint]int
+
+// consumoDasLinhas soma a receita de cada hambúrguer, menos os ingredientes retirados,
+// mais os adicionais e as bebidas, tudo multiplicado pela quantidade da linha. A receita
+// é a vigente, por isso só serve para linhas que estão sendo vendidas agora; o que já
+// foi baixado é lido do registro por consumoRegistrado.
+func consumoDasLinhas(store repository.Store, hamburgueres []models.PedidoHamburguer, bebidas []models.PedidoBebida) consumo {
+	total := consumo{}
+	receitas := map[uint][]models.HamburguerIngrediente{}
+	for _, linha := range hamburgueres {
+		receita, carregada := receitas[linha.HamburguerID]
+		if !carregada {
+			if hamburguer, err := store.Hamburguers().Buscar(linha.HamburguerID); err == nil {
+				receita = hamburguer.HamburguerIngredientes
+			}
+			receitas[linha.HamburguerID] = receita
+		}
+
+		retirados := map[uint]bool{}
+		for _, personalizacao := range linha.Personalizacoes {
+			switch personalizacao.Acao {
+			case models.AcaoRemover:
+				retirados[personalizacao.ItemID] = true
+			case models.AcaoAdicionar:
+				total[personalizacao.ItemID] += personalizacao.Quantidade * linha.Quantidade
+			}
+		}
+		for _, ingrediente := range receita {
+			if !retirados[ingrediente.ItemID] {
+				total[ingrediente.ItemID] += ingrediente.Quantidade * linha.Quantidade
+			}
+		}
+	}
+	for _, linha := range bebidas {
+		total[linha.ItemID] += linha.Quantidade
+	}
+	return total
+}
+
+// consumoRegistrado soma as baixas do pedido no registro de movimentos, descontados os
+// estornos, para devolver exatamente o que saiu mesmo que a receita tenha mudado ou o
+// hambúrguer tenha sido removido depois da venda. Com tipos, só entram os itens desses
+// tipos: ingredientes saem pelas linhas de hambúrguer e bebidas pelas de bebida.
+func consumoRegistrado(tx repository.Store, pedidoID uuid.UUID, tipos ...models.TipoItem) (consumo, error) {
+	movimentos, err := tx.Estoque().Movimentos(repository.FiltroMovimentos{PedidoID: pedidoID})
+	if err != nil {
+		return nil, erroInterno(mensagens.EstoqueErroBuscar)
+	}
+
+	total := consumo{}
+	for _, movimento := range movimentos {
+		total[movimento.ItemID] -= movimento.Quantidade
+	}
+	if len(tipos) == 0 {
+		return total, nil
+	}
+
+	for itemID := range total {
+		estoque, err := tx.Estoque().Buscar(itemID)
+		switch {
+		case errors.Is(err, repository.ErrNaoEncontrado):
+			// O item deixou de ter estoque controlado e não é mais movimentado
+			delete(total, itemID)
+		case err != nil:
+			return nil, erroInterno(mensagens.EstoqueErroBuscar)
+		case !slices.Contains(tipos, estoque.Item.Tipo):
+			delete(total, itemID)
+		}
+	}
+	return total, nil
+}
+
+// menos retorna a diferença entre dois consumos, item a item
+func (c consumo) menos(outro consumo) consumo {
+	diferenca := maps.Clone(c)
+	for id, quantidade := range outro {
+		diferenca[id] -= quantidade
+	}
+	return diferenca
+}
+
+// movimentarEstoque aplica as quantidades ao saldo, registrando os movimentos ligados ao
+// pedido: negativas são CONSUMO e positivas são ESTORNO. Itens sem estoque controlado
+// são ignorados e as faltas são reunidas em um ErroEstoque.
+func movimentarEstoque(tx repository.Store, pedidoID uuid.UUID, movimento consumo) error {
+	var faltas []FaltaEstoque
+	for _, itemID := range slices.Sorted(maps.Keys(movimento)) {
+		quantidade := movimento[itemID]
+		if quantidade == 0 {
+			continue
+		}
+
+		tipo := models.MovimentoConsumo
+		if quantidade > 0 {
+			tipo = models.MovimentoEstorno
+		}
+		err := tx.Estoque().Movimentar(&models.MovimentoEstoque{
+			ItemID:     itemID,
+			Tipo:       tipo,
+			Quantidade: quantidade,
+			PedidoID:   &pedidoID,
+		})
+		switch {
+		case err == nil, errors.Is(err, repository.ErrNaoEncontrado):
+			continue
+		case !errors.Is(err, repository.ErrConflito):
+			return erroInterno(mensagens.EstoqueErroMovimentar)
+		}
+
+		falta, err := faltaEstoque(tx, itemID, -quantidade)
+		if err != nil {
+			return err
+		}
+		faltas = append(faltas, falta)
+	}
+
+	if len(faltas) > 0 {
+		return &ErroEstoque{Faltas: faltas}
+	}
+	return nil
+}
+
+// faltaEstoque descreve uma saída recusada por falta de saldo
+func faltaEstoque(tx repository.Store, itemID uint, necessario int) (FaltaEstoque, error) {
+	estoque, err := tx.Estoque().Buscar(itemID)
+	if err != nil {
+		return FaltaEstoque{}, erroInterno(mensagens.EstoqueErroMovimentar)
+	}
+	return FaltaEstoque{
+		ItemID:     itemID,
+		Descricao:  estoque.Item.Descricao,
+		Unidade:    estoque.Unidade,
+		Necessario: necessario,
+		Disponivel: estoque.Quantidade,
+	}, nil
+}
+
+// EstoqueService registra as entradas, perdas e ajustes lançados manualmente. As
+// saídas por venda são feitas pelo PedidoService, na transação do pedido.
+type EstoqueService struct {
+	store repository.Store
+}
+
+func NewEstoqueService(store repository.Store) *EstoqueService {
+	return &EstoqueService{store: store}
+}
+
+// SetStock passa a controlar o estoque do item ou altera a unidade e o ponto de reposição.
+// Se o saldo contado for informado, a diferença para o saldo atual vira um AJUSTE.
+func (s *EstoqueService) SetStock(itemID uint, request models.EstoqueRequest) (models.Estoque, error) {
+	err := s.store.Transacao(func(tx repository.Store) error {
+		if _, err := tx.Itens().Buscar(itemID); err != nil {
+			return erroNaoEncontrado(mensagens.ItemNaoEncontrado)
+		}
+
+		estoque := models.Estoque{
+			ItemID:         itemID,
+			Unidade:        request.Unidade,
+			PontoReposicao: request.PontoReposicao,
+		}
+		if err := tx.Estoque().Configurar(&estoque); err != nil {
+			return falhaRepositorio(err, mensagens.ItemNaoEncontrado, mensagens.EstoqueErroSalvar)
+		}
+		if request.Quantidade == nil {
+			return nil
+		}
+
+		atual, err := tx.Estoque().Buscar(itemID)
+		if err != nil {
+			return erroInterno(mensagens.EstoqueErroSalvar)
+		}
+		diferenca := *request.Quantidade - atual.Quantidade
+		if diferenca == 0 {
+			return nil
+		}
+		return lancar(tx, &models.MovimentoEstoque{
+			ItemID:      itemID,
+			Tipo:        models.MovimentoAjuste,
+			Quantidade:  diferenca,
+			Observacao:  request.Observacao,
+			Responsavel: request.Responsavel,
+		})
+	})
+	if err != nil {
+		return models.Estoque{}, err
+	}
+
+	estoque, err := s.store.Estoque().Buscar(itemID)
+	if err != nil {
+		return models.Estoque{}, erroInterno(mensagens.EstoqueErroBuscar)
+	}
+	return estoque, nil
+}
+
+// ReceiveStock lança a entrada de mercadoria entregue por um fornecedor
+func (s *EstoqueService) ReceiveStock(itemID uint, request models.RecebimentoRequest) (models.MovimentoEstoque, error) {
+	movimento := models.MovimentoEstoque{
+		ItemID:      itemID,
+		Tipo:        models.MovimentoRecebimento,
+		Quantidade:  request.Quantidade,
+		Fornecedor:  request.Fornecedor,
+		Documento:   request.Documento,
+		Observacao:  request.Observacao,
+		Responsavel: request.Responsavel,
+	}
+	err := s.store.Transacao(func(tx repository.Store) error {
+		return lancar(tx, &movimento)
+	})
+	return movimento, err
+}
+
+// AdjustStock lança uma perda, que sempre sai do estoque, ou um ajuste manual em qualquer sentido
+func (s *EstoqueService) AdjustStock(itemID uint, request models.AjusteEstoqueRequest) (models.MovimentoEstoque, error) {
+	if !slices.Contains(models.TiposAjuste, request.Tipo) {
+		return models.MovimentoEstoque{}, erroValidacao(mensagens.EstoqueTipoAjusteInvalido, request.Tipo)
+	}
+
+	quantidade := request.Quantidade
+	if request.Tipo == models.MovimentoPerda {
+		if quantidade < 0 {
+			return models.MovimentoEstoque{}, erroValidacao(mensagens.EstoquePerdaPositiva)
+		}
+		quantidade = -quantidade
+	}
+
+	movimento := models.MovimentoEstoque{
+		ItemID:      itemID,
+		Tipo:        request.Tipo,
+		Quantidade:  quantidade,
+		Observacao:  request.Observacao,
+		Responsavel: request.Responsavel,
+	}
+	err := s.store.Transacao(func(tx repository.Store) error {
+		return lancar(tx, &movimento)
+	})
+	return movimento, err
+}
+
+// StopTracking zera o saldo com um AJUSTE, para que a soma dos movimentos continue
+// batendo, e deixa de controlar o estoque do item
+func (s *EstoqueService) StopTracking(itemID uint) error {
+	return s.store.Transacao(func(tx repository.Store) error {
+		estoque, err := tx.Estoque().Buscar(itemID)
+		if errors.Is(err, repository.ErrNaoEncontrado) {
+			return erroNaoEncontrado(mensagens.EstoqueNaoControlado)
+		}
+		if err != nil {
+			return erroInterno(mensagens.EstoqueErroRemover)
+		}
+
+		if estoque.Quantidade != 0 {
+			if err := lancar(tx, &models.MovimentoEstoque{
+				ItemID:     itemID,
+				Tipo:       models.MovimentoAjuste,
+				Quantidade: -estoque.Quantidade,
+			}); err != nil {
+				return err
+			}
+		}
+
+		if err := tx.Estoque().Remover(itemID); err != nil {
+			return erroInterno(mensagens.EstoqueErroRemover)
+		}
+		return nil
+	})
+}
+
+// StockAt soma os movimentos registrados até o fim do dia informado
+func (s *EstoqueService) StockAt(dia time.Time) ([]models.SaldoEstoque, error) {
+	saldos, err := s.store.Estoque().SaldosEm(dia.AddDate(0, 0, 1))
+	if err != nil {
+		return nil, erroInterno(mensagens.EstoqueErroRelatorio)
+	}
+	return saldos, nil
+}
+
+// lancar grava um movimento avulso, sem pedido, convertendo os erros do repositório
+func lancar(tx repository.Store, movimento *models.MovimentoEstoque) error {
+	err := tx.Estoque().Movimentar(movimento)
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, repository.ErrNaoEncontrado):
+		return erroNaoEncontrado(mensagens.EstoqueNaoControlado)
+	case errors.Is(err, repository.ErrConflito):
+		falta, err := faltaEstoque(tx, movimento.ItemID, -movimento.Quantidade)
+		if err != nil {
+			return err
+		}
+		return &ErroEstoque{Faltas: []FaltaEstoque{falta}}
+	}
+	return erroInterno(mensagens.EstoqueErroMovimentar)
+}
@@ -0,0 +1,168 @@
+package service
+
+import (
+	"testing"
+
+	"lanchonete/models"
+	"lanchonete/repository"
+)
+
+// controlarEstoque passa a controlar o estoque do item com o saldo informado
+func controlarEstoque(t *testing.T, store repository.Store, itemID uint, quantidade int) {
+	t.Helper()
+
+	_, err := NewEstoqueService(store).SetStock(itemID, models.EstoqueRequest{Quantidade: &quantidade, Unidade: models.UnidadeUnidade})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func conferirSaldo(t *testing.T, store repository.Store, itemID uint, esperado int) {
+	t.Helper()
+
+	estoque, err := store.Estoque().Buscar(itemID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if estoque.Quantidade != esperado {
+		t.Errorf("saldo do item %d = %d, esperado %d", itemID, estoque.Quantidade, esperado)
+	}
+}
+
+// trocarReceita muda a quantidade do ingrediente 1 na receita do hambúrguer 1
+func trocarReceita(t *testing.T, store repository.Store, quantidade int) {
+	t.Helper()
+
+	hamburguer, err := store.Hamburguers().Buscar(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hamburguer.HamburguerIngredientes = []models.HamburguerIngrediente{{HamburguerID: 1, ItemID: 1, Quantidade: quantidade}}
+	if err := store.Hamburguers().Salvar(&hamburguer); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCancelOrderDevolveOQueFoiBaixado(t *testing.T) {
+	depoisDaVenda := map[string]func(*testing.T, repository.Store){
+		"receita alterada": func(t *testing.T, store repository.Store) {
+			trocarReceita(t, store, 5)
+		},
+		"hambúrguer removido": func(t *testing.T, store repository.Store) {
+			if err := store.Hamburguers().Remover(1); err != nil {
+				t.Fatal(err)
+			}
+		},
+	}
+
+	for nome, alterar := range depoisDaVenda {
+		t.Run(nome, func(t *testing.T) {
+			store := repository.NewMemoriaStore()
+			cardapioDeTeste(t, store)
+			controlarEstoque(t, store, 1, 100)
+			controlarEstoque(t, store, 10, 10)
+			servico := NewPedidoService(store, nil)
+
+			pedido := pedidoDeTeste(t, servico)
+			conferirSaldo(t, store, 1, 98)
+			conferirSaldo(t, store, 10, 9)
+
+			alterar(t, store)
+
+			_, err := servico.CancelOrder(pedido.ID, models.PedidoCancelamentoRequest{CanceladoPor: "balcão", Motivo: models.MotivoClienteDesistiu})
+			if err != nil {
+				t.Fatal(err)
+			}
+			conferirSaldo(t, store, 1, 100)
+			conferirSaldo(t, store, 10, 10)
+		})
+	}
+}
+
+func TestAmendOrderAjustaOQueFoiBaixado(t *testing.T) {
+	store := repository.NewMemoriaStore()
+	cardapioDeTeste(t, store)
+	controlarEstoque(t, store, 1, 100)
+	controlarEstoque(t, store, 10, 10)
+	servico := NewPedidoService(store, nil)
+
+	pedido := pedidoDeTeste(t, servico)
+	trocarReceita(t, store, 3)
+
+	// Só as bebidas mudam: os ingredientes baixados com a receita antiga ficam como estão
+	_, err := servico.AmendOrder(pedido.ID, models.PedidoUpdateRequest{
+		Bebidas: []models.PedidoItemRequest{{ID: 10, Quantidade: 4}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	conferirSaldo(t, store, 1, 98)
+	conferirSaldo(t, store, 10, 6)
+
+	// As novas linhas de hambúrguer baixam pela receita vigente e as antigas devolvem o que baixaram
+	_, err = servico.AmendOrder(pedido.ID, models.PedidoUpdateRequest{
+		Hamburgueres: []models.PedidoHamburguerRequest{{ID: 1, Quantidade: 2}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	conferirSaldo(t, store, 1, 94)
+	conferirSaldo(t, store, 10, 6)
+
+	_, err = servico.CancelOrder(pedido.ID, models.PedidoCancelamentoRequest{CanceladoPor: "balcão", Motivo: models.MotivoClienteDesistiu})
+	if err != nil {
+		t.Fatal(err)
+	}
+	conferirSaldo(t, store, 1, 100)
+	conferirSaldo(t, store, 10, 10)
+}
+
+func TestCancelOrderEstornaOsMovimentosDoPedido(t *testing.T) {
+	store := repository.NewMemoriaStore()
+	cardapioDeTeste(t, store)
+	controlarEstoque(t, store, 1, 100)
+	controlarEstoque(t, store, 10, 10)
+	servico := NewPedidoService(store, nil)
+
+	pedido := pedidoDeTeste(t, servico)
+	outro := pedidoDeTeste(t, servico)
+	trocarReceita(t, store, 4)
+
+	if _, err := servico.CancelOrder(pedido.ID, models.PedidoCancelamentoRequest{CanceladoPor: "balcão", Motivo: models.MotivoClienteDesistiu}); err != nil {
+		t.Fatal(err)
+	}
+
+	movimentos, err := store.Estoque().Movimentos(repository.FiltroMovimentos{PedidoID: pedido.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	consumido, estornado := map[uint]int{}, map[uint]int{}
+	for _, movimento := range movimentos {
+		if movimento.PedidoID == nil || *movimento.PedidoID != pedido.ID {
+			t.Fatalf("movimento %d de outro pedido no filtro", movimento.ID)
+		}
+		switch movimento.Tipo {
+		case models.MovimentoConsumo:
+			consumido[movimento.ItemID] -= movimento.Quantidade
+		case models.MovimentoEstorno:
+			estornado[movimento.ItemID] += movimento.Quantidade
+		}
+	}
+	if consumido[1] != 2 || consumido[10] != 1 {
+		t.Errorf("consumo registrado = %v", consumido)
+	}
+	for itemID, quantidade := range consumido {
+		if estornado[itemID] != quantidade {
+			t.Errorf("item %d: estorno %d, consumo %d", itemID, estornado[itemID], quantidade)
+		}
+	}
+
+	// O pedido que continua aberto mantém a sua baixa
+	conferirSaldo(t, store, 1, 98)
+	conferirSaldo(t, store, 10, 9)
+	if _, err := servico.CancelOrder(outro.ID, models.PedidoCancelamentoRequest{CanceladoPor: "balcão", Motivo: models.MotivoClienteDesistiu}); err != nil {
+		t.Fatal(err)
+	}
+	conferirSaldo(t, store, 1, 100)
+	conferirSaldo(t, store, 10, 10)
+}
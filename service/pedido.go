@@ -0,0 +1,688 @@
+package service
+
+import (
+	"cmp"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"lanchonete/cep"
+	"lanchonete/mensagens"
+	"lanchonete/models"
+	"lanchonete/repository"
+)
+
+// PedidoService é a fonte única das regras de pedidos: quais linhas são válidas,
+// quanto custam e como o pedido pode mudar depois de criado
+type PedidoService struct {
+	store repository.Store
+	ceps  cep.Consulta
+}
+
+func NewPedidoService(store repository.Store, ceps cep.Consulta) *PedidoService {
+	return &PedidoService{store: store, ceps: ceps}
+}
+
+// Cotacao são as linhas de um pedido precificadas com os preços vigentes
+type Cotacao struct {
+	Hamburgueres []models.PedidoHamburguer
+	Bebidas      []models.PedidoBebida
+	Subtotal     models.Dinheiro     // soma das linhas, com os adicionais
+	Zona         *models.ZonaEntrega // zona que atende o endereço; nil quando a cotação não inclui a entrega
+	TaxaEntrega  models.Dinheiro
+	Descontos    []models.PedidoDesconto // promoções aplicadas, calculadas por aplicarPromocoes
+	Desconto     models.Dinheiro         // soma de Descontos, nunca maior que o subtotal
+	ValorTotal   models.Dinheiro
+}
+
+func (c *Cotacao) calcularTotal() {
+	c.Subtotal = 0
+	for _, linha := range c.Hamburgueres {
+		c.Subtotal += linha.Subtotal()
+	}
+	for _, linha := range c.Bebidas {
+		c.Subtotal += linha.Subtotal()
+	}
+	c.ValorTotal = c.Subtotal + c.TaxaEntrega - c.Desconto
+}
+
+// QuoteOrder valida e precifica o pedido sem gravar nada, com os descontos das promoções
+// vigentes e do cupom. Com o endereço informado ou salvo no cadastro do cliente, inclui
+// a taxa de entrega da zona que o atende.
+func (s *PedidoService) QuoteOrder(request models.PedidoRequest) (Cotacao, error) {
+	tipo := cmp.Or(request.Tipo, models.TipoDelivery)
+	if err := validarTipo(tipo, request.Mesa, request.Endereco, request.EnderecoID); err != nil {
+		return Cotacao{}, err
+	}
+
+	cotacao, err := cotar(s.store, request.Hamburgueres, request.Bebidas)
+	if err != nil {
+		return Cotacao{}, err
+	}
+	uso := usoPromocao{Cupom: request.Cupom, Telefone: request.Telefone, Instante: time.Now()}
+	if err := aplicarPromocoes(s.store, &cotacao, uso); err != nil {
+		return Cotacao{}, err
+	}
+	if !tipo.ComEntrega() {
+		return cotacao, nil
+	}
+
+	endereco, ok, err := enderecoCotacao(s.store, s.ceps, request)
+	if err != nil {
+		return Cotacao{}, err
+	}
+	if ok {
+		if err := cotarEntrega(s.store, &cotacao, endereco); err != nil {
+			return Cotacao{}, err
+		}
+	}
+	return cotacao, nil
+}
+
+// PlaceOrder valida, precifica e grava um novo pedido com seu primeiro registro de histórico,
+// baixando do estoque os itens consumidos na mesma transação. O cliente é cadastrado
+// no primeiro pedido do telefone, e o pedido guarda uma cópia do nome e, nas entregas,
+// do endereço. Sem tipo informado, o pedido é uma entrega. Os descontos das promoções
+// são gravados junto com o pedido.
+func (s *PedidoService) PlaceOrder(request models.PedidoRequest) (models.Pedido, error) {
+	pedido := models.Pedido{
+		Descricao:   request.Descricao,
+		Tipo:        cmp.Or(request.Tipo, models.TipoDelivery),
+		Mesa:        request.Mesa,
+		Telefone:    request.Telefone,
+		Observacoes: request.Observacoes,
+		Status:      models.StatusStarted,
+	}
+	if err := validarTipo(pedido.Tipo, pedido.Mesa, request.Endereco, request.EnderecoID); err != nil {
+		return models.Pedido{}, err
+	}
+
+	err := s.store.Transacao(func(tx repository.Store) error {
+		cotacao, err := cotar(tx, request.Hamburgueres, request.Bebidas)
+		if err != nil {
+			return err
+		}
+		uso := usoPromocao{Cupom: request.Cupom, Telefone: request.Telefone, Instante: time.Now()}
+		if err := aplicarPromocoes(tx, &cotacao, uso); err != nil {
+			return err
+		}
+
+		if pedido.Tipo.ComEntrega() {
+			entrega, err := identificarCliente(tx, s.ceps, request.Telefone, request.Nome, request.Endereco, request.EnderecoID)
+			if err != nil {
+				return err
+			}
+			pedido.Nome, pedido.Endereco, pedido.EnderecoEntrega = entrega.Nome, entrega.Endereco, entrega.EnderecoEntrega
+
+			if err := cotarEntrega(tx, &cotacao, pedido.EnderecoEntrega); err != nil {
+				return err
+			}
+			aplicarEntrega(&pedido, cotacao)
+		} else {
+			nome, err := identificarClienteSemEntrega(tx, request.Telefone, request.Nome)
+			if err != nil {
+				return err
+			}
+			pedido.Nome = nome
+		}
+
+		pedido.Desconto, pedido.ValorTotal = cotacao.Desconto, cotacao.ValorTotal
+		if err := tx.Pedidos().Criar(&pedido); err != nil {
+			return erroInterno(mensagens.PedidoErroCriar)
+		}
+		if err := gravarDescontos(tx, pedido.ID, cotacao.Descontos); err != nil {
+			return err
+		}
+
+		if err := registrarHistorico(tx, pedido.ID, "", pedido.Status, pedido.Nome); err != nil {
+			return erroInterno(mensagens.PedidoErroRegistrarHistorico)
+		}
+
+		if err := gravarHamburgueres(tx, pedido.ID, cotacao.Hamburgueres); err != nil {
+			return err
+		}
+		if err := gravarBebidas(tx, pedido.ID, cotacao.Bebidas); err != nil {
+			return err
+		}
+
+		baixa := consumo{}.menos(consumoDasLinhas(tx, cotacao.Hamburgueres, cotacao.Bebidas))
+		return movimentarEstoque(tx, pedido.ID, baixa)
+	})
+	if err != nil {
+		return models.Pedido{}, err
+	}
+
+	return s.buscar(pedido.ID)
+}
+
+// AmendOrder altera os dados do pedido e substitui as linhas informadas. Grupos de
+// linhas não informados são mantidos com os preços gravados na compra. O tipo só muda
+// antes de o pedido sair do preparo, já que cada tipo tem o seu fluxo de status. Os
+// descontos são recalculados quando as linhas ou o cupom mudam, com as promoções
+// vigentes no horário do pedido. Pedidos finalizados ou cancelados não são alterados.
+func (s *PedidoService) AmendOrder(id uuid.UUID, request models.PedidoUpdateRequest) (models.Pedido, error) {
+	err := s.store.Transacao(func(tx repository.Store) error {
+		// Bloqueia o pedido antes de ler as linhas, para que um cancelamento simultâneo
+		// não estorne o estoque de linhas que esta alteração está substituindo
+		if _, err := tx.Pedidos().BuscarParaAtualizar(id); err != nil {
+			return falhaBuscaPedido(err)
+		}
+		pedido, err := tx.Pedidos().Buscar(id)
+		if err != nil {
+			return falhaBuscaPedido(err)
+		}
+
+		// Pedidos finalizados ou cancelados já foram cobrados ou estornados
+		if slices.Contains(models.StatusEncerrados, pedido.Status) {
+			return erroConflito(mensagens.AlteracaoNaoPermitida, pedido.Status)
+		}
+
+		// Atualizar campos básicos se fornecidos
+		if request.Descricao != "" {
+			pedido.Descricao = request.Descricao
+		}
+		if request.Nome != "" {
+			pedido.Nome = request.Nome
+		}
+
+		// A mesa não passa de um tipo para outro: só vale a informada junto com o novo tipo
+		tipoAlterado := request.Tipo != "" && request.Tipo != pedido.Tipo
+		if tipoAlterado {
+			pedido.Tipo, pedido.Mesa = request.Tipo, 0
+		}
+		pedido.Mesa = cmp.Or(request.Mesa, pedido.Mesa)
+		if err := validarTipo(pedido.Tipo, pedido.Mesa, request.Endereco, request.EnderecoID); err != nil {
+			return err
+		}
+		if tipoAlterado && pedido.Status != models.StatusStarted {
+			return erroConflito(mensagens.TipoAlteracaoNaoPermitida, pedido.Status)
+		}
+
+		// Trocar o telefone ou o endereço passa pelo cadastro do cliente, como na criação;
+		// um pedido que deixa de ser entrega perde o endereço
+		enderecoAlterado := request.Telefone != "" || request.Endereco != nil || request.EnderecoID != 0 || tipoAlterado
+		switch {
+		case !pedido.Tipo.ComEntrega() && enderecoAlterado:
+			pedido.Telefone = cmp.Or(request.Telefone, pedido.Telefone)
+			if _, err := identificarClienteSemEntrega(tx, pedido.Telefone, pedido.Nome); err != nil {
+				return err
+			}
+			pedido.Endereco, pedido.EnderecoEntrega = "", models.EnderecoEntrega{}
+			aplicarEntrega(&pedido, Cotacao{})
+
+		case enderecoAlterado:
+			pedido.Telefone = cmp.Or(request.Telefone, pedido.Telefone)
+
+			// Só o telefone mudou: o pedido mantém o endereço, que vai para o cadastro do novo cliente
+			endereco := request.Endereco
+			if endereco == nil && request.EnderecoID == 0 && pedido.CEP != "" {
+				endereco = &models.EnderecoRequest{
+					CEP:         pedido.CEP,
+					Logradouro:  pedido.Logradouro,
+					Numero:      pedido.Numero,
+					Complemento: pedido.Complemento,
+					Bairro:      pedido.Bairro,
+					Cidade:      pedido.Cidade,
+					UF:          pedido.UF,
+				}
+			}
+
+			entrega, err := identificarCliente(tx, s.ceps, pedido.Telefone, pedido.Nome, endereco, request.EnderecoID)
+			if err != nil {
+				return err
+			}
+			pedido.Endereco, pedido.EnderecoEntrega = entrega.Endereco, entrega.EnderecoEntrega
+		}
+		if request.Observacoes != "" {
+			pedido.Observacoes = request.Observacoes
+		}
+
+		cotacao := Cotacao{Hamburgueres: pedido.PedidoHamburgueres, Bebidas: pedido.PedidoBebidas}
+
+		// Valida todas as linhas novas antes de apagar as antigas
+		var invalidas linhasInvalidas
+		if len(request.Hamburgueres) > 0 {
+			cotacao.Hamburgueres = precificarHamburgueres(tx, request.Hamburgueres, &invalidas)
+		}
+		if len(request.Bebidas) > 0 {
+			cotacao.Bebidas = precificarBebidas(tx, request.Bebidas, &invalidas)
+		}
+		if err := invalidas.erro(); err != nil {
+			return err
+		}
+
+		// As linhas substituídas devolvem o que foi baixado para elas e as novas baixam
+		// o estoque pela receita vigente
+		var substituidos []models.TipoItem
+		var novasHamburgueres []models.PedidoHamburguer
+		var novasBebidas []models.PedidoBebida
+		if len(request.Hamburgueres) > 0 {
+			substituidos = append(substituidos, models.TipoIngrediente)
+			novasHamburgueres = cotacao.Hamburgueres
+		}
+		if len(request.Bebidas) > 0 {
+			substituidos = append(substituidos, models.TipoBebida)
+			novasBebidas = cotacao.Bebidas
+		}
+		if len(substituidos) > 0 {
+			anterior, err := consumoRegistrado(tx, pedido.ID, substituidos...)
+			if err != nil {
+				return err
+			}
+			novo := consumoDasLinhas(tx, novasHamburgueres, novasBebidas)
+			if err := movimentarEstoque(tx, pedido.ID, anterior.menos(novo)); err != nil {
+				return err
+			}
+		}
+
+		if len(request.Hamburgueres) > 0 {
+			if err := tx.Pedidos().RemoverHamburgueres(pedido.ID); err != nil {
+				return erroInterno(mensagens.PedidoErroAtualizarHamburgueres)
+			}
+			if err := gravarHamburgueres(tx, pedido.ID, cotacao.Hamburgueres); err != nil {
+				return err
+			}
+		}
+		if len(request.Bebidas) > 0 {
+			if err := tx.Pedidos().RemoverBebidas(pedido.ID); err != nil {
+				return erroInterno(mensagens.PedidoErroAtualizarBebidas)
+			}
+			if err := gravarBebidas(tx, pedido.ID, cotacao.Bebidas); err != nil {
+				return err
+			}
+		}
+
+		// Sem novo cupom, o cupom já aplicado continua valendo enquanto se aplicar às linhas
+		linhasAlteradas := len(request.Hamburgueres) > 0 || len(request.Bebidas) > 0
+		cotacao.Desconto = pedido.Desconto
+		if linhasAlteradas || request.Cupom != "" {
+			uso := usoPromocao{
+				Cupom:      cmp.Or(request.Cupom, pedido.Cupom()),
+				CupomSalvo: request.Cupom == "",
+				Telefone:   pedido.Telefone,
+				Instante:   pedido.Data,
+				Pedido:     pedido.ID,
+			}
+			if err := aplicarPromocoes(tx, &cotacao, uso); err != nil {
+				return err
+			}
+			if err := gravarDescontos(tx, pedido.ID, cotacao.Descontos); err != nil {
+				return err
+			}
+			pedido.Desconto = cotacao.Desconto
+		}
+
+		// A entrega é recalculada com as zonas atuais quando o endereço ou as linhas mudam;
+		// pedidos anteriores ao endereço estruturado mantêm a taxa gravada
+		if pedido.CEP != "" && (enderecoAlterado || linhasAlteradas) {
+			if err := cotarEntrega(tx, &cotacao, pedido.EnderecoEntrega); err != nil {
+				return err
+			}
+			aplicarEntrega(&pedido, cotacao)
+		}
+		cotacao.TaxaEntrega = pedido.TaxaEntrega
+		cotacao.calcularTotal()
+		pedido.ValorTotal = cotacao.ValorTotal
+
+		if err := tx.Pedidos().Salvar(&pedido); err != nil {
+			return erroInterno(mensagens.PedidoErroAtualizar)
+		}
+		return nil
+	})
+	if err != nil {
+		return models.Pedido{}, err
+	}
+
+	return s.buscar(id)
+}
+
+// ChangeStatus move o pedido pelo fluxo do seu tipo: STARTED → DELIVERY → FINALIZED nas
+// entregas, STARTED → READY_FOR_PICKUP → FINALIZED nas retiradas e STARTED → SERVED →
+// FINALIZED no consumo no local. O cancelamento exige motivo e responsável e é feito por CancelOrder.
+func (s *PedidoService) ChangeStatus(id uuid.UUID, request models.PedidoStatusRequest) (models.Pedido, error) {
+	if !request.Status.Valido() {
+		return models.Pedido{}, erroValidacao(mensagens.StatusInvalido, request.Status)
+	}
+	if request.Status == models.StatusCancelled {
+		return models.Pedido{}, erroValidacao(mensagens.CancelarPelaRota)
+	}
+
+	err := s.store.Transacao(func(tx repository.Store) error {
+		// Bloqueia o pedido para que duas transições simultâneas não partam do mesmo status
+		pedido, err := tx.Pedidos().BuscarParaAtualizar(id)
+		if err != nil {
+			return falhaBuscaPedido(err)
+		}
+
+		if !pedido.Tipo.PodeSeguir(pedido.Status, request.Status) {
+			return erroTransicao(pedido, mensagens.MudancaStatusNaoPermitida, pedido.Status, request.Status)
+		}
+
+		statusAnterior := pedido.Status
+		pedido.Status = request.Status
+		if err := tx.Pedidos().Salvar(&pedido); err != nil {
+			return erroInterno(mensagens.PedidoErroAtualizarStatus)
+		}
+
+		if err := registrarHistorico(tx, pedido.ID, statusAnterior, request.Status, request.Ator); err != nil {
+			return erroInterno(mensagens.PedidoErroRegistrarHistorico)
+		}
+		return nil
+	})
+	if err != nil {
+		return models.Pedido{}, err
+	}
+
+	return s.buscar(id)
+}
+
+// CancelOrder cancela um pedido ainda não finalizado, registrando quem cancelou, quando,
+// o motivo e, se pedido, o valor a ser reembolsado. Os itens consumidos voltam ao estoque.
+func (s *PedidoService) CancelOrder(id uuid.UUID, request models.PedidoCancelamentoRequest) (models.Pedido, error) {
+	if !request.Motivo.Valido() {
+		return models.Pedido{}, erroValidacao(mensagens.MotivoCancelamentoInvalido, request.Motivo)
+	}
+
+	err := s.store.Transacao(func(tx repository.Store) error {
+		pedido, err := tx.Pedidos().BuscarParaAtualizar(id)
+		if err != nil {
+			return falhaBuscaPedido(err)
+		}
+
+		if !pedido.Tipo.PodeSeguir(pedido.Status, models.StatusCancelled) {
+			return erroTransicao(pedido, mensagens.CancelamentoNaoPermitido, pedido.Status)
+		}
+
+		// Devolve o que foi baixado na venda e nas alterações, como está no registro
+		baixado, err := consumoRegistrado(tx, pedido.ID)
+		if err != nil {
+			return err
+		}
+		if err := movimentarEstoque(tx, pedido.ID, baixado); err != nil {
+			return err
+		}
+
+		agora := time.Now()
+		statusAnterior := pedido.Status
+		pedido.Status = models.StatusCancelled
+		pedido.CanceladoPor = request.CanceladoPor
+		pedido.CanceladoEm = &agora
+		pedido.MotivoCancelamento = request.Motivo
+		pedido.ReembolsoNecessario = request.Reembolsar
+		if request.Reembolsar {
+			pedido.ValorReembolso = pedido.ValorTotal
+		}
+
+		if err := tx.Pedidos().Salvar(&pedido); err != nil {
+			return erroInterno(mensagens.PedidoErroCancelar)
+		}
+
+		if err := registrarHistorico(tx, pedido.ID, statusAnterior, models.StatusCancelled, request.CanceladoPor); err != nil {
+			return erroInterno(mensagens.PedidoErroRegistrarHistorico)
+		}
+		return nil
+	})
+	if err != nil {
+		return models.Pedido{}, err
+	}
+
+	return s.buscar(id)
+}
+
+// RefundOrder marca como reembolsado um pedido cancelado com reembolso pendente
+func (s *PedidoService) RefundOrder(id uuid.UUID) (models.Pedido, error) {
+	err := s.store.Transacao(func(tx repository.Store) error {
+		pedido, err := tx.Pedidos().BuscarParaAtualizar(id)
+		if err != nil {
+			return falhaBuscaPedido(err)
+		}
+
+		if !pedido.ReembolsoNecessario || pedido.ReembolsadoEm != nil {
+			return erroConflito(mensagens.SemReembolsoPendente)
+		}
+
+		agora := time.Now()
+		pedido.ReembolsadoEm = &agora
+
+		if err := tx.Pedidos().Salvar(&pedido); err != nil {
+			return erroInterno(mensagens.PedidoErroRegistrarReembolso)
+		}
+		return nil
+	})
+	if err != nil {
+		return models.Pedido{}, err
+	}
+
+	return s.buscar(id)
+}
+
+// buscar carrega o pedido gravado com suas linhas para ser devolvido ao chamador
+func (s *PedidoService) buscar(id uuid.UUID) (models.Pedido, error) {
+	pedido, err := s.store.Pedidos().Buscar(id)
+	if err != nil {
+		return models.Pedido{}, erroInterno(mensagens.PedidoErroCarregar)
+	}
+	return pedido, nil
+}
+
+// validarTipo confere os dados que cada tipo de pedido exige: só as entregas têm
+// endereço, e a mesa é obrigatória no consumo no local e recusada nos demais tipos
+func validarTipo(tipo models.TipoPedido, mesa int, endereco *models.EnderecoRequest, enderecoID uint) error {
+	if !tipo.Valido() {
+		return erroValidacao(mensagens.TipoPedidoInvalido, tipo)
+	}
+	if !tipo.ComEntrega() && (endereco != nil || enderecoID != 0) {
+		return erroValidacao(mensagens.PedidoSemEntrega, tipo)
+	}
+	if tipo == models.TipoDineIn && mesa == 0 {
+		return erroValidacao(mensagens.PedidoMesaObrigatoria)
+	}
+	if tipo != models.TipoDineIn && mesa != 0 {
+		return erroValidacao(mensagens.PedidoMesaForaDoLocal)
+	}
+	return nil
+}
+
+// cotar valida e precifica as linhas com os preços vigentes, recusando o pedido com
+// todas as linhas inválidas de uma vez
+func cotar(store repository.Store, hamburgueres []models.PedidoHamburguerRequest, bebidas []models.PedidoItemRequest) (Cotacao, error) {
+	var invalidas linhasInvalidas
+	cotacao := Cotacao{
+		Hamburgueres: precificarHamburgueres(store, hamburgueres, &invalidas),
+		Bebidas:      precificarBebidas(store, bebidas, &invalidas),
+	}
+	if err := invalidas.erro(); err != nil {
+		return Cotacao{}, err
+	}
+
+	cotacao.calcularTotal()
+	return cotacao, nil
+}
+
+// precificarHamburgueres monta as linhas válidas e registra as recusadas em invalidas
+func precificarHamburgueres(store repository.Store, requests []models.PedidoHamburguerRequest, invalidas *linhasInvalidas) []models.PedidoHamburguer {
+	linhas := make([]models.PedidoHamburguer, 0, len(requests))
+	for i, request := range requests {
+		linha, err := montarLinhaHamburguer(store, request)
+		if err != nil {
+			invalidas.recusar("hamburgueres", i, request.ID, err)
+			continue
+		}
+		linhas = append(linhas, linha)
+	}
+	return linhas
+}
+
+// precificarBebidas monta as linhas válidas e registra as recusadas em invalidas. O pedido
+// guarda uma linha por bebida, por isso a mesma bebida repetida é recusada.
+func precificarBebidas(store repository.Store, requests []models.PedidoItemRequest, invalidas *linhasInvalidas) []models.PedidoBebida {
+	linhas := make([]models.PedidoBebida, 0, len(requests))
+	primeira := make(map[uint]int, len(requests))
+	for i, request := range requests {
+		if anterior, repetida := primeira[request.ID]; repetida {
+			invalidas.recusar("bebidas", i, request.ID, erroValidacao(mensagens.BebidaRepetida, anterior))
+			continue
+		}
+		primeira[request.ID] = i
+
+		linha, err := montarLinhaBebida(store, request)
+		if err != nil {
+			invalidas.recusar("bebidas", i, request.ID, err)
+			continue
+		}
+		linhas = append(linhas, linha)
+	}
+	return linhas
+}
+
+// montarLinhaHamburguer valida a disponibilidade e a personalização de uma linha contra a
+// receita do hambúrguer e os itens extras, gravando na linha os preços vigentes no momento da compra
+func montarLinhaHamburguer(store repository.Store, request models.PedidoHamburguerRequest) (models.PedidoHamburguer, error) {
+	hamburguer, err := store.Hamburguers().Buscar(request.ID)
+	if err != nil {
+		return models.PedidoHamburguer{}, erroValidacao(mensagens.HamburguerNaoEncontrado)
+	}
+	if !hamburguer.Disponivel {
+		return models.PedidoHamburguer{}, erroValidacao(mensagens.HamburguerIndisponivel, hamburguer.Descricao)
+	}
+
+	receita := make(map[uint]models.Item, len(hamburguer.HamburguerIngredientes))
+	for _, ingrediente := range hamburguer.HamburguerIngredientes {
+		receita[ingrediente.ItemID] = ingrediente.Item
+	}
+
+	linha := models.PedidoHamburguer{
+		HamburguerID:  hamburguer.ID,
+		Quantidade:    request.Quantidade,
+		Descricao:     hamburguer.Descricao,
+		PrecoUnitario: hamburguer.Preco,
+	}
+
+	removidos := make(map[uint]bool, len(request.Remover))
+	for _, itemID := range request.Remover {
+		item, ok := receita[itemID]
+		if !ok {
+			return models.PedidoHamburguer{}, erroValidacao(mensagens.ItemForaDaReceita, itemID, hamburguer.Descricao)
+		}
+		if removidos[itemID] {
+			continue
+		}
+		removidos[itemID] = true
+
+		linha.Personalizacoes = append(linha.Personalizacoes, models.PedidoHamburguerPersonalizacao{
+			ItemID:     itemID,
+			Acao:       models.AcaoRemover,
+			Quantidade: 1,
+			Descricao:  item.Descricao,
+		})
+	}
+
+	// Um ingrediente em falta só impede a venda se não foi retirado da receita pelo cliente
+	for _, ingrediente := range hamburguer.HamburguerIngredientes {
+		if !ingrediente.Item.Disponivel && !removidos[ingrediente.ItemID] {
+			return models.PedidoHamburguer{}, erroValidacao(mensagens.HamburguerSemIngrediente, hamburguer.Descricao, ingrediente.Item.Descricao)
+		}
+	}
+
+	for _, adicional := range request.Adicionar {
+		if removidos[adicional.ID] {
+			return models.PedidoHamburguer{}, erroValidacao(mensagens.ItemAdicionadoERemovido, adicional.ID)
+		}
+
+		item, err := store.Itens().Buscar(adicional.ID)
+		if err != nil {
+			return models.PedidoHamburguer{}, erroValidacao(mensagens.IngredienteNaoEncontradoCodigo, adicional.ID)
+		}
+
+		// Só ingredientes marcados como extra podem ser adicionados
+		if item.Tipo != models.TipoIngrediente || !item.Extra {
+			return models.PedidoHamburguer{}, erroValidacao(mensagens.ItemNaoPodeSerExtra, item.Descricao)
+		}
+		if !item.Disponivel {
+			return models.PedidoHamburguer{}, erroValidacao(mensagens.ItemIndisponivel, item.Descricao)
+		}
+
+		linha.Personalizacoes = append(linha.Personalizacoes, models.PedidoHamburguerPersonalizacao{
+			ItemID:        item.ID,
+			Acao:          models.AcaoAdicionar,
+			Quantidade:    adicional.Quantidade,
+			Descricao:     item.Descricao,
+			PrecoUnitario: item.Preco,
+		})
+	}
+
+	return linha, nil
+}
+
+// montarLinhaBebida valida que o item é uma bebida e grava na linha a descrição e o preço vigentes no momento da compra
+func montarLinhaBebida(store repository.Store, request models.PedidoItemRequest) (models.PedidoBebida, error) {
+	bebida, err := store.Itens().Buscar(request.ID)
+	if err != nil {
+		return models.PedidoBebida{}, erroValidacao(mensagens.BebidaNaoEncontrada)
+	}
+
+	// Assim como na receita dos hambúrgueres, o tipo do item é conferido
+	if bebida.Tipo != models.TipoBebida {
+		return models.PedidoBebida{}, erroValidacao(mensagens.ItemNaoEBebida, bebida.Descricao)
+	}
+	if !bebida.Disponivel {
+		return models.PedidoBebida{}, erroValidacao(mensagens.ItemIndisponivel, bebida.Descricao)
+	}
+
+	return models.PedidoBebida{
+		ItemID:        bebida.ID,
+		Quantidade:    request.Quantidade,
+		Descricao:     bebida.Descricao,
+		PrecoUnitario: bebida.Preco,
+	}, nil
+}
+
+func gravarHamburgueres(tx repository.Store, pedidoID uuid.UUID, linhas []models.PedidoHamburguer) error {
+	for i := range linhas {
+		linhas[i].PedidoID = pedidoID
+		if err := tx.Pedidos().AdicionarHamburguer(&linhas[i]); err != nil {
+			return falhaRepositorio(err, mensagens.HamburguerRemovidoDoCardapio, mensagens.PedidoErroAdicionarHamburguer)
+		}
+	}
+	return nil
+}
+
+func gravarBebidas(tx repository.Store, pedidoID uuid.UUID, linhas []models.PedidoBebida) error {
+	for i := range linhas {
+		linhas[i].PedidoID = pedidoID
+		if err := tx.Pedidos().AdicionarBebida(&linhas[i]); err != nil {
+			return falhaRepositorio(err, mensagens.BebidaRepetidaOuRemovida, mensagens.PedidoErroAdicionarBebida)
+		}
+	}
+	return nil
+}
+
+// registrarHistorico grava uma transição de status do pedido dentro da transação informada
+func registrarHistorico(tx repository.Store, pedidoID uuid.UUID, anterior, novo models.StatusPedido, ator string) error {
+	return tx.Pedidos().RegistrarHistorico(&models.PedidoStatusHistorico{
+		PedidoID:       pedidoID,
+		StatusAnterior: anterior,
+		StatusNovo:     novo,
+		Ator:           ator,
+		Data:           time.Now(),
+	})
+}
+
+// falhaBuscaPedido converte o erro ao carregar o pedido em não encontrado, quando ele
+// não existe, ou em erro interno
+func falhaBuscaPedido(err error) error {
+	if errors.Is(err, repository.ErrNaoEncontrado) {
+		return erroNaoEncontrado(mensagens.PedidoNaoEncontrado)
+	}
+	return erroInterno(mensagens.PedidoErroCarregar)
+}
+
+// falhaRepositorio converte um erro do repositório em conflito, quando a operação viola
+// a integridade dos dados, ou em erro interno com a mensagem padrão
+func falhaRepositorio(err error, conflito, mensagem mensagens.Chave) error {
+	if errors.Is(err, repository.ErrConflito) {
+		return erroConflito(conflito)
+	}
+	return erroInterno(mensagem)
+}
@@ -0,0 +1,451 @@
+package service
+
+import (
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	"lanchonete/cep"
+	"lanchonete/models"
+	"lanchonete/repository"
+)
+
+// cardapioDeTeste grava no store um ingrediente, uma bebida e um hambúrguer com
+// duas unidades do ingrediente na receita
+func cardapioDeTeste(t *testing.T, store repository.Store) {
+	t.Helper()
+
+	itens := []models.Item{
+		{ID: 1, Tipo: models.TipoIngrediente, Descricao: "Pão", Preco: models.Centavos(150), Disponivel: true},
+		{ID: 10, Tipo: models.TipoBebida, Descricao: "Refrigerante", Preco: models.Centavos(600), Disponivel: true},
+	}
+	for i := range itens {
+		if err := store.Itens().Criar(&itens[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	hamburguer := models.Hamburguer{
+		ID:                     1,
+		Descricao:              "X-Burguer",
+		Preco:                  models.Centavos(2590),
+		Disponivel:             true,
+		HamburguerIngredientes: []models.HamburguerIngrediente{{HamburguerID: 1, ItemID: 1, Quantidade: 2}},
+	}
+	if err := store.Hamburguers().Criar(&hamburguer); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// pedidoDeTeste cria um pedido de retirada com um hambúrguer e uma bebida
+func pedidoDeTeste(t *testing.T, servico *PedidoService) models.Pedido {
+	t.Helper()
+
+	pedido, err := servico.PlaceOrder(models.PedidoRequest{
+		Descricao:    "Pedido de teste",
+		Tipo:         models.TipoPickup,
+		Nome:         "Maria",
+		Telefone:     "11987654321",
+		Hamburgueres: []models.PedidoHamburguerRequest{{ID: 1, Quantidade: 1}},
+		Bebidas:      []models.PedidoItemRequest{{ID: 10, Quantidade: 1}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pedido
+}
+
+func TestAmendOrderRecusaPedidoEncerrado(t *testing.T) {
+	encerrar := map[models.StatusPedido]func(*PedidoService, models.Pedido) error{
+		models.StatusFinalized: func(servico *PedidoService, pedido models.Pedido) error {
+			for _, status := range []models.StatusPedido{models.StatusReadyForPickup, models.StatusFinalized} {
+				if _, err := servico.ChangeStatus(pedido.ID, models.PedidoStatusRequest{Status: status, Ator: "balcão"}); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		models.StatusCancelled: func(servico *PedidoService, pedido models.Pedido) error {
+			_, err := servico.CancelOrder(pedido.ID, models.PedidoCancelamentoRequest{CanceladoPor: "balcão", Motivo: models.MotivoClienteDesistiu})
+			return err
+		},
+	}
+
+	for status, encerra := range encerrar {
+		t.Run(string(status), func(t *testing.T) {
+			store := repository.NewMemoriaStore()
+			cardapioDeTeste(t, store)
+			servico := NewPedidoService(store, nil)
+
+			pedido := pedidoDeTeste(t, servico)
+			if err := encerra(servico, pedido); err != nil {
+				t.Fatal(err)
+			}
+
+			_, err := servico.AmendOrder(pedido.ID, models.PedidoUpdateRequest{
+				Observacoes:  "sem cebola",
+				Hamburgueres: []models.PedidoHamburguerRequest{{ID: 1, Quantidade: 3}},
+			})
+			if !errors.Is(err, ErrConflito) {
+				t.Fatalf("AmendOrder em pedido %s: erro %v, esperado ErrConflito", status, err)
+			}
+
+			gravado, err := store.Pedidos().Buscar(pedido.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if gravado.Status != status {
+				t.Errorf("status = %s, esperado %s", gravado.Status, status)
+			}
+			if gravado.ValorTotal != pedido.ValorTotal {
+				t.Errorf("valor total = %s, esperado %s", gravado.ValorTotal, pedido.ValorTotal)
+			}
+			if len(gravado.PedidoHamburgueres) != 1 || gravado.PedidoHamburgueres[0].Quantidade != 1 {
+				t.Errorf("linhas de hambúrguer alteradas: %+v", gravado.PedidoHamburgueres)
+			}
+			if gravado.Observacoes != "" {
+				t.Errorf("observações alteradas: %q", gravado.Observacoes)
+			}
+		})
+	}
+}
+
+func TestBebidaRepetidaRecusadaNaCotacaoENoPedido(t *testing.T) {
+	store := repository.NewMemoriaStore()
+	cardapioDeTeste(t, store)
+	servico := NewPedidoService(store, nil)
+
+	request := models.PedidoRequest{
+		Descricao:    "Pedido de teste",
+		Tipo:         models.TipoPickup,
+		Nome:         "Maria",
+		Telefone:     "11987654321",
+		Hamburgueres: []models.PedidoHamburguerRequest{{ID: 1, Quantidade: 1}},
+		Bebidas:      []models.PedidoItemRequest{{ID: 10, Quantidade: 1}, {ID: 10, Quantidade: 2}},
+	}
+
+	conferir := func(operacao string, err error) {
+		t.Helper()
+
+		var linhas *ErroLinhas
+		if !errors.As(err, &linhas) || !errors.Is(err, ErrValidacao) {
+			t.Fatalf("%s: erro %v, esperado ErroLinhas", operacao, err)
+		}
+		if len(linhas.Linhas) != 1 || linhas.Linhas[0].Campo != "bebidas[1]" || linhas.Linhas[0].ID != 10 {
+			t.Errorf("%s: linhas recusadas %+v, esperado bebidas[1]", operacao, linhas.Linhas)
+		}
+	}
+
+	_, err := servico.QuoteOrder(request)
+	conferir("QuoteOrder", err)
+
+	_, err = servico.PlaceOrder(request)
+	conferir("PlaceOrder", err)
+
+	pedido := pedidoDeTeste(t, servico)
+	_, err = servico.AmendOrder(pedido.ID, models.PedidoUpdateRequest{Bebidas: request.Bebidas})
+	conferir("AmendOrder", err)
+}
+
+// storeSemPedidos simula um banco indisponível na leitura dos pedidos
+type storeSemPedidos struct {
+	repository.Store
+}
+
+func (s storeSemPedidos) Pedidos() repository.PedidoRepository {
+	return pedidosSemLeitura{s.Store.Pedidos()}
+}
+
+func (s storeSemPedidos) Transacao(fn func(tx repository.Store) error) error {
+	return s.Store.Transacao(func(tx repository.Store) error {
+		return fn(storeSemPedidos{tx})
+	})
+}
+
+type pedidosSemLeitura struct {
+	repository.PedidoRepository
+}
+
+var errBancoIndisponivel = errors.New("conexão recusada")
+
+func (pedidosSemLeitura) Buscar(uuid.UUID) (models.Pedido, error) {
+	return models.Pedido{}, errBancoIndisponivel
+}
+
+func (pedidosSemLeitura) BuscarParaAtualizar(uuid.UUID) (models.Pedido, error) {
+	return models.Pedido{}, errBancoIndisponivel
+}
+
+func TestPedidoNaoEncontradoSoQuandoNaoExiste(t *testing.T) {
+	operacoes := map[string]func(*PedidoService, uuid.UUID) error{
+		"AmendOrder": func(servico *PedidoService, id uuid.UUID) error {
+			_, err := servico.AmendOrder(id, models.PedidoUpdateRequest{Observacoes: "sem cebola"})
+			return err
+		},
+		"ChangeStatus": func(servico *PedidoService, id uuid.UUID) error {
+			_, err := servico.ChangeStatus(id, models.PedidoStatusRequest{Status: models.StatusReadyForPickup, Ator: "balcão"})
+			return err
+		},
+		"CancelOrder": func(servico *PedidoService, id uuid.UUID) error {
+			_, err := servico.CancelOrder(id, models.PedidoCancelamentoRequest{CanceladoPor: "balcão", Motivo: models.MotivoClienteDesistiu})
+			return err
+		},
+		"RefundOrder": func(servico *PedidoService, id uuid.UUID) error {
+			_, err := servico.RefundOrder(id)
+			return err
+		},
+	}
+
+	for nome, operacao := range operacoes {
+		t.Run(nome, func(t *testing.T) {
+			store := repository.NewMemoriaStore()
+			cardapioDeTeste(t, store)
+			pedido := pedidoDeTeste(t, NewPedidoService(store, nil))
+
+			if err := operacao(NewPedidoService(store, nil), uuid.New()); !errors.Is(err, ErrNaoEncontrado) {
+				t.Errorf("pedido inexistente: erro %v, esperado ErrNaoEncontrado", err)
+			}
+			if err := operacao(NewPedidoService(storeSemPedidos{store}, nil), pedido.ID); !errors.Is(err, ErrInterno) {
+				t.Errorf("falha do banco: erro %v, esperado ErrInterno", err)
+			}
+		})
+	}
+}
+
+func TestCancelOrderEAmendOrderSimultaneos(t *testing.T) {
+	for range 50 {
+		store := repository.NewMemoriaStore()
+		cardapioDeTeste(t, store)
+		controlarEstoque(t, store, 1, 100)
+		controlarEstoque(t, store, 10, 10)
+		servico := NewPedidoService(store, nil)
+		pedido := pedidoDeTeste(t, servico)
+
+		var grupo sync.WaitGroup
+		var errCancelamento, errAlteracao error
+		largada := make(chan struct{})
+		grupo.Add(2)
+		go func() {
+			defer grupo.Done()
+			<-largada
+			_, errCancelamento = servico.CancelOrder(pedido.ID, models.PedidoCancelamentoRequest{CanceladoPor: "balcão", Motivo: models.MotivoClienteDesistiu})
+		}()
+		go func() {
+			defer grupo.Done()
+			<-largada
+			_, errAlteracao = servico.AmendOrder(pedido.ID, models.PedidoUpdateRequest{
+				Hamburgueres: []models.PedidoHamburguerRequest{{ID: 1, Quantidade: 3}},
+				Bebidas:      []models.PedidoItemRequest{{ID: 10, Quantidade: 2}},
+			})
+		}()
+		close(largada)
+		grupo.Wait()
+
+		if errCancelamento != nil {
+			t.Fatalf("CancelOrder: %v", errCancelamento)
+		}
+		// A alteração entra antes do cancelamento ou é recusada por encontrar o pedido cancelado
+		if errAlteracao != nil && !errors.Is(errAlteracao, ErrConflito) {
+			t.Fatalf("AmendOrder: erro %v, esperado nenhum ou ErrConflito", errAlteracao)
+		}
+
+		gravado, err := store.Pedidos().Buscar(pedido.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if gravado.Status != models.StatusCancelled {
+			t.Fatalf("status = %s, esperado %s", gravado.Status, models.StatusCancelled)
+		}
+
+		// Seja qual for a ordem, o cancelamento devolve tudo o que o pedido baixou
+		conferirSaldo(t, store, 1, 100)
+		conferirSaldo(t, store, 10, 10)
+	}
+}
+
+// enderecoDeTeste é um endereço completo, que dispensa a consulta de CEPs
+var enderecoDeTeste = models.EnderecoRequest{
+	CEP:        "01310-100",
+	Logradouro: "Avenida Paulista",
+	Numero:     "1000",
+	Bairro:     "Bela Vista",
+	Cidade:     "São Paulo",
+	UF:         "SP",
+}
+
+// zonaDeTeste grava uma zona de entrega pela faixa de CEPs do centro de São Paulo
+func zonaDeTeste(t *testing.T, store repository.Store) models.ZonaEntrega {
+	t.Helper()
+
+	zona := models.ZonaEntrega{Nome: "Centro", CEPInicio: "01000000", CEPFim: "01599999", Taxa: models.Centavos(500), PrazoMinutos: 40, Ativa: true}
+	if err := store.ZonasEntrega().Criar(&zona); err != nil {
+		t.Fatal(err)
+	}
+	return zona
+}
+
+func TestFluxoDeStatusPorTipo(t *testing.T) {
+	casos := []struct {
+		tipo     models.TipoPedido
+		mesa     int
+		endereco *models.EnderecoRequest
+		fluxo    []models.StatusPedido
+		fora     models.StatusPedido // etapa de outro tipo, recusada no fluxo deste
+	}{
+		{models.TipoDelivery, 0, &enderecoDeTeste, []models.StatusPedido{models.StatusDelivery, models.StatusFinalized}, models.StatusReadyForPickup},
+		{models.TipoPickup, 0, nil, []models.StatusPedido{models.StatusReadyForPickup, models.StatusFinalized}, models.StatusServed},
+		{models.TipoDineIn, 7, nil, []models.StatusPedido{models.StatusServed, models.StatusFinalized}, models.StatusDelivery},
+	}
+
+	for _, caso := range casos {
+		t.Run(string(caso.tipo), func(t *testing.T) {
+			store := repository.NewMemoriaStore()
+			cardapioDeTeste(t, store)
+			zona := zonaDeTeste(t, store)
+			servico := NewPedidoService(store, cep.SemConsulta{})
+
+			pedido, err := servico.PlaceOrder(models.PedidoRequest{
+				Descricao:    "Pedido de teste",
+				Tipo:         caso.tipo,
+				Mesa:         caso.mesa,
+				Nome:         "Maria",
+				Telefone:     "11987654321",
+				Endereco:     caso.endereco,
+				Hamburgueres: []models.PedidoHamburguerRequest{{ID: 1, Quantidade: 1}},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if pedido.Tipo.ComEntrega() {
+				if pedido.ZonaEntregaID == nil || *pedido.ZonaEntregaID != zona.ID || pedido.TaxaEntrega != zona.Taxa || pedido.CEP != "01310100" {
+					t.Errorf("entrega sem a zona: zona %v, taxa %s, CEP %q", pedido.ZonaEntregaID, pedido.TaxaEntrega, pedido.CEP)
+				}
+			} else if pedido.ZonaEntregaID != nil || pedido.TaxaEntrega != 0 || pedido.Endereco != "" {
+				t.Errorf("pedido %s com entrega: zona %v, taxa %s, endereço %q", pedido.Tipo, pedido.ZonaEntregaID, pedido.TaxaEntrega, pedido.Endereco)
+			}
+			if pedido.Mesa != caso.mesa {
+				t.Errorf("mesa = %d, esperado %d", pedido.Mesa, caso.mesa)
+			}
+
+			var transicao *ErroTransicao
+			_, err = servico.ChangeStatus(pedido.ID, models.PedidoStatusRequest{Status: caso.fora, Ator: "balcão"})
+			if !errors.As(err, &transicao) || !errors.Is(err, ErrConflito) {
+				t.Fatalf("STARTED → %s: erro %v, esperado ErroTransicao", caso.fora, err)
+			}
+			if !slices.Equal(transicao.StatusPermitidos, []models.StatusPedido{caso.fluxo[0], models.StatusCancelled}) {
+				t.Errorf("status permitidos = %v", transicao.StatusPermitidos)
+			}
+
+			for _, status := range caso.fluxo {
+				pedido, err = servico.ChangeStatus(pedido.ID, models.PedidoStatusRequest{Status: status, Ator: "balcão"})
+				if err != nil {
+					t.Fatalf("→ %s: %v", status, err)
+				}
+				if pedido.Status != status {
+					t.Fatalf("status = %s, esperado %s", pedido.Status, status)
+				}
+			}
+		})
+	}
+}
+
+func TestTipoDoPedidoExigeMesaSoNoLocal(t *testing.T) {
+	casos := []struct {
+		nome     string
+		tipo     models.TipoPedido
+		mesa     int
+		endereco *models.EnderecoRequest
+	}{
+		{"tipo desconhecido", "DRIVE_THRU", 0, nil},
+		{"consumo no local sem mesa", models.TipoDineIn, 0, nil},
+		{"retirada com mesa", models.TipoPickup, 3, nil},
+		{"entrega com mesa", models.TipoDelivery, 3, &enderecoDeTeste},
+		{"retirada com endereço", models.TipoPickup, 0, &enderecoDeTeste},
+		{"consumo no local com endereço", models.TipoDineIn, 3, &enderecoDeTeste},
+	}
+
+	for _, caso := range casos {
+		t.Run(caso.nome, func(t *testing.T) {
+			store := repository.NewMemoriaStore()
+			cardapioDeTeste(t, store)
+			zonaDeTeste(t, store)
+			servico := NewPedidoService(store, cep.SemConsulta{})
+
+			request := models.PedidoRequest{
+				Descricao:    "Pedido de teste",
+				Tipo:         caso.tipo,
+				Mesa:         caso.mesa,
+				Nome:         "Maria",
+				Telefone:     "11987654321",
+				Endereco:     caso.endereco,
+				Hamburgueres: []models.PedidoHamburguerRequest{{ID: 1, Quantidade: 1}},
+			}
+			if _, err := servico.QuoteOrder(request); !errors.Is(err, ErrValidacao) {
+				t.Errorf("QuoteOrder: erro %v, esperado ErrValidacao", err)
+			}
+			if _, err := servico.PlaceOrder(request); !errors.Is(err, ErrValidacao) {
+				t.Errorf("PlaceOrder: erro %v, esperado ErrValidacao", err)
+			}
+
+			pedido := pedidoDeTeste(t, servico)
+			_, err := servico.AmendOrder(pedido.ID, models.PedidoUpdateRequest{Tipo: caso.tipo, Mesa: caso.mesa, Endereco: caso.endereco})
+			if !errors.Is(err, ErrValidacao) {
+				t.Errorf("AmendOrder: erro %v, esperado ErrValidacao", err)
+			}
+		})
+	}
+}
+
+func TestAmendOrderTrocaOTipoSoAntesDoPreparo(t *testing.T) {
+	store := repository.NewMemoriaStore()
+	cardapioDeTeste(t, store)
+	zonaDeTeste(t, store)
+	servico := NewPedidoService(store, cep.SemConsulta{})
+	pedido := pedidoDeTeste(t, servico)
+
+	// De retirada para entrega: o endereço passa a ser exigido e a taxa é cobrada
+	if _, err := servico.AmendOrder(pedido.ID, models.PedidoUpdateRequest{Tipo: models.TipoDelivery}); !errors.Is(err, ErrValidacao) {
+		t.Errorf("entrega sem endereço: erro %v, esperado ErrValidacao", err)
+	}
+	alterado, err := servico.AmendOrder(pedido.ID, models.PedidoUpdateRequest{Tipo: models.TipoDelivery, Endereco: &enderecoDeTeste})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if alterado.TaxaEntrega != models.Centavos(500) || alterado.ValorTotal != pedido.ValorTotal+models.Centavos(500) {
+		t.Errorf("entrega: taxa %s, total %s", alterado.TaxaEntrega, alterado.ValorTotal)
+	}
+
+	// De entrega para consumo no local: a mesa é exigida e o endereço e a taxa saem
+	alterado, err = servico.AmendOrder(pedido.ID, models.PedidoUpdateRequest{Tipo: models.TipoDineIn, Mesa: 4})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if alterado.Mesa != 4 || alterado.Endereco != "" || alterado.ZonaEntregaID != nil || alterado.ValorTotal != pedido.ValorTotal {
+		t.Errorf("consumo no local: mesa %d, endereço %q, zona %v, total %s", alterado.Mesa, alterado.Endereco, alterado.ZonaEntregaID, alterado.ValorTotal)
+	}
+
+	if _, err := servico.ChangeStatus(pedido.ID, models.PedidoStatusRequest{Status: models.StatusServed, Ator: "garçom"}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Depois do preparo, o pedido segue o fluxo do tipo em que foi preparado
+	_, err = servico.AmendOrder(pedido.ID, models.PedidoUpdateRequest{Tipo: models.TipoPickup})
+	if !errors.Is(err, ErrConflito) {
+		t.Fatalf("troca de tipo após o preparo: erro %v, esperado ErrConflito", err)
+	}
+	gravado, err := store.Pedidos().Buscar(pedido.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gravado.Tipo != models.TipoDineIn || gravado.Mesa != 4 {
+		t.Errorf("pedido alterado: tipo %s, mesa %d", gravado.Tipo, gravado.Mesa)
+	}
+
+	// Os demais dados continuam podendo mudar
+	if _, err := servico.AmendOrder(pedido.ID, models.PedidoUpdateRequest{Mesa: 5}); err != nil {
+		t.Errorf("troca de mesa após o preparo: %v", err)
+	}
+}
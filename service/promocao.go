@@ -0,0 +1,141 @@
+package service
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"lanchonete/mensagens"
+	"lanchonete/models"
+	"lanchonete/repository"
+)
+
+// usoPromocao é o contexto em que as promoções são avaliadas
+type usoPromocao struct {
+	Cupom      string
+	CupomSalvo bool // cupom que já estava no pedido: se deixou de valer, é descartado sem erro
+	Telefone   string
+	Instante   time.Time // horário do pedido, para a validade e o happy hour
+	Pedido     uuid.UUID // pedido que está sendo alterado, que não conta como uso
+}
+
+// aplicarPromocoes calcula os descontos da cotação: todas as promoções automáticas
+// vigentes que se aplicam às linhas e, no máximo, a do cupom informado. A soma dos
+// descontos nunca passa do subtotal; a taxa de entrega é sempre cobrada.
+func aplicarPromocoes(store repository.Store, cotacao *Cotacao, uso usoPromocao) error {
+	cotacao.Descontos, cotacao.Desconto = nil, 0
+	cotacao.calcularTotal()
+
+	promocoes, err := store.Promocoes().Listar(repository.FiltroPromocoes{Ativas: true})
+	if err != nil {
+		return erroInterno(mensagens.PromocaoErroBuscar)
+	}
+	for _, promocao := range promocoes {
+		if !promocao.Automatica() || !promocao.VigenteEm(uso.Instante) {
+			continue
+		}
+		valor := promocao.Desconto(cotacao.Hamburgueres, cotacao.Bebidas)
+		if valor == 0 {
+			continue
+		}
+		esgotada, err := promocaoEsgotada(store, promocao, uso)
+		if err != nil {
+			return err
+		}
+		if !esgotada {
+			cotacao.adicionarDesconto(promocao, valor)
+		}
+	}
+
+	if cupom := strings.ToUpper(strings.TrimSpace(uso.Cupom)); cupom != "" {
+		promocao, valor, err := promocaoDoCupom(store, cotacao, cupom, uso)
+		switch {
+		case err == nil:
+			cotacao.adicionarDesconto(promocao, valor)
+		case !uso.CupomSalvo || !errors.Is(err, ErrCupomInvalido):
+			return err
+		}
+	}
+
+	cotacao.calcularTotal()
+	return nil
+}
+
+// promocaoDoCupom busca a promoção do cupom e confere se ela pode ser usada no pedido
+func promocaoDoCupom(store repository.Store, cotacao *Cotacao, cupom string, uso usoPromocao) (models.Promocao, models.Dinheiro, error) {
+	promocao, err := store.Promocoes().BuscarCupom(cupom)
+	if errors.Is(err, repository.ErrNaoEncontrado) {
+		return models.Promocao{}, 0, erroCupomInvalido(mensagens.CupomNaoEncontrado, cupom)
+	}
+	if err != nil {
+		return models.Promocao{}, 0, erroInterno(mensagens.PromocaoErroBuscar)
+	}
+	if !promocao.VigenteEm(uso.Instante) {
+		return models.Promocao{}, 0, erroCupomInvalido(mensagens.CupomForaDaValidade, cupom)
+	}
+
+	valor := promocao.Desconto(cotacao.Hamburgueres, cotacao.Bebidas)
+	if valor == 0 {
+		return models.Promocao{}, 0, erroCupomInvalido(mensagens.CupomNaoSeAplica, cupom)
+	}
+	esgotada, err := promocaoEsgotada(store, promocao, uso)
+	if err != nil {
+		return models.Promocao{}, 0, err
+	}
+	if esgotada {
+		return models.Promocao{}, 0, erroCupomInvalido(mensagens.CupomEsgotado, cupom)
+	}
+	return promocao, valor, nil
+}
+
+// promocaoEsgotada indica se a promoção já atingiu o limite de usos, no total ou do
+// cliente. A promoção fica bloqueada até o fim da transação, para que pedidos
+// simultâneos não passem juntos do limite.
+func promocaoEsgotada(store repository.Store, promocao models.Promocao, uso usoPromocao) (bool, error) {
+	if !promocao.Limitada() {
+		return false, nil
+	}
+	if _, err := store.Promocoes().BuscarParaAtualizar(promocao.ID); err != nil {
+		return false, erroInterno(mensagens.PromocaoErroBuscar)
+	}
+
+	total, doCliente, err := store.Promocoes().Usos(promocao.ID, uso.Telefone, uso.Pedido)
+	if err != nil {
+		return false, erroInterno(mensagens.PromocaoErroBuscar)
+	}
+	return (promocao.LimiteUsos > 0 && total >= int64(promocao.LimiteUsos)) ||
+		(promocao.LimitePorCliente > 0 && doCliente >= int64(promocao.LimitePorCliente)), nil
+}
+
+// adicionarDesconto soma o desconto da promoção à cotação, limitado ao que ainda
+// resta do subtotal
+func (c *Cotacao) adicionarDesconto(promocao models.Promocao, valor models.Dinheiro) {
+	valor = min(valor, c.Subtotal-c.Desconto)
+	if valor <= 0 {
+		return
+	}
+
+	id := promocao.ID
+	c.Descontos = append(c.Descontos, models.PedidoDesconto{
+		PromocaoID: &id,
+		Descricao:  promocao.Nome,
+		Cupom:      promocao.Cupom,
+		Valor:      valor,
+	})
+	c.Desconto += valor
+}
+
+// gravarDescontos substitui os descontos gravados no pedido pelos da cotação
+func gravarDescontos(tx repository.Store, pedidoID uuid.UUID, descontos []models.PedidoDesconto) error {
+	if err := tx.Pedidos().RemoverDescontos(pedidoID); err != nil {
+		return erroInterno(mensagens.PromocaoErroAplicar)
+	}
+	for _, desconto := range descontos {
+		desconto.PedidoID = pedidoID
+		if err := tx.Pedidos().AdicionarDesconto(&desconto); err != nil {
+			return falhaRepositorio(err, mensagens.PromocaoNaoEncontrada, mensagens.PromocaoErroAplicar)
+		}
+	}
+	return nil
+}